@@ -4,33 +4,53 @@
 package main
 
 import (
-	"bufio"
+	"context"
 	"flag"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"os"
+	"path/filepath"
+	"regexp"
 	"strings"
+	"time"
 
+	"github.com/mattn/anko/ankotest"
 	"github.com/mattn/anko/core"
 	"github.com/mattn/anko/env"
 	_ "github.com/mattn/anko/packages"
 	"github.com/mattn/anko/parser"
 	"github.com/mattn/anko/vm"
+	"github.com/peterh/liner"
 )
 
 const version = "0.1.8"
 
 var (
-	flagExecute string
-	file        string
-	args        []string
-	e           *env.Env
+	flagExecute    string
+	flagCPUProfile string
+	file           string
+	args           []string
+	e              *env.Env
 )
 
+// subcommands are the commands that can be given as the first argument, they return the exit code.
+var subcommands = map[string]func(arguments []string) int{
+	"run":   runFiles,
+	"check": checkFiles,
+	"fmt":   formatFiles,
+	"test":  testFiles,
+}
+
 func main() {
 	var exitCode int
 
+	if len(os.Args) > 1 {
+		if subcommand, ok := subcommands[os.Args[1]]; ok {
+			os.Exit(subcommand(os.Args[2:]))
+		}
+	}
+
 	parseFlags()
 	setupEnv()
 	if flagExecute != "" || flag.NArg() > 0 {
@@ -43,6 +63,7 @@ func main() {
 }
 
 func parseFlags() {
+	flag.Usage = usage
 	flagVersion := flag.Bool("v", false, "prints out the version and then exits")
 	flag.StringVar(&flagExecute, "e", "", "execute the Anko code")
 	flag.Parse()
@@ -61,6 +82,188 @@ func parseFlags() {
 	args = flag.Args()[1:]
 }
 
+func usage() {
+	fmt.Fprintf(flag.CommandLine.Output(), `Usage:
+  anko [-e code | file | -] [args...]  run a script, or the REPL without arguments
+  anko run [-cpuprofile file] [-e code | file | -] [args...]  run a script, - reads it from stdin
+  anko check [file | -]...  parse the scripts without running them
+  anko fmt [-l] [-w] [file | -]...  indent the scripts
+  anko test [-run regexp] [-timeout duration] [-format text|tap|junit] [-v]
+      [-coverprofile file] [-lcov file] [-coverhtml file] [dir | dir/... | file]...
+      run the test_* functions of the *_test.ank files
+
+Flags:
+`)
+	flag.PrintDefaults()
+}
+
+// runFiles is the run subcommand, it runs the script in the -e flag or in the file given as first argument.
+func runFiles(arguments []string) int {
+	flagSet := flag.NewFlagSet("run", flag.ContinueOnError)
+	flagSet.StringVar(&flagExecute, "e", "", "execute the Anko code")
+	flagSet.StringVar(&flagCPUProfile, "cpuprofile", "", "write a pprof profile of the time and allocations of the script functions and lines to the file")
+	if err := flagSet.Parse(arguments); err != nil {
+		return 2
+	}
+	if flagExecute == "" && flagSet.NArg() < 1 {
+		fmt.Fprintln(os.Stderr, "run: no script given")
+		return 2
+	}
+
+	file = ""
+	args = flagSet.Args()
+	if flagExecute == "" {
+		file = flagSet.Arg(0)
+		args = flagSet.Args()[1:]
+	}
+	setupEnv()
+	return runNonInteractive()
+}
+
+// checkFiles is the check subcommand, it parses the scripts without running them and prints the syntax errors.
+// Returns 2 if a script could not be read and 3 if a script has a syntax error.
+func checkFiles(arguments []string) int {
+	flagSet := flag.NewFlagSet("check", flag.ContinueOnError)
+	if err := flagSet.Parse(arguments); err != nil {
+		return 2
+	}
+
+	parser.EnableErrorVerbose()
+	exitCode := 0
+	for _, name := range flagSet.Args() {
+		source, err := readScript(name)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "ReadFile error:", err)
+			exitCode = 2
+			continue
+		}
+		_, err = parser.ParseSrc(string(source))
+		if err != nil {
+			if e, ok := err.(*parser.Error); ok {
+				fmt.Fprintf(os.Stderr, "%v:%v:%v: %v\n", name, e.Pos.Line, e.Pos.Column, e.Message)
+			} else {
+				fmt.Fprintf(os.Stderr, "%v: %v\n", name, err)
+			}
+			if exitCode == 0 {
+				exitCode = 3
+			}
+		}
+	}
+	return exitCode
+}
+
+// testFiles is the test subcommand, it runs the tests of the *_test.ank files matched by the arguments.
+// Returns 1 if a test failed and 2 if the arguments are wrong.
+func testFiles(arguments []string) int {
+	flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+	flagRun := flagSet.String("run", "", "run only the tests matching the regular expression")
+	flagTimeout := flagSet.Duration("timeout", 10*time.Minute, "interrupt each test after the duration, 0 for no timeout")
+	flagFormat := flagSet.String("format", "text", "output format: text, tap or junit")
+	flagVerbose := flagSet.Bool("v", false, "list all tests in the text output")
+	flagCoverProfile := flagSet.String("coverprofile", "", "write the coverage of the imported modules to the file in the Go cover profile format")
+	flagLCOV := flagSet.String("lcov", "", "write the coverage of the imported modules to the file in the LCOV format")
+	flagCoverHTML := flagSet.String("coverhtml", "", "write an HTML report of the coverage of the imported modules to the file")
+	if err := flagSet.Parse(arguments); err != nil {
+		return 2
+	}
+
+	options := &ankotest.Options{Timeout: *flagTimeout}
+	if *flagRun != "" {
+		var err error
+		options.Run, err = regexp.Compile(*flagRun)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "test: bad -run:", err)
+			return 2
+		}
+	}
+	files, err := ankotest.FindFiles(flagSet.Args()...)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "test:", err)
+		return 2
+	}
+
+	if *flagCoverProfile != "" || *flagLCOV != "" || *flagCoverHTML != "" {
+		options.Coverage = vm.NewCoverage()
+	}
+
+	results := ankotest.RunFiles(context.Background(), files, options)
+	switch *flagFormat {
+	case "tap":
+		err = ankotest.WriteTAP(os.Stdout, results)
+	case "junit":
+		err = ankotest.WriteJUnit(os.Stdout, results)
+	default:
+		err = ankotest.WriteText(os.Stdout, results, *flagVerbose)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "test:", err)
+		return 2
+	}
+
+	if options.Coverage != nil {
+		if *flagFormat == "text" {
+			fmt.Printf("coverage: %.1f%% of statements\n", options.Coverage.Percent())
+		}
+		reports := []struct {
+			file  string
+			write func(io.Writer) error
+		}{
+			{*flagCoverProfile, options.Coverage.WriteProfile},
+			{*flagLCOV, options.Coverage.WriteLCOV},
+			{*flagCoverHTML, options.Coverage.WriteHTML},
+		}
+		for _, report := range reports {
+			if report.file == "" {
+				continue
+			}
+			err = writeReport(report.file, report.write)
+			if err != nil {
+				fmt.Fprintln(os.Stderr, "test:", err)
+				return 2
+			}
+		}
+	}
+
+	if ankotest.Failed(results) {
+		return 1
+	}
+	return 0
+}
+
+// writeReport creates the file and writes the report to it.
+func writeReport(name string, write func(io.Writer) error) error {
+	file, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+	err = write(file)
+	if err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// readScript reads the script file from the operating system file system, or stdin if the name is -.
+func readScript(name string) ([]byte, error) {
+	if name == "-" {
+		return ioutil.ReadAll(os.Stdin)
+	}
+	return env.ReadFile(nil, name)
+}
+
+// newModuleLoader creates the loader of the modules imported by the script file, which resolves relative module names
+// against the directory of the file and runs modules with the core builtins.
+func newModuleLoader(file string) *vm.ModuleLoader {
+	loader := vm.NewModuleLoader(nil)
+	if file != "" && file != "-" {
+		loader.Dir = filepath.Dir(file)
+	}
+	loader.Env = env.NewEnv()
+	core.Import(loader.Env)
+	return loader
+}
+
 func setupEnv() {
 	e = env.NewEnv()
 	e.Define("args", args)
@@ -72,7 +275,7 @@ func runNonInteractive() int {
 	if flagExecute != "" {
 		source = flagExecute
 	} else {
-		sourceBytes, err := ioutil.ReadFile(file)
+		sourceBytes, err := readScript(file)
 		if err != nil {
 			fmt.Println("ReadFile error:", err)
 			return 2
@@ -80,7 +283,22 @@ func runNonInteractive() int {
 		source = string(sourceBytes)
 	}
 
-	_, err := vm.Execute(e, nil, source) // Ensure vm.Execute is used correctly
+	options := &vm.Options{ModuleLoader: newModuleLoader(file)}
+	if flagCPUProfile != "" {
+		profiler := vm.NewProfiler()
+		profiler.File = file
+		options.Profiler = profiler
+	}
+	_, err := vm.Execute(e, options, source)
+	if options.Profiler != nil {
+		if err := writeReport(flagCPUProfile, options.Profiler.WritePprof); err != nil {
+			fmt.Println("Profile error:", err)
+			return 2
+		}
+	}
+	if exitErr, ok := err.(*vm.ExitError); ok {
+		return exitErr.Code
+	}
 	if err != nil {
 		fmt.Println("Execute error:", err)
 		return 4
@@ -90,25 +308,60 @@ func runNonInteractive() int {
 }
 
 func runInteractive() int {
-	var following bool
-	var source string
-	scanner := bufio.NewScanner(os.Stdin)
+	line := liner.NewLiner()
+	defer line.Close()
+	line.SetCtrlCAborts(true)
+	line.SetWordCompleter(func(text string, pos int) (string, []string, string) {
+		return completeWord(e, text, pos)
+	})
+
+	history := historyFile()
+	if history != "" {
+		if file, err := os.Open(history); err == nil {
+			line.ReadHistory(file)
+			file.Close()
+		}
+	}
 
 	parser.EnableErrorVerbose()
 
+	var source string
+	var exitCode int
 	for {
-		if following {
-			source += "\n"
-			fmt.Print("  ")
-		} else {
-			fmt.Print("> ")
+		prompt := "> "
+		if source != "" {
+			prompt = "  "
 		}
 
-		if !scanner.Scan() {
+		text, err := line.Prompt(prompt)
+		if err == liner.ErrPromptAborted {
+			// Ctrl-C discards the statement being typed
+			source = ""
+			continue
+		}
+		if err != nil {
+			if err != io.EOF {
+				fmt.Fprintln(os.Stderr, "Prompt error:", err)
+				return 12
+			}
 			break
 		}
-		source += scanner.Text()
-		if source == "" {
+
+		if strings.TrimSpace(text) != "" {
+			line.AppendHistory(text)
+		}
+		if source == "" && isCommand(text) {
+			if err := runCommand(os.Stdout, text); err != nil {
+				printError(err)
+			}
+			continue
+		}
+		if source != "" {
+			source += "\n"
+		}
+		source += text
+		if strings.TrimSpace(source) == "" {
+			source = ""
 			continue
 		}
 		if source == "quit()" {
@@ -116,54 +369,45 @@ func runInteractive() int {
 		}
 
 		stmts, err := parser.ParseSrc(source)
-
-		if e, ok := err.(*parser.Error); ok {
-			es := e.Error()
-			if strings.HasPrefix(es, "syntax error: unexpected") {
-				if strings.HasPrefix(es, "syntax error: unexpected $end,") {
-					following = true
-					continue
-				}
-			} else {
-				if e.Pos.Column == len(source) && !e.Fatal {
-					fmt.Fprintln(os.Stderr, e)
-					following = true
-					continue
-				}
-				if e.Error() == "unexpected EOF" {
-					following = true
-					continue
-				}
-			}
+		if e, ok := err.(*parser.Error); ok && e.Incomplete {
+			continue
 		}
 
-		following = false
 		source = ""
 		var v interface{}
 
 		if err == nil {
 			v, err = vm.Run(e, nil, stmts)
 		}
+		if exitErr, ok := err.(*vm.ExitError); ok {
+			exitCode = exitErr.Code
+			break
+		}
 		if err != nil {
-			if e, ok := err.(*vm.Error); ok {
-				fmt.Fprintf(os.Stderr, "%d:%d %s\n", e.Pos.Line, e.Pos.Column, err)
-			} else if e, ok := err.(*parser.Error); ok {
-				fmt.Fprintf(os.Stderr, "%d:%d %s\n", e.Pos.Line, e.Pos.Column, err)
-			} else {
-				fmt.Fprintln(os.Stderr, err)
-			}
+			printError(err)
 			continue
 		}
 
-		fmt.Printf("%#v\n", v)
+		fmt.Println(formatValue(v))
 	}
 
-	if err := scanner.Err(); err != nil {
-		if err != io.EOF {
-			fmt.Fprintln(os.Stderr, "ReadString error:", err)
-			return 12
+	if history != "" {
+		if file, err := os.Create(history); err == nil {
+			line.WriteHistory(file)
+			file.Close()
 		}
 	}
 
-	return 0
+	return exitCode
+}
+
+// printError prints a REPL error to stderr, prefixed with its position when there is one.
+func printError(err error) {
+	if e, ok := err.(*vm.Error); ok {
+		fmt.Fprintf(os.Stderr, "%d:%d %s\n", e.Pos.Line, e.Pos.Column, err)
+	} else if e, ok := err.(*parser.Error); ok {
+		fmt.Fprintf(os.Stderr, "%d:%d %s\n", e.Pos.Line, e.Pos.Column, err)
+	} else {
+		fmt.Fprintln(os.Stderr, err)
+	}
 }
@@ -4,15 +4,20 @@ package main
 
 import (
 	"bufio"
+	"fmt"
 	"io"
+	"io/ioutil"
 	"log"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"reflect"
 	"runtime"
 	"strings"
 	"testing"
 	"time"
+
+	"github.com/mattn/anko/env"
 )
 
 var logger *log.Logger
@@ -86,8 +91,14 @@ func TestRunInteractive(t *testing.T) {
 		{runLines: []string{"1 + 1"}, runOutputs: []string{"2"}},
 		{runLines: []string{"a = 1", "b = 2", "a + b"}, runOutputs: []string{"1", "2", "3"}},
 		{runLines: []string{"a = 1", "if a == 1 {", "b = 1", "b = 2", "}", "a"}, runOutputs: []string{"1", "", "", "", "2", "1"}},
-		{runLines: []string{"a = 1", "for i = 0; i < 2; i++ {", "a++", "}", "a"}, runOutputs: []string{"1", "", "", "<nil>", "3"}},
-		{runLines: []string{"1 + 1", "// comment 1", "2 + 2 // comment 2", "// 3 + 3"}, runOutputs: []string{"2", "<nil>", "4", "<nil>"}},
+		{runLines: []string{"a = 1", "for i = 0; i < 2; i++ {", "a++", "}", "a"}, runOutputs: []string{"1", "", "", "nil", "3"}},
+		{runLines: []string{"1 + 1", "// comment 1", "2 + 2 // comment 2", "// 3 + 3"}, runOutputs: []string{"2", "nil", "4", "nil"}},
+		{runLines: []string{"a = [1,", "2]"}, runOutputs: []string{"", "[1, 2]"}},
+		{runLines: []string{"a = `b", "c`"}, runOutputs: []string{"", `"b\nc"`}},
+		{runLines: []string{"/* comment", "*/ 1"}, runOutputs: []string{"", "1"}},
+		{runLines: []string{`{"b": 2, "a": [1, "c", nil]}`}, runOutputs: []string{`{"a": [1, "c", nil], "b": 2}`}},
+		{runLines: []string{":type 1"}, runOutputs: []string{"int64"}},
+		{runLines: []string{":missing"}, runError: "unknown command :missing, :help lists the commands"},
 	}
 	runInteractiveTests(t, tests)
 }
@@ -271,3 +282,292 @@ func setStd(stdin *os.File, stderr *os.File, stdout *os.File) {
 	os.Stderr = stderr
 	os.Stdout = stdout
 }
+
+func TestCompleteWord(t *testing.T) {
+	e := env.NewEnv()
+	e.Define("abc", 1)
+	e.Define("abd", 2)
+	module, _ := e.NewModule("mod")
+	module.Define("value", 1)
+	module.Define("other", 2)
+	inner, _ := module.NewModule("inner")
+	inner.Define("deep", 1)
+	e.Define("m", map[interface{}]interface{}{"key": 1, 2: 2})
+	e.DefineType("abType", 1)
+	env.Packages["zz_test_package"] = nil
+	defer delete(env.Packages, "zz_test_package")
+
+	tests := []struct {
+		line        string
+		pos         int
+		head        string
+		completions []string
+		tail        string
+	}{
+		{line: "ab", pos: 2, completions: []string{"abType", "abc", "abd"}},
+		{line: "1 + ab + 1", pos: 6, head: "1 + ", completions: []string{"abType", "abc", "abd"}, tail: " + 1"},
+		{line: "fo", pos: 2, completions: []string{"for"}},
+		{line: "mod.", pos: 4, head: "mod.", completions: []string{"inner", "other", "value"}},
+		{line: "mod.v", pos: 5, head: "mod.", completions: []string{"value"}},
+		{line: "mod.inner.d", pos: 11, head: "mod.inner.", completions: []string{"deep"}},
+		{line: "m.", pos: 2, head: "m.", completions: []string{"key"}},
+		{line: "abc.", pos: 4, head: "abc."},
+		{line: "x.", pos: 2, head: "x."},
+		{line: `import("zz_test`, pos: 15, head: `import("`, completions: []string{"zz_test_package"}},
+	}
+	for _, test := range tests {
+		head, completions, tail := completeWord(e, test.line, test.pos)
+		if head != test.head || tail != test.tail || !reflect.DeepEqual(completions, test.completions) {
+			t.Errorf("completeWord - received: %q, %q, %q - expected: %q, %q, %q - line: %v",
+				head, completions, tail, test.head, test.completions, test.tail, test.line)
+		}
+	}
+}
+
+func TestFormatValue(t *testing.T) {
+	type point struct {
+		X int
+		Y int
+	}
+	tests := []struct {
+		value    interface{}
+		expected string
+	}{
+		{value: nil, expected: "nil"},
+		{value: int64(1), expected: "1"},
+		{value: 1.5, expected: "1.5"},
+		{value: true, expected: "true"},
+		{value: "a\n", expected: `"a\n"`},
+		{value: []interface{}{int64(1), "a", nil, []int{2}}, expected: `[1, "a", nil, [2]]`},
+		{value: map[interface{}]interface{}{"b": int64(1), "a": []interface{}{}}, expected: `{"a": [], "b": 1}`},
+		{value: map[string]int(nil), expected: "nil"},
+		{value: point{X: 1, Y: 2}, expected: "{X:1 Y:2}"},
+		{value: &point{X: 1, Y: 2}, expected: "&{X:1 Y:2}"},
+		{value: fmt.Errorf("failed"), expected: "error: failed"},
+		{value: time.Second, expected: "1s"},
+		{value: strings.Split, expected: "func(string, string) []string"},
+		{value: env.NewEnv(), expected: "module"},
+	}
+	for _, test := range tests {
+		value := formatValue(test.value)
+		if value != test.expected {
+			t.Errorf("formatValue - received: %v - expected: %v", value, test.expected)
+		}
+	}
+}
+
+func TestRunCommand(t *testing.T) {
+	setupEnv()
+	defer setupEnv()
+
+	loadFile := filepath.Join(t.TempDir(), "load.ank")
+	err := ioutil.WriteFile(loadFile, []byte("loaded = 1\nloaded + 2"), 0644)
+	if err != nil {
+		t.Fatal("WriteFile error:", err)
+	}
+
+	tests := []struct {
+		line     string
+		output   string
+		prefix   string
+		contains string
+		err      string
+	}{
+		{line: ":type 1", output: "int64\n"},
+		{line: ":type [1]", output: "[]interface {}\n"},
+		{line: ":type func() {}", output: "func\n"},
+		{line: ":type b = 1", output: "int64\n"},
+		{line: ":type b", err: "undefined symbol 'b'"},
+		{line: ":type", err: "usage: :type expr"},
+		{line: ":doc strings.Split", output: "// Split slices s into all substrings separated by sep and returns a slice of the substrings between those separators.\nfunc Split(string, string) []string\n"},
+		{line: ":doc fmt.Sprintf", output: "// Sprintf formats according to a format specifier and returns the resulting string.\nfunc Sprintf(string, ...interface {}) string\n"},
+		{line: ":doc time.Duration", prefix: "// A Duration represents the elapsed time between two instants as an int64 nanosecond count.\ntype Duration int64\n", contains: "    func (time.Duration) String() string\n"},
+		{line: ":doc time.Duration.String", output: "// String returns a string representing the duration in the form \"72h3m0.5s\".\nfunc (time.Duration) String() string\n"},
+		{line: ":doc strings.Builder", prefix: "// A Builder is used to efficiently build a string using [Builder.Write] methods.\ntype Builder struct\n", contains: "    func (*strings.Builder) WriteString(string) (int, error)\n"},
+		{line: ":doc strings.Builder.WriteString", output: "// WriteString appends the contents of s to b's buffer.\nfunc (*strings.Builder) WriteString(string) (int, error)\n"},
+		{line: ":doc time.Duration.Missing", err: "undefined symbol 'time.Duration.Missing'"},
+		{line: ":doc strings.Missing", err: "undefined symbol 'strings.Missing'"},
+		{line: ":load " + loadFile, output: "3\n"},
+		{line: ":doc loaded", output: "var loaded int64 = 1\n"},
+		{line: ":env", contains: "\nloaded "},
+		{line: ":time 1 + 1", prefix: "2\ntime: "},
+		{line: ":ast a", output: "*ast.StmtsStmt\n  Stmts:\n    - *ast.ExprStmt 1:1\n      Expr: *ast.IdentExpr 1:1\n        Lit: \"a\"\n"},
+		{line: ":ast 1 +", err: "syntax error"},
+		{line: ":reset"},
+		{line: ":type loaded", err: "undefined symbol 'loaded'"},
+		{line: ":missing", err: "unknown command :missing, :help lists the commands"},
+	}
+	for _, test := range tests {
+		var output strings.Builder
+		err := runCommand(&output, test.line)
+		if err != nil && test.err == "" || err == nil && test.err != "" || err != nil && !strings.Contains(err.Error(), test.err) {
+			t.Errorf("runCommand error - received: %v - expected: %v - line: %v", err, test.err, test.line)
+			continue
+		}
+		switch {
+		case test.prefix != "" || test.contains != "":
+			if !strings.HasPrefix(output.String(), test.prefix) || !strings.Contains(output.String(), test.contains) {
+				t.Errorf("runCommand - received: %q - expected prefix: %q and containing: %q - line: %v", output.String(), test.prefix, test.contains, test.line)
+			}
+		case output.String() != test.output:
+			t.Errorf("runCommand - received: %q - expected: %q - line: %v", output.String(), test.output, test.line)
+		}
+	}
+}
+
+func TestRunFiles(t *testing.T) {
+	defer setupEnv()
+	dir := t.TempDir()
+	exitFile := filepath.Join(dir, "exit.ank")
+	err := ioutil.WriteFile(exitFile, []byte("#!/usr/bin/env anko\nexit(len(args))\nthrow \"not reached\""), 0644)
+	if err != nil {
+		t.Fatal("WriteFile error:", err)
+	}
+
+	moduleFile := filepath.Join(dir, "module.ank")
+	err = ioutil.WriteFile(moduleFile, []byte("lib = import(\"./lib.ank\")\nexit(lib.n)"), 0644)
+	if err != nil {
+		t.Fatal("WriteFile error:", err)
+	}
+	err = ioutil.WriteFile(filepath.Join(dir, "lib.ank"), []byte("n = len(range(8))"), 0644)
+	if err != nil {
+		t.Fatal("WriteFile error:", err)
+	}
+
+	realStdin := os.Stdin
+	defer func() { os.Stdin = realStdin }()
+	stdinFile := filepath.Join(dir, "stdin.ank")
+	err = ioutil.WriteFile(stdinFile, []byte("exit(args[0] == \"a\" ? 5 : 6)"), 0644)
+	if err != nil {
+		t.Fatal("WriteFile error:", err)
+	}
+	os.Stdin, err = os.Open(stdinFile)
+	if err != nil {
+		t.Fatal("Open error:", err)
+	}
+	defer os.Stdin.Close()
+
+	tests := []struct {
+		arguments []string
+		exitCode  int
+	}{
+		{arguments: []string{}, exitCode: 2},
+		{arguments: []string{"-e", "1 + 1"}, exitCode: 0},
+		{arguments: []string{"-e", "exit(7)"}, exitCode: 7},
+		{arguments: []string{"-e", "1++"}, exitCode: 4},
+		{arguments: []string{exitFile, "a", "b", "c"}, exitCode: 3},
+		{arguments: []string{moduleFile}, exitCode: 8},
+		{arguments: []string{filepath.Join(dir, "not-found.ank")}, exitCode: 2},
+		{arguments: []string{"-", "a"}, exitCode: 5},
+		{arguments: []string{"-cpuprofile", filepath.Join(dir, "cpu.pprof"), exitFile, "a"}, exitCode: 1},
+		{arguments: []string{"-cpuprofile", filepath.Join(dir, "not-found", "cpu.pprof"), "-e", "1"}, exitCode: 2},
+	}
+	for _, test := range tests {
+		exitCode := runFiles(test.arguments)
+		if exitCode != test.exitCode {
+			t.Errorf("exitCode - received: %v - expected: %v - arguments: %v", exitCode, test.exitCode, test.arguments)
+		}
+	}
+	if info, err := os.Stat(filepath.Join(dir, "cpu.pprof")); err != nil || info.Size() == 0 {
+		t.Errorf("cpu.pprof - received: %v - expected: a profile", err)
+	}
+	file = ""
+	flagExecute = ""
+}
+
+func TestCheckFiles(t *testing.T) {
+	_, filename, _, _ := runtime.Caller(0)
+	testDir := filepath.Join(filepath.Dir(filename), "core", "testdata")
+	dir := t.TempDir()
+	broken := filepath.Join(dir, "broken.ank")
+	err := ioutil.WriteFile(broken, []byte("a = [1,"), 0644)
+	if err != nil {
+		t.Fatal("WriteFile error:", err)
+	}
+
+	tests := []struct {
+		arguments []string
+		exitCode  int
+	}{
+		{arguments: []string{filepath.Join(testDir, "test.ank"), filepath.Join(testDir, "broken.ank")}, exitCode: 0},
+		{arguments: []string{filepath.Join(testDir, "test.ank"), broken}, exitCode: 3},
+		{arguments: []string{filepath.Join(dir, "not-found.ank"), broken}, exitCode: 2},
+	}
+	for _, test := range tests {
+		exitCode := checkFiles(test.arguments)
+		if exitCode != test.exitCode {
+			t.Errorf("exitCode - received: %v - expected: %v - arguments: %v", exitCode, test.exitCode, test.arguments)
+		}
+	}
+}
+
+func TestFormatSource(t *testing.T) {
+	tests := []struct {
+		source    string
+		formatted string
+		err       string
+	}{
+		{source: "a = 1  \n\n\n\nb = 2\n\n", formatted: "a = 1\n\nb = 2\n"},
+		{source: "if a {\nb = [\n1,\n2,\n]\n    } else {\n  c = 1 // }\n}", formatted: "if a {\n\tb = [\n\t\t1,\n\t\t2,\n\t]\n} else {\n\tc = 1 // }\n}\n"},
+		{source: "switch a {\n  case 1:\nb = \"{\"\n default:\n b = '}'\n}", formatted: "switch a {\ncase 1:\n\tb = \"{\"\ndefault:\n\tb = '}'\n}\n"},
+		{source: "func f() {\na = `x {  \n  y`\n/* {\n  */ return a\n}", formatted: "func f() {\n\ta = `x {  \n  y`\n\t/* {\n  */ return a\n}\n"},
+		{source: "a = [1,", err: "syntax error"},
+	}
+	for _, test := range tests {
+		formatted, err := formatSource(test.source)
+		if err != nil && test.err == "" || err == nil && test.err != "" || err != nil && !strings.Contains(err.Error(), test.err) {
+			t.Errorf("formatSource error - received: %v - expected: %v - source: %q", err, test.err, test.source)
+			continue
+		}
+		if formatted != test.formatted {
+			t.Errorf("formatSource - received: %q - expected: %q - source: %q", formatted, test.formatted, test.source)
+		}
+	}
+}
+
+func TestTestFiles(t *testing.T) {
+	_, filename, _, _ := runtime.Caller(0)
+	testDir := filepath.Join(filepath.Dir(filename), "ankotest", "testdata")
+
+	tests := []struct {
+		arguments []string
+		exitCode  int
+	}{
+		{arguments: []string{testDir}, exitCode: 0},
+		{arguments: []string{"-format", "tap", "-timeout", "50ms", testDir + "/..."}, exitCode: 1},
+		{arguments: []string{"-format", "junit", "-run", "equal", filepath.Join(testDir, "sub")}, exitCode: 1},
+		{arguments: []string{"-run", "^test_double$", testDir + "/..."}, exitCode: 0},
+		{arguments: []string{"-run", "("}, exitCode: 2},
+		{arguments: []string{filepath.Join(testDir, "not-found")}, exitCode: 2},
+		{arguments: []string{"-coverprofile", filepath.Join(testDir, "not-found", "cover.out"), testDir}, exitCode: 2},
+	}
+	for _, test := range tests {
+		exitCode := testFiles(test.arguments)
+		if exitCode != test.exitCode {
+			t.Errorf("exitCode - received: %v - expected: %v - arguments: %v", exitCode, test.exitCode, test.arguments)
+		}
+	}
+
+	tempDir, err := ioutil.TempDir("", "anko-cover")
+	if err != nil {
+		t.Fatal("TempDir error:", err)
+	}
+	defer os.RemoveAll(tempDir)
+	reports := map[string]string{"cover.out": "mode: count\n", "lcov.info": "TN:\n", "cover.html": "<!DOCTYPE html>"}
+	arguments := []string{
+		"-coverprofile", filepath.Join(tempDir, "cover.out"),
+		"-lcov", filepath.Join(tempDir, "lcov.info"),
+		"-coverhtml", filepath.Join(tempDir, "cover.html"),
+		testDir,
+	}
+	exitCode := testFiles(arguments)
+	if exitCode != 0 {
+		t.Errorf("exitCode - received: %v - expected: %v - arguments: %v", exitCode, 0, arguments)
+	}
+	for name, prefix := range reports {
+		report, err := ioutil.ReadFile(filepath.Join(tempDir, name))
+		if err != nil || !strings.HasPrefix(string(report), prefix) {
+			t.Errorf("%v - received: %q, %v - expected to start with: %q", name, report, err, prefix)
+		}
+	}
+}
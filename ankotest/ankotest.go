@@ -0,0 +1,333 @@
+// Package ankotest runs the tests written in Anko script test files.
+//
+// Test files are named *_test.ank, every top-level function without arguments named test_* is a test:
+//
+//	func test_add() {
+//		assert.equal(3, 1 + 2)
+//	}
+//
+// Each test runs in a new env with the core builtins and the assert module defined,
+// after the top-level statements of the file. A failed assertion stops the test.
+// Tables of cases can be run as subtests with assert.run:
+//
+//	func test_table() {
+//		for c in [{"name": "one", "input": 1, "want": 2}, {"name": "two", "input": 2, "want": 4}] {
+//			assert.run(c.name, func() {
+//				assert.equal(c.want, c.input * 2)
+//			})
+//		}
+//	}
+package ankotest
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mattn/anko/ast"
+	"github.com/mattn/anko/core"
+	"github.com/mattn/anko/env"
+	"github.com/mattn/anko/parser"
+	"github.com/mattn/anko/vm"
+)
+
+// Options are the options of a test run.
+type Options struct {
+	// Run selects the tests to run by name, nil runs all tests
+	Run *regexp.Regexp
+	// Timeout is how long each test can run before it is interrupted, zero for no timeout
+	Timeout time.Duration
+	// Setup is called with the env of each test before the file runs, to define the values and types of the host
+	Setup func(e *env.Env) error
+	// VMOptions are the options the test files run with, nil uses the default options.
+	// The ModuleLoader is replaced by one that imports modules relative to the test file and runs them with the core builtins.
+	VMOptions *vm.Options
+	// Coverage records the coverage of the modules the test files import, nil does not record.
+	// The test files are not added to it.
+	Coverage *vm.Coverage
+}
+
+// Result is the result of a test or subtest.
+type Result struct {
+	// File is the test file
+	File string
+	// Name is the name of the test, subtests are named test/subtest
+	Name string
+	// Failed is true if the test failed
+	Failed bool
+	// Skipped is true if the test called assert.skip
+	Skipped bool
+	// Message is the failure message or the reason the test was skipped
+	Message string
+	// Pos is the position in the test file where the test failed
+	Pos ast.Position
+	// Duration is how long the test ran
+	Duration time.Duration
+}
+
+// Location returns file:line:column of the failure, or the file when the position is unknown.
+func (result *Result) Location() string {
+	if result.Pos.Line < 1 {
+		return result.File
+	}
+	return fmt.Sprintf("%v:%v:%v", result.File, result.Pos.Line, result.Pos.Column)
+}
+
+// setupName is the test name of the result of a file that could not be read, parsed or run.
+const setupName = "setup"
+
+// FindFiles returns the test files matched by the patterns, sorted.
+// A pattern can be a file, a directory for the test files in it or a directory followed by /...
+// for the test files in it and in all its subdirectories, except the ones named testdata
+// or starting with . or _. No patterns is the current directory.
+func FindFiles(patterns ...string) ([]string, error) {
+	if len(patterns) == 0 {
+		patterns = []string{"."}
+	}
+
+	found := make(map[string]struct{})
+	for _, pattern := range patterns {
+		if pattern == "..." || strings.HasSuffix(pattern, string(filepath.Separator)+"...") || strings.HasSuffix(pattern, "/...") {
+			root := filepath.Clean(strings.TrimSuffix(pattern, "..."))
+			err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+				if err != nil {
+					return err
+				}
+				if info.IsDir() {
+					name := info.Name()
+					if path != root && (name == "testdata" || strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_")) {
+						return filepath.SkipDir
+					}
+					return nil
+				}
+				if isTestFile(path) {
+					found[path] = struct{}{}
+				}
+				return nil
+			})
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		info, err := os.Stat(pattern)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			found[pattern] = struct{}{}
+			continue
+		}
+		files, err := filepath.Glob(filepath.Join(pattern, "*_test.ank"))
+		if err != nil {
+			return nil, err
+		}
+		for _, file := range files {
+			found[file] = struct{}{}
+		}
+	}
+
+	files := make([]string, 0, len(found))
+	for file := range found {
+		files = append(files, file)
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// isTestFile returns true if the file name ends with _test.ank.
+func isTestFile(path string) bool {
+	return strings.HasSuffix(filepath.Base(path), "_test.ank")
+}
+
+// RunFiles runs the tests of the files. A file that cannot be read, parsed or run
+// has a failed result named setup.
+func RunFiles(ctx context.Context, files []string, options *Options) []Result {
+	var results []Result
+	for _, file := range files {
+		fileResults, err := RunFile(ctx, file, options)
+		results = append(results, fileResults...)
+		if err != nil {
+			result := Result{File: file, Name: setupName}
+			result.fail(err)
+			results = append(results, result)
+		}
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	return results
+}
+
+// RunFile runs the tests of the file, in the order they are in the file.
+// Returns an error if the file cannot be read or parsed.
+func RunFile(ctx context.Context, file string, options *Options) ([]Result, error) {
+	if options == nil {
+		options = &Options{}
+	}
+	source, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	stmt, err := parser.ParseSrc(string(source))
+	if err != nil {
+		return nil, err
+	}
+
+	run := &testRun{file: file, stmt: stmt, options: options}
+	for _, name := range testNames(stmt) {
+		if options.Run != nil && !options.Run.MatchString(name) {
+			continue
+		}
+		run.test(ctx, name)
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	return run.results, nil
+}
+
+// testNames returns the names of the top-level functions named test_* without arguments.
+func testNames(stmt ast.Stmt) []string {
+	stmts, ok := stmt.(*ast.StmtsStmt)
+	if !ok {
+		return nil
+	}
+	var names []string
+	for _, stmt := range stmts.Stmts {
+		exprStmt, ok := stmt.(*ast.ExprStmt)
+		if !ok {
+			continue
+		}
+		funcExpr, ok := exprStmt.Expr.(*ast.FuncExpr)
+		if ok && strings.HasPrefix(funcExpr.Name, "test_") && len(funcExpr.Params) == 0 {
+			names = append(names, funcExpr.Name)
+		}
+	}
+	return names
+}
+
+// testRun runs the tests of a file and collects the results.
+type testRun struct {
+	file    string
+	stmt    ast.Stmt
+	options *Options
+	results []Result
+	// current is the result of the test or subtest that is running
+	current *Result
+}
+
+// test runs the test function name in a new env.
+func (run *testRun) test(ctx context.Context, name string) {
+	if run.options.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, run.options.Timeout)
+		defer cancel()
+	}
+
+	index, result := run.start(name)
+	start := time.Now()
+	err := run.runTest(ctx, name)
+	result.Duration = time.Since(start)
+	if ctx.Err() == context.DeadlineExceeded {
+		err = nil
+		result.Failed = true
+		result.Message = fmt.Sprintf("timed out after %v", run.options.Timeout)
+	}
+	run.finish(index, result, err)
+}
+
+// start adds the result of the test or subtest that starts running, before the results of its subtests.
+func (run *testRun) start(name string) (int, *Result) {
+	result := &Result{File: run.file, Name: name}
+	run.results = append(run.results, *result)
+	run.current = result
+	return len(run.results) - 1, result
+}
+
+// finish sets the result of the test or subtest that started at index. It fails if the error is not nil or a subtest failed,
+// with the name of the first failed subtest as message if it did not fail itself.
+func (run *testRun) finish(index int, result *Result, err error) {
+	if err != nil && !result.Skipped {
+		result.fail(err)
+	}
+	for _, subtest := range run.results[index+1:] {
+		if subtest.Failed && !result.Failed {
+			result.Failed = true
+			result.Message = "subtest " + subtest.Name + " failed"
+		}
+	}
+	run.results[index] = *result
+}
+
+// runTest runs the file and then calls the test function in a new env.
+func (run *testRun) runTest(ctx context.Context, name string) error {
+	e := env.NewEnv()
+	core.Import(e)
+	err := defineAssert(e, run)
+	if err != nil {
+		return err
+	}
+	if run.options.Setup != nil {
+		err = run.options.Setup(e)
+		if err != nil {
+			return err
+		}
+	}
+
+	vmOptions := vm.Options{}
+	if run.options.VMOptions != nil {
+		vmOptions = *run.options.VMOptions
+	}
+	vmOptions.ModuleLoader = vm.NewModuleLoader(vmOptions.FS)
+	vmOptions.ModuleLoader.Dir = filepath.Dir(run.file)
+	vmOptions.ModuleLoader.Env = env.NewEnv()
+	core.Import(vmOptions.ModuleLoader.Env)
+	if run.options.Coverage != nil {
+		vmOptions.Coverage = run.options.Coverage
+	}
+
+	_, err = vm.RunContext(ctx, e, &vmOptions, run.stmt)
+	if err != nil {
+		return err
+	}
+	stmt, err := parser.ParseSrc(name + "()")
+	if err != nil {
+		return err
+	}
+	_, err = vm.RunContext(ctx, e, &vmOptions, stmt)
+	return err
+}
+
+// subtest runs the function as the subtest name of the test that is running.
+func (run *testRun) subtest(ctx context.Context, name string, f *vm.Func) bool {
+	parent := run.current
+	index, result := run.start(parent.Name + "/" + name)
+	start := time.Now()
+	_, err := f.Call(ctx)
+	result.Duration = time.Since(start)
+	run.current = parent
+	if err == vm.ErrInterrupt {
+		// the test was interrupted, stop the test
+		panic(err)
+	}
+	run.finish(index, result, err)
+	return !result.Failed
+}
+
+// fail marks the result as failed with the error, using the position of errors from the vm.
+func (result *Result) fail(err error) {
+	result.Failed = true
+	if vmError, ok := err.(*vm.Error); ok {
+		result.Message = vmError.Message
+		result.Pos = vmError.Pos
+		return
+	}
+	result.Message = err.Error()
+}
@@ -0,0 +1,168 @@
+package ankotest
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mattn/anko/ast"
+	"github.com/mattn/anko/vm"
+)
+
+func TestFindFiles(t *testing.T) {
+	tests := []struct {
+		patterns []string
+		files    []string
+	}{
+		{patterns: []string{"testdata"}, files: []string{filepath.Join("testdata", "math_test.ank")}},
+		{patterns: []string{"testdata/..."}, files: []string{filepath.Join("testdata", "math_test.ank"), filepath.Join("testdata", "sub", "fail_test.ank")}},
+		{patterns: []string{"testdata/sub/fail_test.ank", "testdata/sub"}, files: []string{"testdata/sub/fail_test.ank"}},
+		{patterns: []string{"./..."}},
+	}
+	for _, test := range tests {
+		files, err := FindFiles(test.patterns...)
+		if err != nil {
+			t.Errorf("FindFiles error - received: %v - expected: %v - patterns: %v", err, nil, test.patterns)
+			continue
+		}
+		if len(files) != len(test.files) || len(files) > 0 && !reflect.DeepEqual(files, test.files) {
+			t.Errorf("FindFiles - received: %v - expected: %v - patterns: %v", files, test.files, test.patterns)
+		}
+	}
+
+	_, err := FindFiles("testdata/not-found")
+	if err == nil {
+		t.Errorf("FindFiles error - received: %v - expected: %v", err, "no such file or directory")
+	}
+}
+
+func TestRunFile(t *testing.T) {
+	results, err := RunFile(context.Background(), filepath.Join("testdata", "math_test.ank"), nil)
+	if err != nil {
+		t.Fatal("RunFile error:", err)
+	}
+	expected := []Result{
+		{Name: "test_double"},
+		{Name: "test_table"},
+		{Name: "test_table/one"},
+		{Name: "test_table/two"},
+		{Name: "test_assertions"},
+		{Name: "test_skip", Skipped: true, Message: "not ready"},
+	}
+	checkResults(t, results, expected)
+
+	file := filepath.Join("testdata", "sub", "fail_test.ank")
+	results, err = RunFile(context.Background(), file, &Options{Timeout: 50 * time.Millisecond})
+	if err != nil {
+		t.Fatal("RunFile error:", err)
+	}
+	expected = []Result{
+		{Name: "test_equal", Failed: true, Message: "equal - received: 1 - expected: 2 - a", Pos: ast.Position{Line: 3, Column: 2}},
+		{Name: "test_subtests", Failed: true, Message: "subtest test_subtests/fail failed"},
+		{Name: "test_subtests/pass"},
+		{Name: "test_subtests/fail", Failed: true, Message: "contains - [1 2] does not contain 3", Pos: ast.Position{Line: 11, Column: 3}},
+		{Name: "test_throw", Failed: true, Message: "thrown", Pos: ast.Position{Line: 17, Column: 2}},
+		{Name: "test_timeout", Failed: true, Message: "timed out after 50ms"},
+	}
+	checkResults(t, results, expected)
+
+	results, err = RunFile(context.Background(), file, &Options{Run: regexp.MustCompile("^test_eq")})
+	if err != nil {
+		t.Fatal("RunFile error:", err)
+	}
+	if len(results) != 1 || results[0].Name != "test_equal" {
+		t.Errorf("RunFile - received: %v - expected: %v", results, "test_equal")
+	}
+}
+
+func checkResults(t *testing.T, results []Result, expected []Result) {
+	t.Helper()
+	if len(results) != len(expected) {
+		t.Fatalf("RunFile - received: %v results - expected: %v results - %+v", len(results), len(expected), results)
+	}
+	for i, result := range results {
+		result.File = ""
+		result.Duration = 0
+		if !reflect.DeepEqual(result, expected[i]) {
+			t.Errorf("RunFile - received: %+v - expected: %+v", result, expected[i])
+		}
+	}
+}
+
+func TestRunFileCoverage(t *testing.T) {
+	coverage := vm.NewCoverage()
+	_, err := RunFile(context.Background(), filepath.Join("testdata", "math_test.ank"), &Options{Coverage: coverage})
+	if err != nil {
+		t.Fatal("RunFile error:", err)
+	}
+	files := coverage.Files()
+	if len(files) != 1 || filepath.Base(files[0].File) != "lib.ank" {
+		t.Fatalf("Files - received: %+v - expected: the coverage of lib.ank", files)
+	}
+	if percent := coverage.Percent(); percent != 100 {
+		t.Errorf("Percent - received: %v - expected: %v", percent, 100)
+	}
+}
+
+func TestRunFiles(t *testing.T) {
+	results := RunFiles(context.Background(), []string{filepath.Join("testdata", "lib.ank"), filepath.Join("testdata", "not-found_test.ank")}, nil)
+	if len(results) != 1 || results[0].Name != "setup" || !results[0].Failed {
+		t.Errorf("RunFiles - received: %+v - expected: a failed setup result", results)
+	}
+	if !Failed(results) {
+		t.Errorf("Failed - received: %v - expected: %v", false, true)
+	}
+}
+
+func TestWrite(t *testing.T) {
+	results := []Result{
+		{File: "a_test.ank", Name: "test_a", Duration: time.Second},
+		{File: "a_test.ank", Name: "test_b", Failed: true, Message: "b failed", Pos: ast.Position{Line: 2, Column: 3}},
+		{File: "a_test.ank", Name: "test_b/sub", Failed: true, Message: "sub failed"},
+		{File: "c_test.ank", Name: "test_c", Skipped: true, Message: "later"},
+	}
+
+	var buffer bytes.Buffer
+	err := WriteText(&buffer, results, false)
+	if err != nil {
+		t.Fatal("WriteText error:", err)
+	}
+	text := "--- FAIL: test_b (0.00s)\n    a_test.ank:2:3: b failed\n    --- FAIL: test_b/sub (0.00s)\n        a_test.ank: sub failed\n" +
+		"FAIL\ta_test.ank\t1.000s\n--- SKIP: test_c (0.00s)\n    later\nok\tc_test.ank\t0.000s\n"
+	if buffer.String() != text {
+		t.Errorf("WriteText - received: %q - expected: %q", buffer.String(), text)
+	}
+
+	buffer.Reset()
+	err = WriteTAP(&buffer, results)
+	if err != nil {
+		t.Fatal("WriteTAP error:", err)
+	}
+	tap := "TAP version 13\n1..4\nok 1 - a_test.ank test_a\nnot ok 2 - a_test.ank test_b\n  ---\n  message: \"b failed\"\n  at: \"a_test.ank:2:3\"\n  duration_ms: 0.000\n  ...\n" +
+		"not ok 3 - a_test.ank test_b/sub\n  ---\n  message: \"sub failed\"\n  at: \"a_test.ank\"\n  duration_ms: 0.000\n  ...\nok 4 - c_test.ank test_c # SKIP later\n"
+	if buffer.String() != tap {
+		t.Errorf("WriteTAP - received: %q - expected: %q", buffer.String(), tap)
+	}
+
+	buffer.Reset()
+	err = WriteJUnit(&buffer, results)
+	if err != nil {
+		t.Fatal("WriteJUnit error:", err)
+	}
+	for _, expected := range []string{
+		`<testsuites tests="4" failures="2" skipped="1" time="1.000">`,
+		`<testsuite name="a_test.ank" tests="3" failures="2" skipped="0" time="1.000">`,
+		`<testcase classname="a_test.ank" name="test_b" time="0.000">`,
+		`<failure message="b failed">a_test.ank:2:3: b failed</failure>`,
+		`<skipped message="later"></skipped>`,
+	} {
+		if !strings.Contains(buffer.String(), expected) {
+			t.Errorf("WriteJUnit - received: %v - expected to contain: %v", buffer.String(), expected)
+		}
+	}
+}
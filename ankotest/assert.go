@@ -0,0 +1,277 @@
+package ankotest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/mattn/anko/env"
+	"github.com/mattn/anko/vm"
+)
+
+// errSkip stops a test that called assert.skip.
+var errSkip = errors.New("test skipped")
+
+// defineAssert defines the assert module used by the tests, the assertions stop the test with an error when they fail.
+// Each assertion takes an optional message, which is added to the failure message.
+//
+//	assert.ok(value)                      value is true
+//	assert.equal(expected, actual)        the values are equal, numbers of any type are compared by value
+//	assert.notEqual(expected, actual)     the values are not equal
+//	assert.isNil(value)                   value is nil
+//	assert.notNil(value)                  value is not nil
+//	assert.contains(container, item)      a string contains a substring, a slice contains an item or a map has a key
+//	assert.throws(func)                   the function throws, returns the error message
+//	assert.fail(message)                  fails the test
+//	assert.skip(message)                  skips the test
+//	assert.run(name, func)                runs the function as a subtest, returns true if it passed
+func defineAssert(e *env.Env, run *testRun) error {
+	assert, err := e.NewModule("assert")
+	if err != nil {
+		return err
+	}
+
+	functions := map[string]interface{}{
+		"ok": func(value interface{}, message ...interface{}) {
+			if truth, isBool := value.(bool); !isBool || !truth {
+				fail("ok", message, "received: %v", formatValue(value))
+			}
+		},
+		"equal": func(expected interface{}, actual interface{}, message ...interface{}) {
+			if !equalValues(reflect.ValueOf(expected), reflect.ValueOf(actual)) {
+				fail("equal", message, "received: %v - expected: %v", formatValue(actual), formatValue(expected))
+			}
+		},
+		"notEqual": func(expected interface{}, actual interface{}, message ...interface{}) {
+			if equalValues(reflect.ValueOf(expected), reflect.ValueOf(actual)) {
+				fail("notEqual", message, "received: %v - expected a different value", formatValue(actual))
+			}
+		},
+		"isNil": func(value interface{}, message ...interface{}) {
+			if !isNil(value) {
+				fail("isNil", message, "received: %v - expected: nil", formatValue(value))
+			}
+		},
+		"notNil": func(value interface{}, message ...interface{}) {
+			if isNil(value) {
+				fail("notNil", message, "received: nil")
+			}
+		},
+		"contains": func(container interface{}, item interface{}, message ...interface{}) {
+			if !containsValue(reflect.ValueOf(container), reflect.ValueOf(item)) {
+				fail("contains", message, "%v does not contain %v", formatValue(container), formatValue(item))
+			}
+		},
+		"throws": func(ctx context.Context, fn interface{}, message ...interface{}) string {
+			f, err := vm.NewFunc(fn)
+			if err != nil {
+				fail("throws", message, "%v", err)
+			}
+			_, err = f.Call(ctx)
+			if err == vm.ErrInterrupt {
+				panic(err)
+			}
+			if err == nil {
+				fail("throws", message, "function did not throw")
+			}
+			if vmError, ok := err.(*vm.Error); ok {
+				return vmError.Message
+			}
+			return err.Error()
+		},
+		"fail": func(message ...interface{}) {
+			fail("fail", message, "")
+		},
+		"skip": func(message ...interface{}) {
+			run.current.Skipped = true
+			run.current.Message = fmt.Sprint(message...)
+			panic(errSkip)
+		},
+		"run": func(ctx context.Context, name string, fn interface{}) bool {
+			f, err := vm.NewFunc(fn)
+			if err != nil {
+				fail("run", nil, "%v", err)
+			}
+			return run.subtest(ctx, name, f)
+		},
+	}
+	for name, function := range functions {
+		err = assert.Define(name, function)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fail stops the test with the failure message of the assertion.
+func fail(assertion string, message []interface{}, format string, a ...interface{}) {
+	text := assertion
+	if format != "" {
+		text += " - " + fmt.Sprintf(format, a...)
+	}
+	if len(message) > 0 {
+		text += " - " + fmt.Sprint(message...)
+	}
+	panic(errors.New(text))
+}
+
+// formatValue formats a value for a failure message, strings are quoted.
+func formatValue(value interface{}) string {
+	if s, ok := value.(string); ok {
+		return fmt.Sprintf("%q", s)
+	}
+	if value == nil {
+		return "nil"
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+// isNil returns true if the value is nil or a nil pointer, map, slice, func, chan or interface.
+func isNil(value interface{}) bool {
+	rv := reflect.ValueOf(value)
+	if !rv.IsValid() {
+		return true
+	}
+	switch rv.Kind() {
+	case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Ptr, reflect.Slice:
+		return rv.IsNil()
+	}
+	return false
+}
+
+// equalValues returns true if the values are deeply equal. Numbers are compared by value,
+// so an int64 from a script equals an int from Go, and slices and maps are compared item by item.
+func equalValues(expected reflect.Value, actual reflect.Value) bool {
+	for expected.Kind() == reflect.Interface && !expected.IsNil() {
+		expected = expected.Elem()
+	}
+	for actual.Kind() == reflect.Interface && !actual.IsNil() {
+		actual = actual.Elem()
+	}
+	if isNilValue(expected) || isNilValue(actual) {
+		return isNilValue(expected) && isNilValue(actual)
+	}
+
+	switch {
+	case isNumber(expected) && isNumber(actual):
+		if isFloat(expected) || isFloat(actual) {
+			return toFloat(expected) == toFloat(actual)
+		}
+		return equalIntegers(expected, actual)
+	case (expected.Kind() == reflect.Slice || expected.Kind() == reflect.Array) &&
+		(actual.Kind() == reflect.Slice || actual.Kind() == reflect.Array):
+		if expected.Len() != actual.Len() {
+			return false
+		}
+		for i := 0; i < expected.Len(); i++ {
+			if !equalValues(expected.Index(i), actual.Index(i)) {
+				return false
+			}
+		}
+		return true
+	case expected.Kind() == reflect.Map && actual.Kind() == reflect.Map:
+		if expected.Len() != actual.Len() {
+			return false
+		}
+		for _, key := range expected.MapKeys() {
+			actualItem, ok := mapIndex(actual, key)
+			if !ok || !equalValues(expected.MapIndex(key), actualItem) {
+				return false
+			}
+		}
+		return true
+	}
+
+	if !expected.CanInterface() || !actual.CanInterface() {
+		return false
+	}
+	return reflect.DeepEqual(expected.Interface(), actual.Interface())
+}
+
+// isNilValue returns true if the reflect.Value is invalid or nil.
+func isNilValue(rv reflect.Value) bool {
+	return !rv.IsValid() || (rv.CanInterface() && isNil(rv.Interface()))
+}
+
+// mapIndex returns the item of the map with a key equal to key.
+func mapIndex(m reflect.Value, key reflect.Value) (reflect.Value, bool) {
+	for _, mapKey := range m.MapKeys() {
+		if equalValues(key, mapKey) {
+			return m.MapIndex(mapKey), true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+// containsValue returns true if a string contains a substring, a slice or array contains an item or a map has a key.
+func containsValue(container reflect.Value, item reflect.Value) bool {
+	for container.Kind() == reflect.Interface && !container.IsNil() {
+		container = container.Elem()
+	}
+	for item.Kind() == reflect.Interface && !item.IsNil() {
+		item = item.Elem()
+	}
+	switch container.Kind() {
+	case reflect.String:
+		return item.Kind() == reflect.String && strings.Contains(container.String(), item.String())
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < container.Len(); i++ {
+			if equalValues(container.Index(i), item) {
+				return true
+			}
+		}
+	case reflect.Map:
+		_, ok := mapIndex(container, item)
+		return ok
+	}
+	return false
+}
+
+func isNumber(rv reflect.Value) bool {
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+func isFloat(rv reflect.Value) bool {
+	return rv.Kind() == reflect.Float32 || rv.Kind() == reflect.Float64
+}
+
+func isUnsigned(rv reflect.Value) bool {
+	switch rv.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	}
+	return false
+}
+
+// equalIntegers compares signed and unsigned integers by value.
+func equalIntegers(a reflect.Value, b reflect.Value) bool {
+	switch {
+	case isUnsigned(a) && isUnsigned(b):
+		return a.Uint() == b.Uint()
+	case isUnsigned(a):
+		return b.Int() >= 0 && uint64(b.Int()) == a.Uint()
+	case isUnsigned(b):
+		return a.Int() >= 0 && uint64(a.Int()) == b.Uint()
+	}
+	return a.Int() == b.Int()
+}
+
+func toFloat(rv reflect.Value) float64 {
+	switch rv.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return rv.Float()
+	}
+	if isUnsigned(rv) {
+		return float64(rv.Uint())
+	}
+	return float64(rv.Int())
+}
@@ -0,0 +1,191 @@
+package ankotest
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// Failed returns true if any of the results failed.
+func Failed(results []Result) bool {
+	for _, result := range results {
+		if result.Failed {
+			return true
+		}
+	}
+	return false
+}
+
+// WriteText writes the results like go test does, the tests that failed or were skipped
+// and then ok or FAIL for each file. With verbose all tests are written.
+func WriteText(w io.Writer, results []Result, verbose bool) error {
+	var err error
+	write := func(format string, a ...interface{}) {
+		if err == nil {
+			_, err = fmt.Fprintf(w, format, a...)
+		}
+	}
+
+	for _, file := range groupByFile(results) {
+		var duration time.Duration
+		failed := false
+		for _, result := range file {
+			indent := strings.Repeat("    ", strings.Count(result.Name, "/"))
+			switch {
+			case result.Failed:
+				failed = true
+				write("%v--- FAIL: %v (%.2fs)\n", indent, result.Name, result.Duration.Seconds())
+				if result.Message != "" {
+					write("%v    %v: %v\n", indent, result.Location(), result.Message)
+				}
+			case result.Skipped:
+				write("%v--- SKIP: %v (%.2fs)\n", indent, result.Name, result.Duration.Seconds())
+				if result.Message != "" {
+					write("%v    %v\n", indent, result.Message)
+				}
+			case verbose:
+				write("%v--- PASS: %v (%.2fs)\n", indent, result.Name, result.Duration.Seconds())
+			}
+			if !strings.Contains(result.Name, "/") {
+				duration += result.Duration
+			}
+		}
+		if failed {
+			write("FAIL\t%v\t%.3fs\n", file[0].File, duration.Seconds())
+		} else {
+			write("ok\t%v\t%.3fs\n", file[0].File, duration.Seconds())
+		}
+	}
+	return err
+}
+
+// WriteTAP writes the results in the Test Anything Protocol version 13,
+// with the failure message and location in a YAML block.
+func WriteTAP(w io.Writer, results []Result) error {
+	var err error
+	write := func(format string, a ...interface{}) {
+		if err == nil {
+			_, err = fmt.Fprintf(w, format, a...)
+		}
+	}
+
+	write("TAP version 13\n")
+	write("1..%v\n", len(results))
+	for i, result := range results {
+		description := strings.Replace(result.File+" "+result.Name, "#", "\\#", -1)
+		switch {
+		case result.Failed:
+			write("not ok %v - %v\n", i+1, description)
+			write("  ---\n")
+			write("  message: %q\n", result.Message)
+			write("  at: %q\n", result.Location())
+			write("  duration_ms: %.3f\n", float64(result.Duration)/float64(time.Millisecond))
+			write("  ...\n")
+		case result.Skipped:
+			write("ok %v - %v # SKIP %v\n", i+1, description, result.Message)
+		default:
+			write("ok %v - %v\n", i+1, description)
+		}
+	}
+	return err
+}
+
+// junitTestSuites is the root element of a JUnit XML report.
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+	Time     string           `xml:"time,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+// junitTestSuite has the tests of a file.
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Skipped  int             `xml:"skipped,attr"`
+	Time     string          `xml:"time,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+// junitTestCase is a test or subtest.
+type junitTestCase struct {
+	ClassName string        `xml:"classname,attr"`
+	Name      string        `xml:"name,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Skipped   *junitMessage `xml:"skipped,omitempty"`
+}
+
+// junitMessage is the failure or the reason a test was skipped.
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// WriteJUnit writes the results as JUnit XML, with a test suite for each file.
+func WriteJUnit(w io.Writer, results []Result) error {
+	var suites junitTestSuites
+	var duration time.Duration
+	for _, file := range groupByFile(results) {
+		suite := junitTestSuite{Name: file[0].File}
+		var suiteDuration time.Duration
+		for _, result := range file {
+			testCase := junitTestCase{ClassName: result.File, Name: result.Name, Time: seconds(result.Duration)}
+			switch {
+			case result.Failed:
+				suite.Failures++
+				testCase.Failure = &junitMessage{Message: result.Message, Text: result.Location() + ": " + result.Message}
+			case result.Skipped:
+				suite.Skipped++
+				testCase.Skipped = &junitMessage{Message: result.Message}
+			}
+			if !strings.Contains(result.Name, "/") {
+				suiteDuration += result.Duration
+			}
+			suite.Cases = append(suite.Cases, testCase)
+		}
+		suite.Tests = len(suite.Cases)
+		suite.Time = seconds(suiteDuration)
+		suites.Tests += suite.Tests
+		suites.Failures += suite.Failures
+		suites.Skipped += suite.Skipped
+		duration += suiteDuration
+		suites.Suites = append(suites.Suites, suite)
+	}
+	suites.Time = seconds(duration)
+
+	_, err := io.WriteString(w, xml.Header)
+	if err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "\t")
+	err = encoder.Encode(suites)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "\n")
+	return err
+}
+
+// groupByFile splits the results by file, keeping the order of the files.
+func groupByFile(results []Result) [][]Result {
+	var files [][]Result
+	for _, result := range results {
+		if len(files) == 0 || files[len(files)-1][0].File != result.File {
+			files = append(files, nil)
+		}
+		files[len(files)-1] = append(files[len(files)-1], result)
+	}
+	return files
+}
+
+// seconds formats a duration in seconds for JUnit XML.
+func seconds(duration time.Duration) string {
+	return fmt.Sprintf("%.3f", duration.Seconds())
+}
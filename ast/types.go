@@ -355,3 +355,22 @@ func (e *LetsExpr) Position() Position {
 func (e *LetsExpr) SetPosition(pos Position) {
 	e.position = pos
 }
+
+// PipeOperator represents a pipeline operator |>
+type PipeOperator struct {
+	LHS      Expr
+	RHS      Expr
+	position Position
+}
+
+func (o *PipeOperator) Position() Position {
+	return o.position
+}
+
+func (o *PipeOperator) SetPosition(pos Position) {
+	o.position = pos
+}
+
+func (o *PipeOperator) String() string {
+	return "|>"
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// parseGoVersion returns the minor version of a Go version like 1.16 or go1.16.
+func parseGoVersion(version string) (int, error) {
+	version = strings.TrimPrefix(version, "go")
+	parts := strings.Split(version, ".")
+	if len(parts) < 2 || parts[0] != "1" {
+		return 0, fmt.Errorf("invalid Go version %q", version)
+	}
+	minor, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, fmt.Errorf("invalid Go version %q", version)
+	}
+	return minor, nil
+}
+
+// apiVersions returns the minor Go version each exported symbol of the standard library package was added in,
+// read from the api files of goroot. Symbols are named like in the package, methods like Type.Method.
+func apiVersions(goroot string, pkgPath string) (map[string]int, error) {
+	files, err := filepath.Glob(filepath.Join(goroot, "api", "go1*.txt"))
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no api files in %v", filepath.Join(goroot, "api"))
+	}
+
+	versions := make(map[string]int)
+	for _, file := range files {
+		minor := 0
+		base := strings.TrimSuffix(filepath.Base(file), ".txt")
+		if base != "go1" {
+			minor, err = parseGoVersion(base)
+			if err != nil {
+				continue
+			}
+		}
+		err = readAPIFile(file, pkgPath, func(name string) {
+			if version, ok := versions[name]; !ok || minor < version {
+				versions[name] = minor
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return versions, nil
+}
+
+// readAPIFile calls add with the name of each func, const, var, type and method of the package in the api file.
+// The lines of the api files look like:
+//
+//	pkg strings, func Cut(string, string) (string, string, bool)
+//	pkg strings, method (*Builder) Cap() int
+//	pkg syscall (linux-386), const AF_INET = 2
+func readAPIFile(file string, pkgPath string, add func(name string)) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimPrefix(scanner.Text(), "pkg ")
+		i := strings.Index(line, ", ")
+		if i < 0 {
+			continue
+		}
+		if fields := strings.Fields(line[:i]); len(fields) == 0 || fields[0] != pkgPath {
+			continue
+		}
+		fields := strings.Fields(line[i+2:])
+		if len(fields) < 2 {
+			continue
+		}
+		switch fields[0] {
+		case "func", "const", "var", "type":
+			add(apiName(fields[1]))
+		case "method":
+			if len(fields) >= 3 {
+				add(apiName(strings.TrimLeft(fields[1], "(*")) + "." + apiName(fields[2]))
+			}
+		}
+	}
+	return scanner.Err()
+}
+
+// apiName returns the name at the start of the field of an api file line.
+func apiName(field string) string {
+	if i := strings.IndexAny(field, "([,)"); i >= 0 {
+		return field[:i]
+	}
+	return field
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"go/ast"
+	"go/doc"
+)
+
+// symbolDocs returns the first sentence of the documentation of the exported symbols declared in the files,
+// with methods named like Type.Method. Values and types declared in a group without their own documentation get the one
+// of the group, and the comment at the end of their line when the group has none either.
+func symbolDocs(files []*ast.File) map[string]string {
+	docs := make(map[string]string)
+	add := func(name string, groups ...*ast.CommentGroup) {
+		for _, group := range groups {
+			if synopsis := doc.Synopsis(group.Text()); synopsis != "" {
+				docs[name] = synopsis
+				return
+			}
+		}
+	}
+
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			switch decl := decl.(type) {
+			case *ast.FuncDecl:
+				if !decl.Name.IsExported() {
+					continue
+				}
+				if decl.Recv == nil {
+					add(decl.Name.Name, decl.Doc)
+					continue
+				}
+				if len(decl.Recv.List) == 1 {
+					receiver := receiverName(decl.Recv.List[0].Type)
+					if ast.IsExported(receiver) {
+						add(receiver+"."+decl.Name.Name, decl.Doc)
+					}
+				}
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					switch spec := spec.(type) {
+					case *ast.TypeSpec:
+						if spec.Name.IsExported() {
+							add(spec.Name.Name, spec.Doc, decl.Doc, spec.Comment)
+						}
+					case *ast.ValueSpec:
+						for _, name := range spec.Names {
+							if name.IsExported() {
+								add(name.Name, spec.Doc, decl.Doc, spec.Comment)
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return docs
+}
+
+// receiverName returns the name of the type of a method receiver, empty if it is not a named type.
+func receiverName(expr ast.Expr) string {
+	switch expr := expr.(type) {
+	case *ast.Ident:
+		return expr.Name
+	case *ast.StarExpr:
+		return receiverName(expr.X)
+	case *ast.ParenExpr:
+		return receiverName(expr.X)
+	case *ast.IndexExpr:
+		return receiverName(expr.X)
+	}
+	return ""
+}
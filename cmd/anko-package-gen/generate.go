@@ -0,0 +1,290 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/build"
+	"go/build/constraint"
+	"go/constant"
+	"go/format"
+	"go/types"
+	"math"
+	"path"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// generateOptions are the options of the generated code.
+type generateOptions struct {
+	// packageName is the package name of the generated code
+	packageName string
+	// build is the build constraint of the generated code
+	build string
+	// goMinor is the minor Go version of the standard library symbols to bind, 0 for all
+	goMinor int
+	// exclude are the symbols not to bind
+	exclude map[string]bool
+	// docs is true to register the documentation of the symbols in env.PackageDocs
+	docs bool
+}
+
+// binding is a symbol of the package bound for scripts, or the reason it is skipped.
+type binding struct {
+	name string
+	expr string
+	skip string
+}
+
+// generated is the code generated for a package.
+type generated struct {
+	source []byte
+	// test is the test importing the package in a VM
+	test []byte
+}
+
+// generate returns the gofmt-ed code binding the package and its test.
+func generate(pkg *loadedPackage, options *generateOptions) (*generated, error) {
+	var versions map[string]int
+	if pkg.goroot && options.goMinor > 0 {
+		var err error
+		versions, err = apiVersions(build.Default.GOROOT, pkg.path)
+		if err != nil {
+			return nil, err
+		}
+	}
+	included := func(name string) bool {
+		version, ok := versions[name]
+		return !ok || version <= options.goMinor
+	}
+
+	alias := importAlias(pkg.types)
+	var values, types []binding
+	bound := make(map[string]bool)
+	scope := pkg.types.Scope()
+	for _, name := range scope.Names() {
+		object := scope.Lookup(name)
+		if !object.Exported() || options.exclude[name] || !included(name) {
+			continue
+		}
+		value, typ := bind(object, alias)
+		if value.name != "" {
+			values = append(values, value)
+			bound[name] = value.skip == ""
+		}
+		if typ.name != "" {
+			types = append(types, typ)
+			bound[name] = typ.skip == ""
+		}
+	}
+
+	var docs []binding
+	if options.docs {
+		for name, synopsis := range symbolDocs(pkg.files) {
+			typeName := strings.SplitN(name, ".", 2)[0]
+			if bound[typeName] && included(name) {
+				docs = append(docs, binding{name: name, expr: fmt.Sprintf("%q", synopsis)})
+			}
+		}
+	}
+
+	var buffer bytes.Buffer
+	err := writeHeader(&buffer, options)
+	if err != nil {
+		return nil, err
+	}
+	buffer.WriteString("import (\n")
+	if alias != path.Base(pkg.path) {
+		fmt.Fprintf(&buffer, "%v %q\n", alias, pkg.path)
+	} else {
+		fmt.Fprintf(&buffer, "%q\n", pkg.path)
+	}
+	buffer.WriteString("\"reflect\"\n\n\"github.com/mattn/anko/env\"\n)\n\n")
+	buffer.WriteString("func init() {\n")
+	fmt.Fprintf(&buffer, "env.Packages[%q] = map[string]reflect.Value{\n", pkg.path)
+	writeBindings(&buffer, values)
+	buffer.WriteString("}\n")
+	if len(types) > 0 {
+		fmt.Fprintf(&buffer, "env.PackageTypes[%q] = map[string]reflect.Type{\n", pkg.path)
+		writeBindings(&buffer, types)
+		buffer.WriteString("}\n")
+	}
+	if len(docs) > 0 {
+		fmt.Fprintf(&buffer, "env.PackageDocs[%q] = map[string]string{\n", pkg.path)
+		writeBindings(&buffer, docs)
+		buffer.WriteString("}\n")
+	}
+	buffer.WriteString("}\n")
+	source, err := format.Source(buffer.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	test, err := generateTest(pkg, options)
+	if err != nil {
+		return nil, err
+	}
+	return &generated{source: source, test: test}, nil
+}
+
+// generateTest returns the gofmt-ed test that imports the package in a VM and checks that each symbol is defined.
+func generateTest(pkg *loadedPackage, options *generateOptions) ([]byte, error) {
+	var buffer bytes.Buffer
+	err := writeHeader(&buffer, options)
+	if err != nil {
+		return nil, err
+	}
+	buffer.WriteString("import (\n\"testing\"\n\n\"github.com/mattn/anko/env\"\n\"github.com/mattn/anko/vm\"\n)\n\n")
+	fmt.Fprintf(&buffer, "func TestPackage%v(t *testing.T) {\n", testName(pkg.path))
+	fmt.Fprintf(&buffer, "value, err := vm.Execute(env.NewEnv(), nil, `import(%q)`)\n", pkg.path)
+	fmt.Fprintf(&buffer, `if err != nil {
+	t.Fatal("import error:", err)
+}
+imported, ok := value.(*env.Env)
+if !ok {
+	t.Fatalf("import - received: %%T - expected: *env.Env", value)
+}
+for name := range env.Packages[%[1]q] {
+	if _, err := imported.Get(name); err != nil {
+		t.Errorf("Get %%v error: %%v", name, err)
+	}
+}
+for name := range env.PackageTypes[%[1]q] {
+	if _, err := imported.Type(name); err != nil {
+		t.Errorf("Type %%v error: %%v", name, err)
+	}
+}
+}
+`, pkg.path)
+	return format.Source(buffer.Bytes())
+}
+
+// writeHeader writes the generated code comment, the build constraint and the package clause.
+func writeHeader(buffer *bytes.Buffer, options *generateOptions) error {
+	buffer.WriteString("// Code generated by anko-package-gen. DO NOT EDIT.\n\n")
+	if options.build != "" {
+		expr, err := constraint.Parse("//go:build " + options.build)
+		if err != nil {
+			return fmt.Errorf("invalid build constraint %q: %v", options.build, err)
+		}
+		fmt.Fprintf(buffer, "//go:build %v\n", expr)
+		plusBuild, err := constraint.PlusBuildLines(expr)
+		if err != nil {
+			return err
+		}
+		for _, line := range plusBuild {
+			buffer.WriteString(line + "\n")
+		}
+		buffer.WriteString("\n")
+	}
+	fmt.Fprintf(buffer, "package %v\n\n", options.packageName)
+	return nil
+}
+
+// testName returns the import path as a Go identifier, like MathBig for math/big.
+func testName(importPath string) string {
+	var builder strings.Builder
+	upper := true
+	for _, r := range importPath {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		builder.WriteRune(r)
+	}
+	return builder.String()
+}
+
+// writeBindings writes the map entries of the bindings, followed by comments for the skipped ones.
+func writeBindings(buffer *bytes.Buffer, bindings []binding) {
+	sort.Slice(bindings, func(i, j int) bool { return bindings[i].name < bindings[j].name })
+	var skipped []binding
+	for _, binding := range bindings {
+		if binding.skip != "" {
+			skipped = append(skipped, binding)
+			continue
+		}
+		fmt.Fprintf(buffer, "%q: %v,\n", binding.name, binding.expr)
+	}
+	for _, binding := range skipped {
+		fmt.Fprintf(buffer, "// %v is skipped: %v\n", binding.name, binding.skip)
+	}
+}
+
+// importAlias returns the name the package is imported with, renamed when it conflicts with the imports of the generated code.
+func importAlias(pkg *types.Package) string {
+	switch pkg.Name() {
+	case "reflect", "env":
+		return pkg.Name() + "pkg"
+	}
+	return pkg.Name()
+}
+
+// bind returns the value binding and the type binding of the package level object, with empty names when there is none.
+func bind(object types.Object, alias string) (value binding, typ binding) {
+	name := object.Name()
+	qualified := alias + "." + name
+	switch object := object.(type) {
+	case *types.Func:
+		value = binding{name: name, expr: "reflect.ValueOf(" + qualified + ")"}
+		if isGeneric(object) {
+			value.skip = "generic function"
+		}
+	case *types.Var:
+		value = binding{name: name, expr: "reflect.ValueOf(" + qualified + ")"}
+	case *types.Const:
+		value = binding{name: name}
+		value.expr, value.skip = constantExpr(object, qualified)
+	case *types.TypeName:
+		typ = binding{name: name, expr: "reflect.TypeOf((*" + qualified + ")(nil)).Elem()"}
+		if _, isStruct := object.Type().Underlying().(*types.Struct); isStruct {
+			typ.expr = "reflect.TypeOf(" + qualified + "{})"
+		}
+		if isGeneric(object) {
+			typ.skip = "generic type"
+		}
+	}
+	return
+}
+
+// constantExpr returns the expression of the value of the constant, or why it is skipped.
+// Untyped constants get their default type, except integers that do not fit in 32 bits,
+// which are converted to int64 or uint64 so they have the same type on every platform.
+func constantExpr(object *types.Const, qualified string) (string, string) {
+	expr := "reflect.ValueOf(" + qualified + ")"
+	basic, ok := object.Type().(*types.Basic)
+	if !ok || basic.Info()&types.IsUntyped == 0 {
+		return expr, ""
+	}
+	value := object.Val()
+	switch basic.Kind() {
+	case types.UntypedInt, types.UntypedRune:
+		if x, exact := constant.Int64Val(value); exact {
+			if x < math.MinInt32 || x > math.MaxInt32 {
+				return "reflect.ValueOf(int64(" + qualified + "))", ""
+			}
+			return expr, ""
+		}
+		if _, exact := constant.Uint64Val(value); exact {
+			return "reflect.ValueOf(uint64(" + qualified + "))", ""
+		}
+		return "", "untyped constant " + value.ExactString() + " overflows uint64"
+	case types.UntypedFloat:
+		if x, _ := constant.Float64Val(value); math.IsInf(x, 0) {
+			return "", "untyped constant " + value.String() + " overflows float64"
+		}
+	case types.UntypedComplex:
+		real, _ := constant.Float64Val(constant.Real(value))
+		imag, _ := constant.Float64Val(constant.Imag(value))
+		if math.IsInf(real, 0) || math.IsInf(imag, 0) {
+			return "", "untyped constant " + value.String() + " overflows complex128"
+		}
+	case types.UntypedNil:
+		return "", "untyped nil"
+	}
+	return expr, ""
+}
@@ -0,0 +1,19 @@
+//go:build go1.18
+// +build go1.18
+
+package main
+
+import "go/types"
+
+// isGeneric returns true if the function or type has type parameters, it cannot be bound without being instantiated.
+func isGeneric(object types.Object) bool {
+	switch object := object.(type) {
+	case *types.Func:
+		return object.Type().(*types.Signature).TypeParams().Len() > 0
+	case *types.TypeName:
+		if named, ok := object.Type().(*types.Named); ok {
+			return named.TypeParams().Len() > 0
+		}
+	}
+	return false
+}
@@ -0,0 +1,11 @@
+//go:build !go1.18
+// +build !go1.18
+
+package main
+
+import "go/types"
+
+// isGeneric returns false, Go versions before 1.18 have no type parameters.
+func isGeneric(object types.Object) bool {
+	return false
+}
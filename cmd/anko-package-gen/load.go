@@ -0,0 +1,178 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// loader loads packages from source and type checks them.
+// It is the importer of the packages it checks, so their dependencies are loaded with the same build context.
+type loader struct {
+	context *build.Context
+	fset    *token.FileSet
+	// packages are the checked dependencies by import path, nil while being checked
+	packages map[string]*types.Package
+}
+
+// newLoader returns a loader that finds packages with the build context.
+func newLoader(context *build.Context) *loader {
+	return &loader{context: context, fset: token.NewFileSet(), packages: make(map[string]*types.Package)}
+}
+
+// loadedPackage is a type checked package.
+type loadedPackage struct {
+	// path is the import path of the package
+	path string
+	// goroot is true for packages of the standard library
+	goroot bool
+	types  *types.Package
+	files  []*ast.File
+}
+
+// load loads the package of the import path or of the directory.
+func (l *loader) load(arg string) (*loadedPackage, error) {
+	var buildPackage *build.Package
+	var err error
+	if build.IsLocalImport(arg) || filepath.IsAbs(arg) {
+		var dir string
+		dir, err = filepath.Abs(arg)
+		if err != nil {
+			return nil, err
+		}
+		buildPackage, err = l.context.ImportDir(dir, 0)
+		if err == nil {
+			buildPackage.ImportPath, err = dirImportPath(dir)
+		}
+	} else {
+		buildPackage, err = l.context.Import(arg, ".", 0)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if buildPackage.Name == "main" {
+		return nil, fmt.Errorf("%v is a command, not a package", arg)
+	}
+
+	files, err := l.parseFiles(buildPackage, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &types.Config{Importer: l}
+	typesPackage, err := config.Check(buildPackage.ImportPath, l.fset, files, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &loadedPackage{path: buildPackage.ImportPath, goroot: buildPackage.Goroot, types: typesPackage, files: files}, nil
+}
+
+// parseFiles parses the Go files of the package.
+func (l *loader) parseFiles(buildPackage *build.Package, mode parser.Mode) ([]*ast.File, error) {
+	files := make([]*ast.File, 0, len(buildPackage.GoFiles))
+	for _, name := range buildPackage.GoFiles {
+		file, err := parser.ParseFile(l.fset, filepath.Join(buildPackage.Dir, name), nil, mode)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+	return files, nil
+}
+
+// Import implements types.Importer.
+func (l *loader) Import(importPath string) (*types.Package, error) {
+	return l.ImportFrom(importPath, ".", 0)
+}
+
+// ImportFrom implements types.ImporterFrom, type checking the package imported from srcDir without its function bodies.
+func (l *loader) ImportFrom(importPath, srcDir string, mode types.ImportMode) (*types.Package, error) {
+	if importPath == "unsafe" {
+		return types.Unsafe, nil
+	}
+	buildPackage, err := l.context.Import(importPath, srcDir, 0)
+	if err != nil {
+		return nil, err
+	}
+	if typesPackage, ok := l.packages[buildPackage.ImportPath]; ok {
+		if typesPackage == nil {
+			return nil, fmt.Errorf("import cycle through package %v", buildPackage.ImportPath)
+		}
+		return typesPackage, nil
+	}
+	l.packages[buildPackage.ImportPath] = nil
+
+	files, err := l.parseFiles(buildPackage, 0)
+	if err != nil {
+		delete(l.packages, buildPackage.ImportPath)
+		return nil, err
+	}
+
+	var hardErr error
+	config := &types.Config{
+		Importer:         l,
+		IgnoreFuncBodies: true,
+		// without the function bodies some imports are unused, only stop on hard errors
+		Error: func(err error) {
+			if typesErr, ok := err.(types.Error); hardErr == nil && (!ok || !typesErr.Soft) {
+				hardErr = err
+			}
+		},
+	}
+	typesPackage, _ := config.Check(buildPackage.ImportPath, l.fset, files, nil)
+	if hardErr != nil {
+		delete(l.packages, buildPackage.ImportPath)
+		return nil, hardErr
+	}
+	l.packages[buildPackage.ImportPath] = typesPackage
+	return typesPackage, nil
+}
+
+// dirImportPath returns the import path of the package in dir, from the go.mod file of its module.
+func dirImportPath(dir string) (string, error) {
+	for moduleDir := dir; ; moduleDir = filepath.Dir(moduleDir) {
+		modulePath, err := readModulePath(filepath.Join(moduleDir, "go.mod"))
+		if err == nil {
+			rel, err := filepath.Rel(moduleDir, dir)
+			if err != nil {
+				return "", err
+			}
+			return path.Join(modulePath, filepath.ToSlash(rel)), nil
+		}
+		if !os.IsNotExist(err) {
+			return "", err
+		}
+		if filepath.Dir(moduleDir) == moduleDir {
+			return "", fmt.Errorf("%v is not in a module", dir)
+		}
+	}
+}
+
+// readModulePath returns the module path of the go.mod file.
+func readModulePath(file string) (string, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) >= 2 && fields[0] == "module" {
+			return strings.Trim(fields[1], "\"`"), nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+	return "", fmt.Errorf("%v has no module path", file)
+}
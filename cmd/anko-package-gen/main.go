@@ -1,122 +1,120 @@
+// Command anko-package-gen generates the Go code that binds packages for Anko scripts,
+// registering the functions, variables and constants of each package in env.Packages
+// and its types in env.PackageTypes:
+//
+//	anko-package-gen [flags] package...
+//
+// Packages are import paths, like strings or github.com/user/module/rules, or directories
+// of packages of the current module, like ./rules. The packages are loaded from source
+// and type checked with their dependencies, so the generated code builds with the same build tags.
+// Generic functions and types are skipped, they cannot be used without being instantiated.
+// Untyped integer constants that do not fit in 32 bits are bound as int64 or uint64.
+//
+// The first sentence of the documentation of each symbol and method is registered in env.PackageDocs,
+// methods named like Type.Method. With -test, a test importing each package in a VM is written next to its code.
+//
+// The packages directory of Anko is generated with it, see packages/generate.go.
 package main
 
 import (
+	"flag"
 	"fmt"
-	"go/ast"
-	"go/parser"
-	"go/token"
-	"log"
+	"go/build"
 	"os"
-	"os/exec"
 	"path/filepath"
-	"sort"
 	"strings"
 )
 
-func pkgName(f string) string {
-	file, err := parser.ParseFile(token.NewFileSet(), f, nil, parser.PackageClauseOnly)
-	if err != nil || file == nil {
-		return ""
-	}
-	return file.Name.Name
-}
-
-func isGoFile(dir os.FileInfo) bool {
-	return !dir.IsDir() &&
-		!strings.HasPrefix(dir.Name(), ".") && // ignore .files
-		filepath.Ext(dir.Name()) == ".go"
-}
-
-func isPkgFile(dir os.FileInfo) bool {
-	return isGoFile(dir) && !strings.HasSuffix(dir.Name(), "_test.go") // ignore test files
+func main() {
+	os.Exit(run(os.Args[1:]))
 }
 
-func parseDir(p string) (map[string]*ast.Package, error) {
-	isGoFile := func(d os.FileInfo) bool {
-		return !d.IsDir() && !strings.HasSuffix(d.Name(), "_test.go") && !strings.HasPrefix(d.Name(), "example_")
+// run generates the packages given in the arguments. Returns the exit code.
+func run(arguments []string) int {
+	flagSet := flag.NewFlagSet("anko-package-gen", flag.ContinueOnError)
+	flagSet.Usage = func() {
+		fmt.Fprintln(flagSet.Output(), "Usage: anko-package-gen [flags] package...")
+		flagSet.PrintDefaults()
 	}
-
-	pkgs, err := parser.ParseDir(token.NewFileSet(), p, isGoFile, parser.ParseComments)
-	if err != nil {
-		return nil, err
+	flagDir := flagSet.String("d", "", "write each package to a file in the directory, named after the import path with . instead of /, instead of stdout")
+	flagPackage := flagSet.String("p", "packages", "package name of the generated code")
+	flagTags := flagSet.String("tags", "", "comma separated build tags to load the packages with")
+	flagBuild := flagSet.String("build", "", "build constraint of the generated code, like !appengine")
+	flagGo := flagSet.String("go", "", "only bind the symbols of the standard library added up to this Go version, like 1.16")
+	flagExclude := flagSet.String("exclude", "", "comma separated symbols not to bind")
+	flagDoc := flagSet.Bool("doc", true, "register the first sentence of the documentation of each symbol in env.PackageDocs")
+	flagTest := flagSet.Bool("test", false, "write a test importing each package in a VM, requires -d")
+	if err := flagSet.Parse(arguments); err != nil {
+		return 2
 	}
-	return pkgs, nil
-}
-
-func main() {
-	pkg := "flag"
-	if len(os.Args) == 2 {
-		pkg = os.Args[1]
+	if flagSet.NArg() < 1 || *flagTest && *flagDir == "" {
+		flagSet.Usage()
+		return 2
 	}
-	b, err := exec.Command("go", "env", "GOROOT").CombinedOutput()
-	if err != nil {
-		log.Fatal(err)
+
+	options := &generateOptions{
+		packageName: *flagPackage,
+		build:       *flagBuild,
+		exclude:     make(map[string]bool),
+		docs:        *flagDoc,
 	}
-	paths := []string{filepath.Join(strings.TrimSpace(string(b)), "src")}
-	b, err = exec.Command("go", "env", "GOPATH").CombinedOutput()
-	if err != nil {
-		log.Fatal(err)
+	if *flagGo != "" {
+		var err error
+		options.goMinor, err = parseGoVersion(*flagGo)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 2
+		}
 	}
-	for _, p := range strings.Split(strings.TrimSpace(string(b)), string(filepath.ListSeparator)) {
-		paths = append(paths, filepath.Join(p, "src"))
+	for _, name := range splitList(*flagExclude) {
+		options.exclude[name] = true
 	}
-	for _, p := range paths {
-		pp := filepath.Join(p, pkg)
-		pkgs, err := parseDir(pp)
-		if err != nil || len(pkgs) == 0 {
+
+	context := build.Default
+	context.BuildTags = splitList(*flagTags)
+	// cgo files cannot be type checked without running cgo, the packages are loaded without them
+	context.CgoEnabled = false
+	loader := newLoader(&context)
+
+	exitCode := 0
+	for _, arg := range flagSet.Args() {
+		pkg, err := loader.load(arg)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			exitCode = 1
 			continue
 		}
-		names := map[string]bool{}
-		pn := pkg
-		for _, pp := range pkgs {
-			pn = pp.Name
-			for _, f := range pp.Files {
-				for _, d := range f.Decls {
-					switch decl := d.(type) {
-					case *ast.GenDecl:
-						for _, spec := range decl.Specs {
-							if vspec, ok := spec.(*ast.ValueSpec); ok {
-								for _, n := range vspec.Names {
-									c := n.Name[0]
-									if c < 'A' || c > 'Z' {
-										continue
-									}
-									names[n.Name] = true
-								}
-							}
-						}
-					case *ast.FuncDecl:
-						if decl.Recv != nil {
-							continue
-						}
-						c := decl.Name.Name[0]
-						if c < 'A' || c > 'Z' {
-							continue
-						}
-						names[decl.Name.Name] = true
-					}
-				}
-			}
+		code, err := generate(pkg, options)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v: %v\n", arg, err)
+			exitCode = 1
+			continue
 		}
-		keys := []string{}
-		for k := range names {
-			keys = append(keys, k)
+		if *flagDir == "" {
+			os.Stdout.Write(code.source)
+			continue
 		}
-		sort.Strings(keys)
-		fmt.Printf(`// Package %s implements %s interface for anko script.
-package %s
-
-import (
-	"%s"
-)
+		file := filepath.Join(*flagDir, strings.Replace(pkg.path, "/", ".", -1))
+		err = os.WriteFile(file+".go", code.source, 0644)
+		if err == nil && *flagTest {
+			err = os.WriteFile(file+"_test.go", code.test, 0644)
+		}
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			exitCode = 1
+		}
+	}
+	return exitCode
+}
 
-func init() {
-	Packages["%s"] = map[string]interface{}{
-`, pn, pkg, pn, pkg, pn)
-		for _, k := range keys {
-			fmt.Printf(`	"%s": %s.%s,`+"\n", k, pn, k)
+// splitList returns the items of a comma separated list.
+func splitList(list string) []string {
+	var items []string
+	for _, item := range strings.Split(list, ",") {
+		item = strings.TrimSpace(item)
+		if item != "" {
+			items = append(items, item)
 		}
-		fmt.Println(`	}
-}`)
 	}
+	return items
 }
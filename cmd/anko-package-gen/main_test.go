@@ -0,0 +1,121 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	tests := []struct {
+		args    []string
+		want    []string
+		notWant []string
+		test    []string
+	}{
+		{
+			args: []string{"./testdata/example"},
+			want: []string{
+				"// Code generated by anko-package-gen. DO NOT EDIT.",
+				`"github.com/mattn/anko/cmd/anko-package-gen/testdata/example"`,
+				`env.Packages["github.com/mattn/anko/cmd/anko-package-gen/testdata/example"] = map[string]reflect.Value{`,
+				`"Add": reflect.ValueOf(example.Add),`,
+				`"Name": reflect.ValueOf(example.Name),`,
+				`"Small": reflect.ValueOf(example.Small),`,
+				`"Big": reflect.ValueOf(int64(example.Big)),`,
+				`"Unsigned": reflect.ValueOf(uint64(example.Unsigned)),`,
+				`"Timeout": reflect.ValueOf(example.Timeout),`,
+				"// Huge is skipped: untyped constant 18446744073709551616 overflows uint64",
+				"// Map is skipped: generic function",
+				`"Point": reflect.TypeOf(example.Point{}),`,
+				`"Shape": reflect.TypeOf((*example.Shape)(nil)).Elem(),`,
+				"// Pair is skipped: generic type",
+				`"Add": "Add is a function.",`,
+				`"Big": "Untyped constants, the ones that do not fit in 32 bits get a sized type.",`,
+				`"Small": "Untyped constants, the ones that do not fit in 32 bits get a sized type.",`,
+				`"Verbose": "Verbose is documented by the comment at the end of its line.",`,
+				`"Point.String": "String returns the point as x,y.",`,
+			},
+			notWant: []string{"Extra", "unexported", "//go:build", `"Map": "`, `"Pair": "`},
+		},
+		{
+			args: []string{"-tags", "extra", "-exclude", "Add,Name", "-build", "!appengine", "-p", "bindings", "-doc=false", "-test", "./testdata/example"},
+			want: []string{
+				"//go:build !appengine // +build !appengine",
+				"package bindings",
+				`"Extra": reflect.ValueOf(example.Extra),`,
+			},
+			notWant: []string{"example.Add", "example.Name", "env.PackageDocs"},
+			test: []string{
+				"//go:build !appengine // +build !appengine",
+				"package bindings",
+				"func TestPackageGithubComMattnAnkoCmdAnkoPackageGenTestdataExample(t *testing.T) {",
+				"vm.Execute(env.NewEnv(), nil, `import(\"github.com/mattn/anko/cmd/anko-package-gen/testdata/example\")`)",
+			},
+		},
+		{
+			args:    []string{"-go", "1.11", "strings"},
+			want:    []string{`"Title":`, "reflect.TypeOf(strings.Builder{}),", `"Builder.WriteString": "`},
+			notWant: []string{`"Cut"`, `"Clone"`, `"Builder.Cap"`},
+		},
+	}
+
+	for _, test := range tests {
+		dir := t.TempDir()
+		exitCode := run(append([]string{"-d", dir}, test.args...))
+		if exitCode != 0 {
+			t.Fatalf("run %v - exit code received: %v - expected: %v", test.args, exitCode, 0)
+		}
+		files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		wantFiles := 1
+		if test.test != nil {
+			wantFiles = 2
+		}
+		if len(files) != wantFiles {
+			t.Fatalf("run %v - files received: %v - expected: %v files", test.args, files, wantFiles)
+		}
+		checkGenerated(t, test.args, files[0], test.want, test.notWant)
+		if test.test != nil {
+			checkGenerated(t, test.args, files[1], test.test, nil)
+		}
+	}
+}
+
+// checkGenerated checks that the generated file contains the wanted code and not the unwanted code, ignoring spacing.
+func checkGenerated(t *testing.T, args []string, file string, want []string, notWant []string) {
+	t.Helper()
+	source, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	code := strings.Join(strings.Fields(string(source)), " ")
+	for _, want := range want {
+		if !strings.Contains(code, want) {
+			t.Errorf("run %v - %v does not contain %q:\n%s", args, filepath.Base(file), want, source)
+		}
+	}
+	for _, notWant := range notWant {
+		if strings.Contains(code, notWant) {
+			t.Errorf("run %v - %v contains %q:\n%s", args, filepath.Base(file), notWant, source)
+		}
+	}
+}
+
+func TestGenerateErrors(t *testing.T) {
+	tests := [][]string{
+		{},
+		{"-test", "strings"},
+		{"-go", "2.0", "strings"},
+		{"./testdata/missing"},
+		{"-build", "!(", "strings"},
+	}
+	for _, args := range tests {
+		if exitCode := run(args); exitCode == 0 {
+			t.Errorf("run %v - exit code received: 0 - expected: not 0", args)
+		}
+	}
+}
@@ -0,0 +1,2 @@
+// Package depend is imported by the example package, to load dependencies with the build tags.
+package depend
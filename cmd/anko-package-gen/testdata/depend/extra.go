@@ -0,0 +1,8 @@
+//go:build extra
+
+package depend
+
+// Extra is only built with the extra tag.
+func Extra() string {
+	return "extra"
+}
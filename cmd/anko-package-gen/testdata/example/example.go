@@ -0,0 +1,61 @@
+// Package example is bound by the tests of anko-package-gen.
+package example
+
+import (
+	"fmt"
+	"time"
+)
+
+// Timeout is a typed constant.
+const Timeout = 3 * time.Second
+
+// Untyped constants, the ones that do not fit in 32 bits get a sized type.
+const (
+	Small    = 42 // Small fits in 32 bits.
+	Big      = 1 << 40
+	Unsigned = 1<<64 - 1
+	Huge     = 1 << 64
+)
+
+// Name is a variable.
+var Name = "example"
+
+var (
+	Verbose = false // Verbose is documented by the comment at the end of its line.
+)
+
+// Point is a struct type.
+type Point struct {
+	X, Y int
+}
+
+// String returns the point as x,y.
+func (p Point) String() string {
+	return fmt.Sprintf("%v,%v", p.X, p.Y)
+}
+
+// Shape is an interface type.
+type Shape interface {
+	Area() float64
+}
+
+// Add is a function.
+func Add(a, b Point) Point {
+	return Point{X: a.X + b.X, Y: a.Y + b.Y}
+}
+
+// Map is a generic function.
+func Map[T, U any](items []T, f func(T) U) []U {
+	result := make([]U, 0, len(items))
+	for _, item := range items {
+		result = append(result, f(item))
+	}
+	return result
+}
+
+// Pair is a generic type.
+type Pair[T any] struct {
+	First, Second T
+}
+
+func unexported() {}
@@ -0,0 +1,10 @@
+//go:build extra
+
+package example
+
+import "github.com/mattn/anko/cmd/anko-package-gen/testdata/depend"
+
+// Extra is only built with the extra tag.
+func Extra() string {
+	return depend.Extra()
+}
@@ -2,9 +2,12 @@
 package core
 
 import (
+	"context"
 	"fmt"
-	"io/ioutil"
+	"io"
 	"reflect"
+	"strings"
+	"time"
 
 	"github.com/mattn/anko/env"
 	"github.com/mattn/anko/parser"
@@ -73,8 +76,25 @@ func Import(e *env.Env) *env.Env {
 		return err == nil
 	})
 
-	e.Define("load", func(s string) interface{} {
-		body, err := ioutil.ReadFile(s)
+	// the functions of ctx get the context of the run from the vm
+	ctx, _ := e.NewModule("ctx")
+	ctx.Define("deadline", func(ctx context.Context) (time.Time, bool) {
+		return ctx.Deadline()
+	})
+	ctx.Define("done", func(ctx context.Context) <-chan struct{} {
+		return ctx.Done()
+	})
+	ctx.Define("err", func(ctx context.Context) error {
+		return ctx.Err()
+	})
+	ctx.Define("value", func(ctx context.Context, name string) interface{} {
+		return vm.ContextValue(ctx, name)
+	})
+
+	// load reads the file from the same file system as the modules imported by the run,
+	// then runs it with the context and the options of the run
+	e.Define("load", func(ctx context.Context, s string) interface{} {
+		body, err := vm.ReadFile(ctx, e, s)
 		if err != nil {
 			panic(err)
 		}
@@ -88,18 +108,66 @@ func Import(e *env.Env) *env.Env {
 			}
 			panic(err)
 		}
-		rv, err := vm.Run(e, nil, stmts)
+		rv, err := vm.RunContext(ctx, e, vm.ContextOptions(ctx), stmts)
 		if err != nil {
 			panic(err)
 		}
 		return rv
 	})
 
-	e.Define("print", fmt.Print)
-	e.Define("println", fmt.Println)
-	e.Define("printf", fmt.Printf)
+	// the vm passes the context of the run, so output goes to the Stdout of the run
+	e.Define("print", func(ctx context.Context, a ...interface{}) (int, error) {
+		return fmt.Fprint(vm.Stdout(ctx), a...)
+	})
+	e.Define("println", func(ctx context.Context, a ...interface{}) (int, error) {
+		return fmt.Fprintln(vm.Stdout(ctx), a...)
+	})
+	e.Define("printf", func(ctx context.Context, format string, a ...interface{}) (int, error) {
+		return fmt.Fprintf(vm.Stdout(ctx), format, a...)
+	})
+	e.Define("eprint", func(ctx context.Context, a ...interface{}) (int, error) {
+		return fmt.Fprint(vm.Stderr(ctx), a...)
+	})
+	e.Define("eprintln", func(ctx context.Context, a ...interface{}) (int, error) {
+		return fmt.Fprintln(vm.Stderr(ctx), a...)
+	})
+	e.Define("eprintf", func(ctx context.Context, format string, a ...interface{}) (int, error) {
+		return fmt.Fprintf(vm.Stderr(ctx), format, a...)
+	})
+	// readLine returns the next line of the Stdin of the run without the line ending, and io.EOF after the last line
+	e.Define("readLine", func(ctx context.Context) (string, error) {
+		return readLine(vm.Stdin(ctx))
+	})
+
+	// exit stops the run, the caller of the run gets a vm.ExitError with the code
+	e.Define("exit", func(ctx context.Context, code int64) {
+		vm.Exit(ctx, int(code))
+	})
 
 	ImportToX(e)
 
 	return e
 }
+
+// readLine reads a line from r without the line ending.
+// The line is read one byte at a time, so the rest of r is left for the next read.
+func readLine(r io.Reader) (string, error) {
+	var line []byte
+	b := make([]byte, 1)
+	for {
+		n, err := r.Read(b)
+		if n > 0 {
+			if b[0] == '\n' {
+				break
+			}
+			line = append(line, b[0])
+		}
+		if err == io.EOF && len(line) > 0 {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+	return strings.TrimSuffix(string(line), "\r"), nil
+}
@@ -0,0 +1,85 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/mattn/anko/env"
+	"github.com/mattn/anko/vm"
+)
+
+func TestLoadFS(t *testing.T) {
+	t.Parallel()
+
+	optionsFS := fstest.MapFS{"lib/a.ank": {Data: []byte(`func X(a) { return a + 1 }`)}}
+	envFS := fstest.MapFS{"lib/a.ank": {Data: []byte(`func X(a) { return a + 2 }`)}}
+
+	e := env.NewEnv()
+	e.SetFS(envFS)
+	Import(e)
+
+	tests := []struct {
+		options  *vm.Options
+		script   string
+		expected interface{}
+		err      string
+	}{
+		{options: &vm.Options{FS: optionsFS}, script: `load("lib/a.ank"); X(1)`, expected: int64(2)},
+		{options: nil, script: `load("/lib/a.ank"); X(1)`, expected: int64(3)},
+		{options: &vm.Options{FS: optionsFS}, script: `load("lib/b.ank")`, err: "open lib/b.ank: file does not exist"},
+	}
+	for _, test := range tests {
+		value, err := vm.Execute(e.NewEnv(), test.options, test.script)
+		if err != nil && err.Error() != test.err || err == nil && test.err != "" {
+			t.Errorf("Execute error - received: %v - expected: %v - script: %v", err, test.err, test.script)
+			continue
+		}
+		if value != test.expected {
+			t.Errorf("Execute value - received: %#v - expected: %#v - script: %v", value, test.expected, test.script)
+		}
+	}
+}
+
+func TestLoadRun(t *testing.T) {
+	t.Parallel()
+
+	type contextKey string
+	fsys := fstest.MapFS{"a.ank": {Data: []byte(`println(ctx.value("name"))`)}}
+	e := Import(env.NewEnv())
+
+	var stdout bytes.Buffer
+	ctx := context.WithValue(context.Background(), contextKey("name"), "a")
+	options := &vm.Options{FS: fsys, Stdout: &stdout, ContextKeys: map[string]interface{}{"name": contextKey("name")}}
+	_, err := vm.ExecuteContext(ctx, e, options, `load("a.ank")`)
+	if err != nil {
+		t.Fatal("Execute error:", err)
+	}
+	if stdout.String() != "a\n" {
+		t.Errorf("Stdout - received: %q - expected: %q", stdout.String(), "a\n")
+	}
+}
+
+func TestStreams(t *testing.T) {
+	t.Parallel()
+
+	e := Import(env.NewEnv())
+	var stdout, stderr bytes.Buffer
+	options := &vm.Options{Stdin: strings.NewReader("a\r\n\nb"), Stdout: &stdout, Stderr: &stderr}
+	_, err := vm.Execute(e, options, `
+a = readLine(); b = readLine(); c = readLine(); d = readLine()
+print(a[0], c[0]); println(len(b[0])); printf("%v\n", d[0] == "")
+eprint("e"); eprintln(a[1], c[1]); eprintf("%v", d[1])
+`)
+	if err != nil {
+		t.Fatal("Execute error:", err)
+	}
+	if stdout.String() != "ab0\ntrue\n" {
+		t.Errorf("Stdout - received: %q - expected: %q", stdout.String(), "ab0\ntrue\n")
+	}
+	if stderr.String() != "e<nil> <nil>\nEOF" {
+		t.Errorf("Stderr - received: %q - expected: %q", stderr.String(), "e<nil> <nil>\nEOF")
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"io/fs"
 	"reflect"
 	"sync"
 )
@@ -15,6 +16,29 @@ type (
 		Type(string) (reflect.Type, error)
 	}
 
+	// ExternalLookupSetter is an ExternalLookup that can set values.
+	// SetValue uses Set for symbols that Get finds in the external lookup.
+	// Set is called with the Env locked, it must not use the Env.
+	ExternalLookupSetter interface {
+		ExternalLookup
+		Set(string, reflect.Value) error
+	}
+
+	// ExternalLookupDefiner is an ExternalLookup that can define values.
+	// DefineValue uses Define instead of defining the symbol in the Env.
+	// Get and Define are called with the Env locked, they must not use the Env.
+	ExternalLookupDefiner interface {
+		ExternalLookup
+		Define(string, reflect.Value) error
+	}
+
+	// ExternalLookupSymbols is an ExternalLookup that can list its value symbols.
+	// GetValueSymbols adds Symbols to the symbols of the Env.
+	ExternalLookupSymbols interface {
+		ExternalLookup
+		Symbols() []string
+	}
+
 	// Env is the environment needed for a VM to run in.
 	Env struct {
 		rwMutex        *sync.RWMutex
@@ -22,6 +46,12 @@ type (
 		values         map[string]reflect.Value
 		types          map[string]reflect.Type
 		externalLookup ExternalLookup
+		fsys           fs.FS
+		observers      []*observerEntry
+		// overlay is true for copy-on-write scopes made by NewOverlay
+		overlay bool
+		// frozen is 1 when the scope is read-only, values and types are then read without locking
+		frozen int32
 	}
 )
 
@@ -34,6 +64,9 @@ var (
 	// reflect.Type must be valid or VM may crash.
 	// For nil type must use NilType.
 	PackageTypes = make(map[string]map[string]reflect.Type)
+	// PackageDocs is where the documentation of package members can be stored, for the REPL :doc command.
+	// Keys are the ones of Packages and PackageTypes, methods are named like Type.Method.
+	PackageDocs = make(map[string]map[string]string)
 
 	// NilType is the reflect.type of nil
 	NilType = reflect.TypeOf(nil)
@@ -154,7 +187,8 @@ func (e *Env) GetEnvFromPath(path []string) (*Env, error) {
 	return e, nil
 }
 
-// Copy the Env for current scope
+// Copy the Env for current scope.
+// The external lookup is shared with the copy.
 func (e *Env) Copy() *Env {
 	e.rwMutex.RLock()
 	copy := Env{
@@ -162,6 +196,8 @@ func (e *Env) Copy() *Env {
 		parent:         e.parent,
 		values:         make(map[string]reflect.Value, len(e.values)),
 		externalLookup: e.externalLookup,
+		fsys:           e.fsys,
+		overlay:        e.overlay,
 	}
 	for name, value := range e.values {
 		copy.values[name] = value
@@ -3,6 +3,7 @@ package env
 import (
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
 	"testing"
 )
@@ -233,3 +234,110 @@ func TestExternalLookupAddr(t *testing.T) {
 		}
 	}
 }
+
+type TestExternalStore struct {
+	TestExternalLookup
+	sets    int
+	defines int
+}
+
+func NewTestExternalStore() *TestExternalStore {
+	return &TestExternalStore{TestExternalLookup: *NewTestExternalLookup()}
+}
+
+func (testExternalStore *TestExternalStore) Set(symbol string, value reflect.Value) error {
+	if symbol == "readOnly" {
+		return fmt.Errorf("symbol '%s' is read-only", symbol)
+	}
+	testExternalStore.sets++
+	testExternalStore.values[symbol] = value
+	return nil
+}
+
+func (testExternalStore *TestExternalStore) Define(symbol string, value reflect.Value) error {
+	testExternalStore.defines++
+	testExternalStore.values[symbol] = value
+	return nil
+}
+
+func (testExternalStore *TestExternalStore) Symbols() []string {
+	symbols := make([]string, 0, len(testExternalStore.values))
+	for symbol := range testExternalStore.values {
+		symbols = append(symbols, symbol)
+	}
+	return symbols
+}
+
+func TestExternalLookupSetDefineSymbols(t *testing.T) {
+	t.Parallel()
+
+	store := NewTestExternalStore()
+	err := store.SetValue("a", int64(1))
+	if err != nil {
+		t.Fatal("SetValue error:", err)
+	}
+	err = store.SetValue("readOnly", int64(1))
+	if err != nil {
+		t.Fatal("SetValue error:", err)
+	}
+
+	envParent := NewEnv()
+	envParent.SetExternalLookup(store)
+	envChild := envParent.NewEnv()
+
+	// set through child scope
+	err = envChild.Set("a", int64(2))
+	if err != nil {
+		t.Errorf("Set error - received: %v - expected: %v", err, nil)
+	}
+	value, err := envParent.Get("a")
+	if err != nil || value != int64(2) || store.sets != 1 {
+		t.Errorf("Get - received: %v, %v - expected: %v, %v", value, err, int64(2), nil)
+	}
+	err = envChild.Set("readOnly", int64(2))
+	if err == nil || err.Error() != "symbol 'readOnly' is read-only" {
+		t.Errorf("Set error - received: %v - expected: %v", err, "symbol 'readOnly' is read-only")
+	}
+	err = envChild.Set("b", int64(2))
+	if err == nil || err.Error() != "undefined symbol 'b'" {
+		t.Errorf("Set error - received: %v - expected: %v", err, "undefined symbol 'b'")
+	}
+
+	// define goes to the external lookup of the scope only
+	err = envParent.Define("b", "b")
+	if err != nil {
+		t.Errorf("Define error - received: %v - expected: %v", err, nil)
+	}
+	err = envChild.Define("c", "c")
+	if err != nil {
+		t.Errorf("Define error - received: %v - expected: %v", err, nil)
+	}
+	if store.defines != 1 || !store.values["b"].IsValid() || store.values["c"].IsValid() {
+		t.Errorf("defines - received: %v - expected: %v", store.defines, 1)
+	}
+	err = envParent.Define("b.b", "b")
+	if err != ErrSymbolContainsDot {
+		t.Errorf("Define error - received: %v - expected: %v", err, ErrSymbolContainsDot)
+	}
+
+	symbols := envParent.GetValueSymbols()
+	sort.Strings(symbols)
+	if !reflect.DeepEqual(symbols, []string{"a", "b", "readOnly"}) {
+		t.Errorf("GetValueSymbols - received: %v - expected: %v", symbols, []string{"a", "b", "readOnly"})
+	}
+	symbols = envChild.GetValueSymbols()
+	if !reflect.DeepEqual(symbols, []string{"c"}) {
+		t.Errorf("GetValueSymbols - received: %v - expected: %v", symbols, []string{"c"})
+	}
+
+	// copies share the external lookup
+	envCopy := envChild.DeepCopy()
+	err = envCopy.Set("a", int64(3))
+	if err != nil {
+		t.Errorf("Set error - received: %v - expected: %v", err, nil)
+	}
+	value, err = envParent.Get("a")
+	if err != nil || value != int64(3) {
+		t.Errorf("Get - received: %v, %v - expected: %v, %v", value, err, int64(3), nil)
+	}
+}
@@ -0,0 +1,49 @@
+package env
+
+import (
+	"io/fs"
+	"io/ioutil"
+	"path"
+	"strings"
+)
+
+// SetFS sets the file system that scripts in this Env and its child scopes read files from.
+// Setting nil uses the file system of the parent scope, or the operating system file system if none is set.
+func (e *Env) SetFS(fsys fs.FS) {
+	e.rwMutex.Lock()
+	e.fsys = fsys
+	e.rwMutex.Unlock()
+}
+
+// FS returns the file system of the Env or of the closest parent scope that has one.
+// Returns nil if none is set, meaning the operating system file system.
+func (e *Env) FS() fs.FS {
+	for ; e != nil; e = e.parent {
+		e.rwMutex.RLock()
+		fsys := e.fsys
+		e.rwMutex.RUnlock()
+		if fsys != nil {
+			return fsys
+		}
+	}
+	return nil
+}
+
+// ReadFile reads the named file from the file system of the Env.
+func (e *Env) ReadFile(name string) ([]byte, error) {
+	return ReadFile(e.FS(), name)
+}
+
+// ReadFile reads the named file from fsys, nil being the operating system file system.
+// With an fs.FS a leading slash is removed and the name is cleaned, so /lib/a.ank and lib/../lib/a.ank both read lib/a.ank.
+func ReadFile(fsys fs.FS, name string) ([]byte, error) {
+	if fsys == nil {
+		return ioutil.ReadFile(name)
+	}
+	return fs.ReadFile(fsys, FSPath(name))
+}
+
+// FSPath returns name as a path valid for an fs.FS.
+func FSPath(name string) string {
+	return path.Clean(strings.TrimPrefix(name, "/"))
+}
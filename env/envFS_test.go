@@ -0,0 +1,58 @@
+package env
+
+import (
+	"os"
+	"testing"
+	"testing/fstest"
+)
+
+func TestFS(t *testing.T) {
+	t.Parallel()
+
+	fsys := fstest.MapFS{
+		"lib/a.ank": {Data: []byte("a")},
+	}
+
+	env := NewEnv()
+	if env.FS() != nil {
+		t.Errorf("FS - received: %v - expected: %v", env.FS(), nil)
+	}
+
+	env.SetFS(fsys)
+	child := env.NewEnv().NewEnv()
+	tests := []struct {
+		name   string
+		output string
+	}{
+		{name: "lib/a.ank", output: "a"},
+		{name: "/lib/a.ank", output: "a"},
+		{name: "lib/../lib/a.ank", output: "a"},
+		{name: "./lib/a.ank", output: "a"},
+	}
+	for _, test := range tests {
+		value, err := child.ReadFile(test.name)
+		if err != nil {
+			t.Errorf("ReadFile error - name: %v - received: %v - expected: %v", test.name, err, nil)
+			continue
+		}
+		if string(value) != test.output {
+			t.Errorf("ReadFile - name: %v - received: %v - expected: %v", test.name, string(value), test.output)
+		}
+	}
+
+	_, err := child.ReadFile("lib/b.ank")
+	if !os.IsNotExist(err) {
+		t.Errorf("ReadFile error - received: %v - expected: %v", err, os.ErrNotExist)
+	}
+
+	copy := env.Copy()
+	if copy.FS() == nil {
+		t.Errorf("Copy FS - received: %v - expected: %v", nil, fsys)
+	}
+
+	child.SetFS(fstest.MapFS{})
+	_, err = child.ReadFile("lib/a.ank")
+	if !os.IsNotExist(err) {
+		t.Errorf("ReadFile error - received: %v - expected: %v", err, os.ErrNotExist)
+	}
+}
@@ -0,0 +1,196 @@
+package env
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+type (
+	// ObjectOptions for DefineObject and NewObject.
+	ObjectOptions struct {
+		// TagName is the struct tag key, empty uses anko.
+		// The tag is the script name followed by options, anko:"name,readonly".
+		// An empty name keeps the field name and anko:"-" hides the field.
+		TagName string
+		// ReadOnly makes all fields read-only.
+		ReadOnly bool
+		// Methods maps script names to method names of the value, only these methods are exposed.
+		Methods map[string]string
+	}
+
+	// Object is a Go struct bound to an Env with DefineObject.
+	// Scripts only see the exported fields and the methods selected by the ObjectOptions.
+	Object struct {
+		value   reflect.Value
+		fields  map[string]objectField
+		methods map[string]reflect.Value
+	}
+
+	objectField struct {
+		index    []int
+		readOnly bool
+	}
+)
+
+// ErrObjectReadOnly is returned when setting a read-only field of an Object.
+var ErrObjectReadOnly = errors.New("object member is read-only")
+
+// DefineObject defines a struct, or a pointer to a struct, as an Object to symbol in current scope.
+// Fields set by scripts change the struct when value is a pointer, otherwise they change a copy.
+// options can be nil.
+func (e *Env) DefineObject(symbol string, value interface{}, options *ObjectOptions) error {
+	object, err := NewObject(value, options)
+	if err != nil {
+		return err
+	}
+	return e.DefineValue(symbol, reflect.ValueOf(object))
+}
+
+// NewObject creates an Object from a struct or a pointer to a struct.
+// options can be nil.
+func NewObject(value interface{}, options *ObjectOptions) (*Object, error) {
+	if options == nil {
+		options = &ObjectOptions{}
+	}
+	tagName := options.TagName
+	if tagName == "" {
+		tagName = "anko"
+	}
+
+	rv := reflect.ValueOf(value)
+	if rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		rv = rv.Elem()
+	} else if rv.Kind() == reflect.Struct {
+		// copy so fields can be set
+		copied := reflect.New(rv.Type()).Elem()
+		copied.Set(rv)
+		rv = copied
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("object must be a struct but is type %T", value)
+	}
+
+	object := &Object{
+		value:   rv,
+		fields:  make(map[string]objectField),
+		methods: make(map[string]reflect.Value, len(options.Methods)),
+	}
+	object.addFields(rv.Type(), rv.Type(), nil, tagName, options.ReadOnly)
+
+	for name, methodName := range options.Methods {
+		method := rv.Addr().MethodByName(methodName)
+		if !method.IsValid() {
+			return nil, fmt.Errorf("object type %v has no method named '%v'", rv.Type(), methodName)
+		}
+		object.methods[name] = method
+	}
+
+	return object, nil
+}
+
+// addFields adds the exported fields of the struct type, including fields of embedded structs.
+func (o *Object) addFields(root reflect.Type, rt reflect.Type, index []int, tagName string, readOnly bool) {
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		tag, hasTag := field.Tag.Lookup(tagName)
+		if tag == "-" {
+			continue
+		}
+
+		fieldIndex := make([]int, len(index)+1)
+		copy(fieldIndex, index)
+		fieldIndex[len(index)] = i
+
+		if field.Anonymous && !hasTag && field.Type.Kind() == reflect.Struct {
+			o.addFields(root, field.Type, fieldIndex, tagName, readOnly)
+			continue
+		}
+		if field.PkgPath != "" {
+			// unexported
+			continue
+		}
+		if len(index) > 0 {
+			// same as Go, fields of embedded structs can be hidden by other fields
+			promoted, ok := root.FieldByName(field.Name)
+			if !ok || !reflect.DeepEqual(promoted.Index, fieldIndex) {
+				continue
+			}
+		}
+
+		name := field.Name
+		fieldReadOnly := readOnly
+		if hasTag {
+			tagOptions := strings.Split(tag, ",")
+			if tagOptions[0] != "" {
+				name = tagOptions[0]
+			}
+			for _, option := range tagOptions[1:] {
+				if option == "readonly" {
+					fieldReadOnly = true
+				}
+			}
+		}
+
+		if _, ok := o.fields[name]; ok && len(index) > 0 {
+			// fields of the outer struct hide fields of embedded structs
+			continue
+		}
+		o.fields[name] = objectField{index: fieldIndex, readOnly: fieldReadOnly}
+	}
+}
+
+// String returns the type of the bound struct.
+func (o *Object) String() string {
+	return "object " + o.value.Type().String()
+}
+
+// Interface returns a pointer to the bound struct.
+func (o *Object) Interface() interface{} {
+	return o.value.Addr().Interface()
+}
+
+// Names returns the sorted names of the fields and methods of the Object.
+func (o *Object) Names() []string {
+	names := make([]string, 0, len(o.fields)+len(o.methods))
+	for name := range o.fields {
+		names = append(names, name)
+	}
+	for name := range o.methods {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Get returns the value of the field or method name.
+// The value of a read-only field is a copy, the values its maps, slices and pointers refer to can still be changed.
+func (o *Object) Get(name string) (reflect.Value, error) {
+	if field, ok := o.fields[name]; ok {
+		value := o.value.FieldByIndex(field.index)
+		if field.readOnly && (value.Kind() != reflect.Interface || !value.IsNil()) {
+			// a copy that is not settable, so the fields of a read-only struct can not be set either
+			return reflect.ValueOf(value.Interface()), nil
+		}
+		return value, nil
+	}
+	if method, ok := o.methods[name]; ok {
+		return method, nil
+	}
+	return NilValue, fmt.Errorf("no member named '%v' for object", name)
+}
+
+// Field returns the settable field name.
+// Returns ErrObjectReadOnly if the field is read-only.
+func (o *Object) Field(name string) (reflect.Value, error) {
+	field, ok := o.fields[name]
+	if !ok {
+		return NilValue, fmt.Errorf("no member named '%v' for object", name)
+	}
+	if field.readOnly {
+		return NilValue, ErrObjectReadOnly
+	}
+	return o.value.FieldByIndex(field.index), nil
+}
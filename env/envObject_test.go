@@ -0,0 +1,99 @@
+package env
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+type testObjectBase struct {
+	ID   int64
+	Name string
+}
+
+type testObject struct {
+	testObjectBase
+	Name     string `anko:"name"`
+	Port     int    `anko:",readonly"`
+	Password string `anko:"-"`
+	hidden   int
+}
+
+func (o testObject) Address() string {
+	return fmt.Sprintf("%v:%v", o.Name, o.Port)
+}
+
+func TestObject(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewObject(1, nil)
+	if err == nil || err.Error() != "object must be a struct but is type int" {
+		t.Errorf("NewObject error - received: %v - expected: %v", err, "object must be a struct but is type int")
+	}
+	_, err = NewObject(&testObject{}, &ObjectOptions{Methods: map[string]string{"address": "Addr"}})
+	if err == nil || err.Error() != "object type env.testObject has no method named 'Addr'" {
+		t.Errorf("NewObject error - received: %v - expected: %v", err, "object type env.testObject has no method named 'Addr'")
+	}
+
+	value := testObject{testObjectBase: testObjectBase{ID: 1, Name: "base"}, Name: "a", Port: 80}
+	object, err := NewObject(value, &ObjectOptions{Methods: map[string]string{"address": "Address"}})
+	if err != nil {
+		t.Fatal("NewObject error:", err)
+	}
+
+	names := object.Names()
+	expectedNames := []string{"ID", "Port", "address", "name"}
+	if !reflect.DeepEqual(names, expectedNames) {
+		t.Errorf("Names - received: %v - expected: %v", names, expectedNames)
+	}
+
+	rv, err := object.Get("name")
+	if err != nil || rv.Interface() != "a" {
+		t.Errorf("Get - received: %v, %v - expected: %v, %v", rv, err, "a", nil)
+	}
+	rv, err = object.Get("address")
+	if err != nil || rv.Call(nil)[0].Interface() != "a:80" {
+		t.Errorf("Get - received: %v, %v - expected: %v, %v", rv, err, "a:80", nil)
+	}
+	_, err = object.Get("Password")
+	if err == nil || err.Error() != "no member named 'Password' for object" {
+		t.Errorf("Get error - received: %v - expected: %v", err, "no member named 'Password' for object")
+	}
+
+	_, err = object.Field("Port")
+	if err != ErrObjectReadOnly {
+		t.Errorf("Field error - received: %v - expected: %v", err, ErrObjectReadOnly)
+	}
+	rv, err = object.Field("name")
+	if err != nil {
+		t.Fatal("Field error:", err)
+	}
+	rv.SetString("b")
+	if value.Name != "a" {
+		t.Errorf("value Name - received: %v - expected: %v", value.Name, "a")
+	}
+	if object.Interface().(*testObject).Name != "b" {
+		t.Errorf("object Name - received: %v - expected: %v", object.Interface().(*testObject).Name, "b")
+	}
+	if object.String() != "object env.testObject" {
+		t.Errorf("String - received: %v - expected: %v", object.String(), "object env.testObject")
+	}
+
+	e := NewEnv()
+	err = e.DefineObject("a", &value, nil)
+	if err != nil {
+		t.Fatal("DefineObject error:", err)
+	}
+	a, err := e.Get("a")
+	if err != nil {
+		t.Fatal("Get error:", err)
+	}
+	rv, err = a.(*Object).Field("name")
+	if err != nil {
+		t.Fatal("Field error:", err)
+	}
+	rv.SetString("c")
+	if value.Name != "c" {
+		t.Errorf("value Name - received: %v - expected: %v", value.Name, "c")
+	}
+}
@@ -0,0 +1,261 @@
+package env
+
+import (
+	"reflect"
+	"sort"
+	"sync/atomic"
+)
+
+type (
+	// ChangeKind is the kind of change made to a value symbol.
+	ChangeKind int
+
+	// Change is a change made to a value symbol of an Env.
+	Change struct {
+		Kind   ChangeKind
+		Symbol string
+		// OldValue is the value before the change, invalid if the symbol was not defined before
+		OldValue reflect.Value
+		// NewValue is the value after the change, invalid for ChangeDelete
+		NewValue reflect.Value
+		// Depth is the number of parent scopes of the changed Env, 0 is the global scope
+		Depth int
+		// Env is the scope the symbol was changed in
+		Env *Env
+	}
+
+	// Observer is called after a value symbol is changed in the Env it was added to or in any of its child scopes.
+	// It is called on the goroutine that made the change, so it must not block or change the same symbol.
+	Observer func(change Change)
+
+	observerEntry struct {
+		observer Observer
+	}
+)
+
+const (
+	// ChangeDefine is a symbol defined with Define, OldValue is valid if the symbol was already defined in the scope
+	ChangeDefine ChangeKind = iota
+	// ChangeSet is a symbol set with Set
+	ChangeSet
+	// ChangeDelete is a symbol deleted with Delete
+	ChangeDelete
+	// ChangeUpdate is a value changed in place and reported with NotifyUpdate, like a map item set by a script,
+	// OldValue is invalid and NewValue is the changed value
+	ChangeUpdate
+)
+
+// observerCount is the number of observers of all Envs, so changes are only checked for observers when there are some.
+var observerCount int32
+
+// String returns the name of the ChangeKind.
+func (kind ChangeKind) String() string {
+	switch kind {
+	case ChangeDefine:
+		return "define"
+	case ChangeSet:
+		return "set"
+	case ChangeDelete:
+		return "delete"
+	case ChangeUpdate:
+		return "update"
+	}
+	return "unknown"
+}
+
+// AddObserver adds an observer of the value symbols changed in the Env and its child scopes.
+// Returns a function that removes the observer.
+func (e *Env) AddObserver(observer Observer) func() {
+	entry := &observerEntry{observer: observer}
+
+	e.rwMutex.Lock()
+	e.observers = append(e.observers, entry)
+	e.rwMutex.Unlock()
+	atomic.AddInt32(&observerCount, 1)
+
+	return func() {
+		e.rwMutex.Lock()
+		defer e.rwMutex.Unlock()
+		for i := 0; i < len(e.observers); i++ {
+			if e.observers[i] == entry {
+				e.observers = append(e.observers[:i:i], e.observers[i+1:]...)
+				atomic.AddInt32(&observerCount, -1)
+				return
+			}
+		}
+	}
+}
+
+// notify calls the observers of the Env and of its parent scopes.
+func (e *Env) notify(kind ChangeKind, symbol string, oldValue reflect.Value, newValue reflect.Value) {
+	if atomic.LoadInt32(&observerCount) < 1 {
+		return
+	}
+
+	change := Change{Kind: kind, Symbol: symbol, OldValue: oldValue, NewValue: newValue, Env: e}
+	for scope := e.parent; scope != nil; scope = scope.parent {
+		change.Depth++
+	}
+
+	for scope := e; scope != nil; scope = scope.parent {
+		scope.rwMutex.RLock()
+		observers := scope.observers
+		scope.rwMutex.RUnlock()
+		for _, entry := range observers {
+			entry.observer(change)
+		}
+	}
+}
+
+// NotifyUpdate calls the observers with a ChangeUpdate of the symbol, for a value changed in place without Set,
+// like an item of a map, an item of a slice or a member of a struct. The vm calls it for the assignments of scripts.
+// The change is made in the scope where symbol is first found, nothing is done if the symbol is not defined.
+func (e *Env) NotifyUpdate(symbol string) {
+	if atomic.LoadInt32(&observerCount) < 1 {
+		return
+	}
+
+	for scope := e; scope != nil; scope = scope.parent {
+		scope.rwMutex.RLock()
+		value, ok := scope.values[symbol]
+		scope.rwMutex.RUnlock()
+		if !ok && scope.externalLookup != nil {
+			var err error
+			value, err = scope.externalLookup.Get(symbol)
+			ok = err == nil
+		}
+		if ok {
+			scope.notify(ChangeUpdate, symbol, reflect.Value{}, value)
+			return
+		}
+	}
+}
+
+// CopyValues copies the Env for current scope like Copy, also copying the maps, slices, arrays and structs in the values,
+// so the copy keeps the items of the values changed in place after. Pointers, funcs and channels are shared with the copy.
+func (e *Env) CopyValues() *Env {
+	copy := e.Copy()
+	copies := make(map[snapshotVisit]reflect.Value)
+	for symbol, value := range copy.values {
+		copy.values[symbol] = copyValue(value, copies)
+	}
+	return copy
+}
+
+// copyValue returns a copy of the maps, slices, arrays and structs in rv, other values are returned as they are.
+// copies has the maps and slices already copied, so values that contain themselves are copied once.
+func copyValue(rv reflect.Value, copies map[snapshotVisit]reflect.Value) reflect.Value {
+	switch rv.Kind() {
+	case reflect.Interface:
+		if rv.IsNil() {
+			return rv
+		}
+		value := reflect.New(rv.Type()).Elem()
+		value.Set(copyValue(rv.Elem(), copies))
+		return value
+	case reflect.Map:
+		if rv.IsNil() {
+			return rv
+		}
+		visit := snapshotVisit{rt: rv.Type(), pointer: rv.Pointer()}
+		if value, ok := copies[visit]; ok {
+			return value
+		}
+		value := reflect.MakeMapWithSize(rv.Type(), rv.Len())
+		copies[visit] = value
+		iter := rv.MapRange()
+		for iter.Next() {
+			value.SetMapIndex(iter.Key(), copyValue(iter.Value(), copies))
+		}
+		return value
+	case reflect.Slice:
+		if rv.IsNil() {
+			return rv
+		}
+		visit := snapshotVisit{rt: rv.Type(), pointer: rv.Pointer(), length: rv.Len()}
+		if value, ok := copies[visit]; ok {
+			return value
+		}
+		value := reflect.MakeSlice(rv.Type(), rv.Len(), rv.Len())
+		copies[visit] = value
+		for i := 0; i < rv.Len(); i++ {
+			value.Index(i).Set(copyValue(rv.Index(i), copies))
+		}
+		return value
+	case reflect.Array:
+		value := reflect.New(rv.Type()).Elem()
+		for i := 0; i < rv.Len(); i++ {
+			value.Index(i).Set(copyValue(rv.Index(i), copies))
+		}
+		return value
+	case reflect.Struct:
+		value := reflect.New(rv.Type()).Elem()
+		value.Set(rv)
+		for i := 0; i < rv.NumField(); i++ {
+			if rv.Type().Field(i).PkgPath == "" {
+				// only exported fields can be set
+				value.Field(i).Set(copyValue(rv.Field(i), copies))
+			}
+		}
+		return value
+	}
+	return rv
+}
+
+// Diff returns the changes from the values in the current scope of before to the values in the current scope of after,
+// sorted by symbol. Values are compared with reflect.DeepEqual.
+// A common use is to CopyValues an Env before running a script then Diff the copy with the Env after the run,
+// a copy made with Copy shares the maps and slices of the values, so it does not keep the items changed in place.
+// Depth and Env of the changes are the ones of after.
+func Diff(before *Env, after *Env) []Change {
+	before.rwMutex.RLock()
+	beforeValues := make(map[string]reflect.Value, len(before.values))
+	for symbol, value := range before.values {
+		beforeValues[symbol] = value
+	}
+	before.rwMutex.RUnlock()
+
+	depth := 0
+	for scope := after.parent; scope != nil; scope = scope.parent {
+		depth++
+	}
+
+	var changes []Change
+	after.rwMutex.RLock()
+	for symbol, newValue := range after.values {
+		oldValue, ok := beforeValues[symbol]
+		if !ok {
+			changes = append(changes, Change{Kind: ChangeDefine, Symbol: symbol, NewValue: newValue, Depth: depth, Env: after})
+			continue
+		}
+		if !equalValues(oldValue, newValue) {
+			changes = append(changes, Change{Kind: ChangeSet, Symbol: symbol, OldValue: oldValue, NewValue: newValue, Depth: depth, Env: after})
+		}
+	}
+	for symbol, oldValue := range beforeValues {
+		if _, ok := after.values[symbol]; !ok {
+			changes = append(changes, Change{Kind: ChangeDelete, Symbol: symbol, OldValue: oldValue, Depth: depth, Env: after})
+		}
+	}
+	after.rwMutex.RUnlock()
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Symbol < changes[j].Symbol
+	})
+	return changes
+}
+
+// equalValues returns true if the values are deeply equal.
+// Funcs are only equal if they are the same func.
+func equalValues(a reflect.Value, b reflect.Value) bool {
+	if !a.IsValid() || !b.IsValid() {
+		return a.IsValid() == b.IsValid()
+	}
+	if !a.CanInterface() || !b.CanInterface() {
+		return false
+	}
+	if a.Kind() == reflect.Func && b.Kind() == reflect.Func {
+		return a.Pointer() == b.Pointer()
+	}
+	return reflect.DeepEqual(a.Interface(), b.Interface())
+}
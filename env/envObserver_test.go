@@ -0,0 +1,188 @@
+package env
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestObserver(t *testing.T) {
+	t.Parallel()
+
+	type change struct {
+		kind     ChangeKind
+		symbol   string
+		oldValue interface{}
+		newValue interface{}
+		depth    int
+	}
+	var changes []change
+	toInterface := func(value reflect.Value) interface{} {
+		if !value.IsValid() {
+			return "invalid"
+		}
+		return value.Interface()
+	}
+
+	e := NewEnv()
+	err := e.Define("a", "a")
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+	remove := e.AddObserver(func(c Change) {
+		changes = append(changes, change{kind: c.Kind, symbol: c.Symbol, oldValue: toInterface(c.OldValue), newValue: toInterface(c.NewValue), depth: c.Depth})
+	})
+
+	child := e.NewEnv()
+	err = e.Define("b", int64(1))
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+	err = e.Define("b", int64(2))
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+	err = child.Set("a", "c")
+	if err != nil {
+		t.Fatal("Set error:", err)
+	}
+	err = child.Define("d", nil)
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+	child.Delete("d")
+	child.Delete("e")
+	child.DeleteGlobal("b")
+	child.NotifyUpdate("a")
+	child.NotifyUpdate("e")
+	err = child.Set("e", 1)
+	if err == nil {
+		t.Fatal("Set error - received: nil - expected: undefined symbol 'e'")
+	}
+
+	expected := []change{
+		{kind: ChangeDefine, symbol: "b", oldValue: "invalid", newValue: int64(1), depth: 0},
+		{kind: ChangeDefine, symbol: "b", oldValue: int64(1), newValue: int64(2), depth: 0},
+		{kind: ChangeSet, symbol: "a", oldValue: "a", newValue: "c", depth: 0},
+		{kind: ChangeDefine, symbol: "d", oldValue: "invalid", newValue: nil, depth: 1},
+		{kind: ChangeDelete, symbol: "d", oldValue: nil, newValue: "invalid", depth: 1},
+		{kind: ChangeDelete, symbol: "b", oldValue: int64(2), newValue: "invalid", depth: 0},
+		{kind: ChangeUpdate, symbol: "a", oldValue: "invalid", newValue: "c", depth: 0},
+	}
+	if !reflect.DeepEqual(changes, expected) {
+		t.Errorf("changes - received: %+v - expected: %+v", changes, expected)
+	}
+
+	// observers of child scopes do not see changes in parent scopes
+	var childChanges int
+	removeChild := child.AddObserver(func(c Change) {
+		childChanges++
+	})
+	err = e.Define("f", 1)
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+	err = child.Define("f", 1)
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+	if childChanges != 1 {
+		t.Errorf("child changes - received: %v - expected: %v", childChanges, 1)
+	}
+	removeChild()
+
+	remove()
+	remove()
+	changes = nil
+	err = e.Define("g", 1)
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+	if len(changes) != 0 {
+		t.Errorf("changes - received: %+v - expected: %+v", changes, nil)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	f := func() {}
+	e := NewEnv().NewEnv()
+	for symbol, value := range map[string]interface{}{"a": int64(1), "b": []interface{}{"b"}, "c": "c", "d": nil, "f": f} {
+		err := e.Define(symbol, value)
+		if err != nil {
+			t.Fatal("Define error:", err)
+		}
+	}
+
+	before := e.Copy()
+	changes := Diff(before, e)
+	if len(changes) != 0 {
+		t.Errorf("Diff - received: %+v - expected: %+v", changes, nil)
+	}
+
+	e.Set("a", int64(2))
+	e.Set("b", []interface{}{"b"})
+	e.Delete("c")
+	e.Define("e", "e")
+	e.Set("f", func() {})
+
+	changes = Diff(before, e)
+	type change struct {
+		kind   ChangeKind
+		symbol string
+		depth  int
+	}
+	received := make([]change, len(changes))
+	for i := 0; i < len(changes); i++ {
+		received[i] = change{kind: changes[i].Kind, symbol: changes[i].Symbol, depth: changes[i].Depth}
+	}
+	expected := []change{
+		{kind: ChangeSet, symbol: "a", depth: 1},
+		{kind: ChangeDelete, symbol: "c", depth: 1},
+		{kind: ChangeDefine, symbol: "e", depth: 1},
+		{kind: ChangeSet, symbol: "f", depth: 1},
+	}
+	if !reflect.DeepEqual(received, expected) {
+		t.Errorf("Diff - received: %+v - expected: %+v", received, expected)
+	}
+	if changes[0].OldValue.Interface() != int64(1) || changes[0].NewValue.Interface() != int64(2) {
+		t.Errorf("Diff values - received: %v, %v - expected: %v, %v", changes[0].OldValue, changes[0].NewValue, int64(1), int64(2))
+	}
+
+	// values changed in place are only kept by CopyValues
+	m := map[string]interface{}{"a": []interface{}{int64(1)}}
+	m["m"] = m
+	l := []interface{}{"a"}
+	e = NewEnv()
+	err := e.Define("m", m)
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+	err = e.Define("l", l)
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+	copied := e.Copy()
+	before = e.CopyValues()
+	m["a"].([]interface{})[0] = int64(2)
+	l[0] = "b"
+	changes = Diff(copied, e)
+	if len(changes) != 0 {
+		t.Errorf("Diff - received: %+v - expected: %+v", changes, nil)
+	}
+	changes = Diff(before, e)
+	if len(changes) != 2 || changes[0].Symbol != "l" || changes[1].Symbol != "m" || changes[1].Kind != ChangeSet {
+		t.Errorf("Diff - received: %+v - expected: changes of l and m", changes)
+	}
+	value, err := before.Get("m")
+	if err != nil {
+		t.Fatal("Get error:", err)
+	}
+	if copiedMap := value.(map[string]interface{}); reflect.ValueOf(copiedMap["m"]).Pointer() != reflect.ValueOf(copiedMap).Pointer() {
+		t.Errorf("CopyValues - received: %v - expected: a map that contains itself", copiedMap)
+	}
+
+	if ChangeSet.String() != "set" {
+		t.Errorf("String - received: %v - expected: %v", ChangeSet.String(), "set")
+	}
+}
@@ -0,0 +1,103 @@
+package env
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+	"sync/atomic"
+)
+
+// ErrEnvFrozen is returned when changing a frozen Env.
+var ErrEnvFrozen = errors.New("env is frozen")
+
+// NewOverlay creates a copy-on-write scope of the Env.
+// The overlay reads the values and types of the Env and its parent scopes without copying them,
+// but setting a symbol of those scopes sets it in the overlay, leaving the Env unchanged.
+// The overlay is the global scope for DefineGlobal and DeleteGlobal.
+// Values like maps and slices are shared, so changing their items changes them for the Env as well.
+//
+// A common use is to Freeze a prepared Env, then run each request in a new overlay of it.
+// Script functions defined in the Env run in the overlay when a run of the overlay calls them,
+// so they read and set the symbols of the Env through the overlay. Functions defined in other scopes,
+// like modules or other functions, set the symbols of those scopes, which fails if they are frozen.
+func (e *Env) NewOverlay() *Env {
+	return &Env{
+		rwMutex: &sync.RWMutex{},
+		parent:  e,
+		values:  make(map[string]reflect.Value),
+		overlay: true,
+	}
+}
+
+// Freeze makes the Env, its parent scopes and the modules defined in them read-only.
+// Defining or setting a symbol in a frozen scope returns ErrEnvFrozen and Delete does nothing.
+// Reading values and types of a frozen scope does not lock, so concurrent reads are faster.
+// An Env can not be unfrozen, use Copy or NewOverlay to get a changeable Env.
+func (e *Env) Freeze() {
+	for scope := e; scope != nil; scope = scope.parent {
+		scope.freeze()
+	}
+}
+
+// freeze makes the scope and its modules read-only.
+func (e *Env) freeze() {
+	e.rwMutex.Lock()
+	if atomic.LoadInt32(&e.frozen) == 1 {
+		e.rwMutex.Unlock()
+		return
+	}
+	atomic.StoreInt32(&e.frozen, 1)
+	var modules []*Env
+	for _, value := range e.values {
+		if !value.IsValid() || !value.CanInterface() {
+			continue
+		}
+		if module, ok := value.Interface().(*Env); ok && module.parent == e {
+			modules = append(modules, module)
+		}
+	}
+	e.rwMutex.Unlock()
+
+	for _, module := range modules {
+		module.freeze()
+	}
+}
+
+// lockWrite locks the scope for changing it. Returns ErrEnvFrozen without locking if the scope is frozen,
+// the flag is checked under the lock so a concurrent Freeze can not let the change through.
+func (e *Env) lockWrite() error {
+	e.rwMutex.Lock()
+	if e.IsFrozen() {
+		e.rwMutex.Unlock()
+		return ErrEnvFrozen
+	}
+	return nil
+}
+
+// IsFrozen returns true if the Env is frozen.
+func (e *Env) IsFrozen() bool {
+	return atomic.LoadInt32(&e.frozen) == 1
+}
+
+// Overlay returns the closest scope of the Env made by NewOverlay, nil if the Env is not in an overlay.
+func (e *Env) Overlay() *Env {
+	for ; e != nil; e = e.parent {
+		if e.overlay {
+			return e
+		}
+	}
+	return nil
+}
+
+// IsOverlayOf returns true if the Env was made by base.NewOverlay.
+func (e *Env) IsOverlayOf(base *Env) bool {
+	return e.overlay && e.parent == base
+}
+
+// globalScope returns the global scope of the Env, which is the closest overlay or the root scope.
+func (e *Env) globalScope() *Env {
+	for e.parent != nil && !e.overlay {
+		e = e.parent
+	}
+	return e
+}
@@ -0,0 +1,288 @@
+package env
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestOverlay(t *testing.T) {
+	t.Parallel()
+
+	base := NewEnv()
+	err := base.Define("a", "a")
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+	err = base.DefineType("myType", int64(1))
+	if err != nil {
+		t.Fatal("DefineType error:", err)
+	}
+	baseChild := base.NewEnv()
+	err = baseChild.Define("b", "b")
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+
+	overlay := baseChild.NewOverlay()
+	overlayChild := overlay.NewEnv()
+
+	if overlayChild.Overlay() != overlay || base.Overlay() != nil {
+		t.Errorf("Overlay - received: %p, %p - expected: %p, %v", overlayChild.Overlay(), base.Overlay(), overlay, nil)
+	}
+	if !overlay.IsOverlayOf(baseChild) || overlay.IsOverlayOf(base) || overlayChild.IsOverlayOf(overlay) {
+		t.Errorf("IsOverlayOf - received: %v, %v, %v - expected: %v, %v, %v",
+			overlay.IsOverlayOf(baseChild), overlay.IsOverlayOf(base), overlayChild.IsOverlayOf(overlay), true, false, false)
+	}
+
+	// reads go to the base
+	for _, symbol := range []string{"a", "b"} {
+		value, err := overlayChild.Get(symbol)
+		if err != nil || value != symbol {
+			t.Errorf("Get - received: %v, %v - expected: %v, %v", value, err, symbol, nil)
+		}
+	}
+	aType, err := overlay.Type("myType")
+	if err != nil || aType != reflect.TypeOf(int64(1)) {
+		t.Errorf("Type - received: %v, %v - expected: %v, %v", aType, err, reflect.TypeOf(int64(1)), nil)
+	}
+
+	// writes stay in the overlay
+	err = overlayChild.Set("a", "overlay a")
+	if err != nil {
+		t.Errorf("Set error - received: %v - expected: %v", err, nil)
+	}
+	err = overlay.Set("b", "overlay b")
+	if err != nil {
+		t.Errorf("Set error - received: %v - expected: %v", err, nil)
+	}
+	err = overlay.Set("c", "c")
+	if err == nil || err.Error() != "undefined symbol 'c'" {
+		t.Errorf("Set error - received: %v - expected: %v", err, "undefined symbol 'c'")
+	}
+	err = overlayChild.DefineGlobal("d", "d")
+	if err != nil {
+		t.Errorf("DefineGlobal error - received: %v - expected: %v", err, nil)
+	}
+	err = overlayChild.DefineGlobalType("myType", "")
+	if err != nil {
+		t.Errorf("DefineGlobalType error - received: %v - expected: %v", err, nil)
+	}
+
+	tests := []struct {
+		env      *Env
+		symbol   string
+		expected interface{}
+	}{
+		{env: overlayChild, symbol: "a", expected: "overlay a"},
+		{env: overlayChild, symbol: "b", expected: "overlay b"},
+		{env: overlay, symbol: "d", expected: "d"},
+		{env: baseChild, symbol: "a", expected: "a"},
+		{env: baseChild, symbol: "b", expected: "b"},
+	}
+	for _, test := range tests {
+		value, err := test.env.Get(test.symbol)
+		if err != nil || value != test.expected {
+			t.Errorf("Get - symbol: %v - received: %v, %v - expected: %v, %v", test.symbol, value, err, test.expected, nil)
+		}
+	}
+	_, err = baseChild.Get("d")
+	if err == nil {
+		t.Errorf("Get error - received: %v - expected: %v", err, "undefined symbol 'd'")
+	}
+	aType, err = base.Type("myType")
+	if err != nil || aType != reflect.TypeOf(int64(1)) {
+		t.Errorf("Type - received: %v, %v - expected: %v, %v", aType, err, reflect.TypeOf(int64(1)), nil)
+	}
+
+	overlayChild.DeleteGlobal("a")
+	value, err := overlay.Get("a")
+	if err != nil || value != "a" {
+		t.Errorf("Get - received: %v, %v - expected: %v, %v", value, err, "a", nil)
+	}
+	overlayChild.DeleteGlobal("a")
+	value, err = base.Get("a")
+	if err != nil || value != "a" {
+		t.Errorf("Get - received: %v, %v - expected: %v, %v", value, err, "a", nil)
+	}
+}
+
+func TestFreeze(t *testing.T) {
+	t.Parallel()
+
+	base := NewEnv()
+	err := base.Define("a", "a")
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+	child := base.NewEnv()
+	err = child.Define("b", "b")
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+	child.Freeze()
+
+	if !base.IsFrozen() || !child.IsFrozen() {
+		t.Fatal("IsFrozen - received: false - expected: true")
+	}
+	err = child.Define("c", "c")
+	if err != ErrEnvFrozen {
+		t.Errorf("Define error - received: %v - expected: %v", err, ErrEnvFrozen)
+	}
+	err = child.Set("a", "c")
+	if err != ErrEnvFrozen {
+		t.Errorf("Set error - received: %v - expected: %v", err, ErrEnvFrozen)
+	}
+	err = base.DefineType("c", "c")
+	if err != ErrEnvFrozen {
+		t.Errorf("DefineType error - received: %v - expected: %v", err, ErrEnvFrozen)
+	}
+	child.DeleteGlobal("a")
+	value, err := child.Get("a")
+	if err != nil || value != "a" {
+		t.Errorf("Get - received: %v, %v - expected: %v, %v", value, err, "a", nil)
+	}
+
+	// children and overlays of a frozen env can be changed
+	grandchild := child.NewEnv()
+	err = grandchild.Define("c", "c")
+	if err != nil {
+		t.Errorf("Define error - received: %v - expected: %v", err, nil)
+	}
+	err = grandchild.Set("a", "c")
+	if err != ErrEnvFrozen {
+		t.Errorf("Set error - received: %v - expected: %v", err, ErrEnvFrozen)
+	}
+
+	var waitGroup sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		waitGroup.Add(1)
+		go func(i int) {
+			defer waitGroup.Done()
+			overlay := child.NewOverlay()
+			symbol := fmt.Sprint(i)
+			err := overlay.Set("a", symbol)
+			if err != nil {
+				t.Errorf("Set error - received: %v - expected: %v", err, nil)
+			}
+			value, err := overlay.Get("a")
+			if err != nil || value != symbol {
+				t.Errorf("Get - received: %v, %v - expected: %v, %v", value, err, symbol, nil)
+			}
+		}(i)
+	}
+	waitGroup.Wait()
+
+	value, err = base.Get("a")
+	if err != nil || value != "a" {
+		t.Errorf("Get - received: %v, %v - expected: %v, %v", value, err, "a", nil)
+	}
+}
+
+func TestFreezeConcurrent(t *testing.T) {
+	t.Parallel()
+
+	e := NewEnv()
+	err := e.Define("a", 0)
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+
+	var waitGroup sync.WaitGroup
+	var writes int32
+	stop := make(chan struct{})
+	for i := 0; i < 4; i++ {
+		waitGroup.Add(1)
+		go func(i int) {
+			defer waitGroup.Done()
+			symbol := fmt.Sprint("b", i)
+			for j := 0; ; j++ {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+				e.Set("a", j)
+				e.Define(symbol, j)
+				e.DefineType(symbol, j)
+				e.Delete(symbol)
+				atomic.AddInt32(&writes, 1)
+			}
+		}(i)
+	}
+	for atomic.LoadInt32(&writes) < 100 {
+		runtime.Gosched()
+	}
+	e.Freeze()
+
+	// no write gets through once Freeze returns
+	symbols := e.GetValueSymbols()
+	sort.Strings(symbols)
+	values := make(map[string]interface{}, len(symbols))
+	for _, symbol := range symbols {
+		values[symbol], _ = e.Get(symbol)
+	}
+	for start := atomic.LoadInt32(&writes); atomic.LoadInt32(&writes) < start+100; {
+		runtime.Gosched()
+	}
+	close(stop)
+	waitGroup.Wait()
+
+	received := e.GetValueSymbols()
+	sort.Strings(received)
+	if !reflect.DeepEqual(received, symbols) {
+		t.Errorf("GetValueSymbols - received: %v - expected: %v", received, symbols)
+	}
+	for symbol, expected := range values {
+		value, err := e.Get(symbol)
+		if err != nil || value != expected {
+			t.Errorf("Get %v - received: %v, %v - expected: %v, %v", symbol, value, err, expected, nil)
+		}
+	}
+}
+
+func benchmarkEnv() *Env {
+	e := NewEnv()
+	for i := 0; i < 500; i++ {
+		module, _ := e.NewModule(fmt.Sprint("module", i))
+		for j := 0; j < 20; j++ {
+			module.Define(fmt.Sprint("value", j), j)
+		}
+	}
+	return e.NewEnv()
+}
+
+func BenchmarkDeepCopy(b *testing.B) {
+	e := benchmarkEnv()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		e.DeepCopy()
+	}
+}
+
+func BenchmarkNewOverlay(b *testing.B) {
+	e := benchmarkEnv()
+	e.Freeze()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		e.NewOverlay()
+	}
+}
+
+func BenchmarkGetFrozen(b *testing.B) {
+	e := benchmarkEnv()
+	e.Freeze()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_, err := e.Get("module1")
+			if err != nil {
+				b.Errorf("Get error: %v", err)
+			}
+		}
+	})
+}
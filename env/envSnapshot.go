@@ -0,0 +1,629 @@
+package env
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+type (
+	// Snapshot is a copy of the values and types of an Env and its parent scopes
+	// that can be encoded with encoding/json or encoding/gob and restored later.
+	Snapshot struct {
+		Values map[string]*SnapshotValue `json:"values,omitempty"`
+		Types  map[string]*SnapshotType  `json:"types,omitempty"`
+		Parent *Snapshot                 `json:"parent,omitempty"`
+	}
+
+	// SnapshotValue is a value in a Snapshot.
+	// Type is nil for a nil value or a module.
+	SnapshotValue struct {
+		Type   *SnapshotType    `json:"type,omitempty"`
+		Bool   bool             `json:"bool,omitempty"`
+		Int    int64            `json:"int,omitempty"`
+		Uint   uint64           `json:"uint,omitempty"`
+		Float  float64          `json:"float,omitempty"`
+		String string           `json:"string,omitempty"`
+		Keys   []*SnapshotValue `json:"keys,omitempty"`
+		Elems  []*SnapshotValue `json:"elems,omitempty"`
+		Nil    bool             `json:"nil,omitempty"`
+		Module *Snapshot        `json:"module,omitempty"`
+	}
+
+	// SnapshotType is a type in a Snapshot.
+	// Kind is the name of the reflect.Kind.
+	SnapshotType struct {
+		Kind   string           `json:"kind"`
+		Key    *SnapshotType    `json:"key,omitempty"`
+		Elem   *SnapshotType    `json:"elem,omitempty"`
+		Len    int              `json:"len,omitempty"`
+		Fields []*SnapshotField `json:"fields,omitempty"`
+	}
+
+	// SnapshotField is a struct field of a SnapshotType.
+	SnapshotField struct {
+		Name string        `json:"name"`
+		Type *SnapshotType `json:"type"`
+		Tag  string        `json:"tag,omitempty"`
+	}
+
+	// SnapshotOptions for Snapshot.
+	SnapshotOptions struct {
+		// SkipUnsupported skips the values and types that can not be in a snapshot instead of returning an error.
+		// For example the functions defined by core.Import and by scripts.
+		SkipUnsupported bool
+	}
+
+	snapshotUnsupportedError struct {
+		rt    reflect.Type
+		cycle bool
+	}
+
+	snapshotState struct {
+		options *SnapshotOptions
+		// envs are the envs being snapshot, to stop on modules that contain themselves
+		envs map[*Env]struct{}
+		// values are the maps, slices and pointers being snapshot, to stop on values that contain themselves
+		values map[snapshotVisit]struct{}
+	}
+
+	snapshotVisit struct {
+		rt      reflect.Type
+		pointer uintptr
+		length  int
+	}
+)
+
+var (
+	envType = reflect.TypeOf((*Env)(nil))
+
+	snapshotKindTypes = map[string]reflect.Type{
+		reflect.Bool.String():      reflect.TypeOf(false),
+		reflect.Int.String():       reflect.TypeOf(int(0)),
+		reflect.Int8.String():      reflect.TypeOf(int8(0)),
+		reflect.Int16.String():     reflect.TypeOf(int16(0)),
+		reflect.Int32.String():     reflect.TypeOf(int32(0)),
+		reflect.Int64.String():     reflect.TypeOf(int64(0)),
+		reflect.Uint.String():      reflect.TypeOf(uint(0)),
+		reflect.Uint8.String():     reflect.TypeOf(uint8(0)),
+		reflect.Uint16.String():    reflect.TypeOf(uint16(0)),
+		reflect.Uint32.String():    reflect.TypeOf(uint32(0)),
+		reflect.Uint64.String():    reflect.TypeOf(uint64(0)),
+		reflect.Float32.String():   reflect.TypeOf(float32(0)),
+		reflect.Float64.String():   reflect.TypeOf(float64(0)),
+		reflect.String.String():    reflect.TypeOf(""),
+		reflect.Interface.String(): basicTypes["interface"],
+	}
+)
+
+func (err *snapshotUnsupportedError) Error() string {
+	if err.cycle {
+		if err.rt == envType {
+			return "env contains itself"
+		}
+		return "value of type " + err.rt.String() + " contains itself"
+	}
+	return "type " + err.rt.String() + " is not supported"
+}
+
+// Snapshot returns a Snapshot of the values and types of the Env and its parent scopes.
+// Supported are nil, bools, numbers, strings, slices, arrays, maps, pointers, structs without a package such as
+// the ones made by scripts, and Envs such as modules. Other values, for example funcs and chans, return an error.
+// The external lookup and the file system of the Env are not part of the snapshot.
+// options can be nil.
+func (e *Env) Snapshot(options *SnapshotOptions) (*Snapshot, error) {
+	if options == nil {
+		options = &SnapshotOptions{}
+	}
+
+	snapshot, err := e.snapshotScope(&snapshotState{options: options, envs: make(map[*Env]struct{}), values: make(map[snapshotVisit]struct{})})
+	if err != nil {
+		return nil, err
+	}
+	if e.parent != nil {
+		snapshot.Parent, err = e.parent.Snapshot(options)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return snapshot, nil
+}
+
+// snapshotScope returns a Snapshot of the current scope.
+func (e *Env) snapshotScope(state *snapshotState) (*Snapshot, error) {
+	if _, ok := state.envs[e]; ok {
+		return nil, &snapshotUnsupportedError{rt: envType, cycle: true}
+	}
+	state.envs[e] = struct{}{}
+	defer delete(state.envs, e)
+
+	e.rwMutex.RLock()
+	values := make(map[string]reflect.Value, len(e.values))
+	for symbol, value := range e.values {
+		values[symbol] = value
+	}
+	types := make(map[string]reflect.Type, len(e.types))
+	for symbol, aType := range e.types {
+		types[symbol] = aType
+	}
+	e.rwMutex.RUnlock()
+
+	snapshot := &Snapshot{
+		Values: make(map[string]*SnapshotValue, len(values)),
+	}
+
+	for _, symbol := range sortedSymbols(values) {
+		value, err := snapshotValue(values[symbol], state)
+		if err != nil {
+			if _, ok := err.(*snapshotUnsupportedError); ok && state.options.SkipUnsupported {
+				continue
+			}
+			return nil, fmt.Errorf("cannot snapshot symbol '%v': %v", symbol, err)
+		}
+		snapshot.Values[symbol] = value
+	}
+
+	for symbol, aType := range types {
+		snapshotType, err := snapshotTypeOf(aType)
+		if err != nil {
+			if state.options.SkipUnsupported {
+				continue
+			}
+			return nil, fmt.Errorf("cannot snapshot type '%v': %v", symbol, err)
+		}
+		if snapshot.Types == nil {
+			snapshot.Types = make(map[string]*SnapshotType, len(types))
+		}
+		snapshot.Types[symbol] = snapshotType
+	}
+
+	return snapshot, nil
+}
+
+func sortedSymbols(values map[string]reflect.Value) []string {
+	symbols := make([]string, 0, len(values))
+	for symbol := range values {
+		symbols = append(symbols, symbol)
+	}
+	sort.Strings(symbols)
+	return symbols
+}
+
+// snapshotValue returns the SnapshotValue of a value.
+func snapshotValue(rv reflect.Value, state *snapshotState) (*SnapshotValue, error) {
+	if rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return &SnapshotValue{}, nil
+		}
+		rv = rv.Elem()
+	}
+	if !rv.IsValid() {
+		return &SnapshotValue{}, nil
+	}
+
+	if rv.Type() == envType {
+		if rv.IsNil() {
+			return &SnapshotValue{}, nil
+		}
+		module, err := rv.Interface().(*Env).snapshotScope(state)
+		if err != nil {
+			return nil, err
+		}
+		return &SnapshotValue{Module: module}, nil
+	}
+
+	snapshotType, err := snapshotTypeOf(rv.Type())
+	if err != nil {
+		return nil, err
+	}
+	value := &SnapshotValue{Type: snapshotType}
+
+	switch rv.Kind() {
+	case reflect.Bool:
+		value.Bool = rv.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		value.Int = rv.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		value.Uint = rv.Uint()
+	case reflect.Float32, reflect.Float64:
+		value.Float = rv.Float()
+	case reflect.String:
+		value.String = rv.String()
+	case reflect.Slice, reflect.Map, reflect.Ptr:
+		if rv.IsNil() {
+			value.Nil = true
+			return value, nil
+		}
+		visit := snapshotVisit{rt: rv.Type(), pointer: rv.Pointer()}
+		if rv.Kind() == reflect.Slice {
+			visit.length = rv.Len()
+		}
+		if _, ok := state.values[visit]; ok {
+			return nil, &snapshotUnsupportedError{rt: rv.Type(), cycle: true}
+		}
+		state.values[visit] = struct{}{}
+		defer delete(state.values, visit)
+
+		switch rv.Kind() {
+		case reflect.Slice:
+			value.Elems, err = snapshotValues(rv, rv.Len(), state)
+		case reflect.Map:
+			keys := rv.MapKeys()
+			value.Keys = make([]*SnapshotValue, len(keys))
+			value.Elems = make([]*SnapshotValue, len(keys))
+			for i, key := range keys {
+				value.Keys[i], err = snapshotValue(key, state)
+				if err != nil {
+					return nil, err
+				}
+				value.Elems[i], err = snapshotValue(rv.MapIndex(key), state)
+				if err != nil {
+					return nil, err
+				}
+			}
+		case reflect.Ptr:
+			var elem *SnapshotValue
+			elem, err = snapshotValue(rv.Elem(), state)
+			value.Elems = []*SnapshotValue{elem}
+		}
+	case reflect.Array:
+		value.Elems, err = snapshotValues(rv, rv.Len(), state)
+	case reflect.Struct:
+		value.Elems, err = snapshotValues(rv, rv.NumField(), state)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// snapshotValues returns the SnapshotValues of the elements of a slice or an array, or the fields of a struct.
+func snapshotValues(rv reflect.Value, length int, state *snapshotState) ([]*SnapshotValue, error) {
+	values := make([]*SnapshotValue, length)
+	for i := 0; i < length; i++ {
+		var elem reflect.Value
+		if rv.Kind() == reflect.Struct {
+			elem = rv.Field(i)
+		} else {
+			elem = rv.Index(i)
+		}
+		var err error
+		values[i], err = snapshotValue(elem, state)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return values, nil
+}
+
+// snapshotTypeOf returns the SnapshotType of a type.
+func snapshotTypeOf(rt reflect.Type) (*SnapshotType, error) {
+	kind := rt.Kind()
+	if rt.PkgPath() != "" || (rt.Name() != "" && rt != snapshotKindTypes[kind.String()]) {
+		// named types can not be made from a snapshot
+		return nil, &snapshotUnsupportedError{rt: rt}
+	}
+
+	snapshotType := &SnapshotType{Kind: kind.String()}
+	var err error
+	switch kind {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+	case reflect.Interface:
+		if rt.NumMethod() > 0 {
+			return nil, &snapshotUnsupportedError{rt: rt}
+		}
+	case reflect.Slice, reflect.Ptr:
+		snapshotType.Elem, err = snapshotTypeOf(rt.Elem())
+	case reflect.Array:
+		snapshotType.Len = rt.Len()
+		snapshotType.Elem, err = snapshotTypeOf(rt.Elem())
+	case reflect.Map:
+		snapshotType.Key, err = snapshotTypeOf(rt.Key())
+		if err == nil {
+			snapshotType.Elem, err = snapshotTypeOf(rt.Elem())
+		}
+	case reflect.Struct:
+		snapshotType.Fields = make([]*SnapshotField, rt.NumField())
+		for i := 0; i < rt.NumField(); i++ {
+			field := rt.Field(i)
+			if field.PkgPath != "" {
+				return nil, &snapshotUnsupportedError{rt: rt}
+			}
+			snapshotType.Fields[i] = &SnapshotField{Name: field.Name, Tag: string(field.Tag)}
+			snapshotType.Fields[i].Type, err = snapshotTypeOf(field.Type)
+			if err != nil {
+				return nil, err
+			}
+		}
+	default:
+		return nil, &snapshotUnsupportedError{rt: rt}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return snapshotType, nil
+}
+
+// NewEnvFromSnapshot creates an Env with the values and types of the snapshot,
+// with parent scopes for the parents of the snapshot.
+func NewEnvFromSnapshot(snapshot *Snapshot) (*Env, error) {
+	var e *Env
+	if snapshot.Parent == nil {
+		e = NewEnv()
+	} else {
+		parent, err := NewEnvFromSnapshot(snapshot.Parent)
+		if err != nil {
+			return nil, err
+		}
+		e = parent.NewEnv()
+	}
+	return e, e.restoreScope(snapshot)
+}
+
+// Restore defines the values and types of the snapshot in the Env,
+// and the ones of the parents of the snapshot in the parent scopes of the Env.
+// Symbols that are not in the snapshot are kept.
+func (e *Env) Restore(snapshot *Snapshot) error {
+	for ; snapshot != nil; snapshot = snapshot.Parent {
+		if e == nil {
+			return fmt.Errorf("snapshot has more scopes than env")
+		}
+		err := e.restoreScope(snapshot)
+		if err != nil {
+			return err
+		}
+		e = e.parent
+	}
+	return nil
+}
+
+// restoreScope defines the values and types of the snapshot in the current scope.
+func (e *Env) restoreScope(snapshot *Snapshot) error {
+	for symbol, snapshotType := range snapshot.Types {
+		rt, err := snapshotType.reflectType()
+		if err != nil {
+			return fmt.Errorf("cannot restore type '%v': %v", symbol, err)
+		}
+		err = e.DefineReflectType(symbol, rt)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, symbol := range sortedSnapshotSymbols(snapshot.Values) {
+		value, err := snapshot.Values[symbol].reflectValue(e, nil)
+		if err != nil {
+			return fmt.Errorf("cannot restore symbol '%v': %v", symbol, err)
+		}
+		err = e.DefineValue(symbol, value)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func sortedSnapshotSymbols(values map[string]*SnapshotValue) []string {
+	symbols := make([]string, 0, len(values))
+	for symbol := range values {
+		symbols = append(symbols, symbol)
+	}
+	sort.Strings(symbols)
+	return symbols
+}
+
+// reflectType returns the type made from the SnapshotType.
+func (snapshotType *SnapshotType) reflectType() (reflect.Type, error) {
+	if snapshotType == nil {
+		return nil, fmt.Errorf("missing type")
+	}
+	if rt, ok := snapshotKindTypes[snapshotType.Kind]; ok {
+		return rt, nil
+	}
+
+	var elem reflect.Type
+	var err error
+	if snapshotType.Elem != nil {
+		elem, err = snapshotType.Elem.reflectType()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	switch snapshotType.Kind {
+	case reflect.Slice.String():
+		if elem != nil {
+			return reflect.SliceOf(elem), nil
+		}
+	case reflect.Ptr.String():
+		if elem != nil {
+			return reflect.PtrTo(elem), nil
+		}
+	case reflect.Array.String():
+		if elem != nil && snapshotType.Len >= 0 {
+			return reflect.ArrayOf(snapshotType.Len, elem), nil
+		}
+	case reflect.Map.String():
+		if elem != nil {
+			var key reflect.Type
+			key, err = snapshotType.Key.reflectType()
+			if err != nil {
+				return nil, err
+			}
+			if !key.Comparable() {
+				return nil, fmt.Errorf("invalid map key type %v", key)
+			}
+			return reflect.MapOf(key, elem), nil
+		}
+	case reflect.Struct.String():
+		fields := make([]reflect.StructField, len(snapshotType.Fields))
+		for i, field := range snapshotType.Fields {
+			fields[i] = reflect.StructField{Name: field.Name, Tag: reflect.StructTag(field.Tag)}
+			fields[i].Type, err = field.Type.reflectType()
+			if err != nil {
+				return nil, err
+			}
+		}
+		return structOf(fields)
+	}
+	return nil, fmt.Errorf("invalid type kind %v", snapshotType.Kind)
+}
+
+// structOf is reflect.StructOf returning invalid fields as an error instead of a panic.
+func structOf(fields []reflect.StructField) (rt reflect.Type, err error) {
+	defer func() {
+		if recoverInterface := recover(); recoverInterface != nil {
+			err = fmt.Errorf("invalid struct: %v", recoverInterface)
+		}
+	}()
+	return reflect.StructOf(fields), nil
+}
+
+// reflectValue returns the value made from the SnapshotValue.
+// Modules are made as child scopes of e. rt is the type the value is stored in, nil if unknown.
+func (snapshotValue *SnapshotValue) reflectValue(e *Env, rt reflect.Type) (reflect.Value, error) {
+	if snapshotValue == nil {
+		return NilValue, fmt.Errorf("missing value")
+	}
+	if snapshotValue.Module != nil {
+		module := e.NewEnv()
+		err := module.restoreScope(snapshotValue.Module)
+		if err != nil {
+			return NilValue, err
+		}
+		return reflect.ValueOf(module), nil
+	}
+	if snapshotValue.Type == nil {
+		if rt != nil {
+			return reflect.Zero(rt), nil
+		}
+		return NilValue, nil
+	}
+
+	valueType, err := snapshotValue.Type.reflectType()
+	if err != nil {
+		return NilValue, err
+	}
+	rv := reflect.New(valueType).Elem()
+
+	switch valueType.Kind() {
+	case reflect.Bool:
+		rv.SetBool(snapshotValue.Bool)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		rv.SetInt(snapshotValue.Int)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		rv.SetUint(snapshotValue.Uint)
+	case reflect.Float32, reflect.Float64:
+		rv.SetFloat(snapshotValue.Float)
+	case reflect.String:
+		rv.SetString(snapshotValue.String)
+	case reflect.Slice:
+		if snapshotValue.Nil {
+			break
+		}
+		rv.Set(reflect.MakeSlice(valueType, len(snapshotValue.Elems), len(snapshotValue.Elems)))
+		err = snapshotValue.setElems(e, rv, len(snapshotValue.Elems))
+	case reflect.Array:
+		if len(snapshotValue.Elems) != rv.Len() {
+			return NilValue, fmt.Errorf("array wants %v values but snapshot has %v", rv.Len(), len(snapshotValue.Elems))
+		}
+		err = snapshotValue.setElems(e, rv, rv.Len())
+	case reflect.Struct:
+		if len(snapshotValue.Elems) != rv.NumField() {
+			return NilValue, fmt.Errorf("struct wants %v fields but snapshot has %v", rv.NumField(), len(snapshotValue.Elems))
+		}
+		err = snapshotValue.setElems(e, rv, rv.NumField())
+	case reflect.Ptr:
+		if snapshotValue.Nil {
+			break
+		}
+		if len(snapshotValue.Elems) != 1 {
+			return NilValue, fmt.Errorf("pointer wants 1 value but snapshot has %v", len(snapshotValue.Elems))
+		}
+		rv.Set(reflect.New(valueType.Elem()))
+		err = snapshotValue.setElems(e, rv.Elem(), -1)
+	case reflect.Map:
+		if snapshotValue.Nil {
+			break
+		}
+		if len(snapshotValue.Keys) != len(snapshotValue.Elems) {
+			return NilValue, fmt.Errorf("map has %v keys but %v values", len(snapshotValue.Keys), len(snapshotValue.Elems))
+		}
+		rv.Set(reflect.MakeMapWithSize(valueType, len(snapshotValue.Keys)))
+		for i := 0; i < len(snapshotValue.Keys); i++ {
+			var key reflect.Value
+			key, err = snapshotValue.Keys[i].reflectValue(e, valueType.Key())
+			if err != nil {
+				return NilValue, err
+			}
+			var elem reflect.Value
+			elem, err = snapshotValue.Elems[i].reflectValue(e, valueType.Elem())
+			if err != nil {
+				return NilValue, err
+			}
+			key, err = assignableValue(key, valueType.Key())
+			if err != nil {
+				return NilValue, err
+			}
+			elem, err = assignableValue(elem, valueType.Elem())
+			if err != nil {
+				return NilValue, err
+			}
+			rv.SetMapIndex(key, elem)
+		}
+	}
+	if err != nil {
+		return NilValue, err
+	}
+	return rv, nil
+}
+
+// setElems sets the elements of a slice or an array, or the fields of a struct, from the Elems.
+// A length of -1 sets rv from the one Elem of a pointer.
+func (snapshotValue *SnapshotValue) setElems(e *Env, rv reflect.Value, length int) error {
+	if length < 0 {
+		elem, err := snapshotValue.Elems[0].reflectValue(e, rv.Type())
+		if err != nil {
+			return err
+		}
+		elem, err = assignableValue(elem, rv.Type())
+		if err != nil {
+			return err
+		}
+		rv.Set(elem)
+		return nil
+	}
+
+	for i := 0; i < length; i++ {
+		var target reflect.Value
+		if rv.Kind() == reflect.Struct {
+			target = rv.Field(i)
+		} else {
+			target = rv.Index(i)
+		}
+		elem, err := snapshotValue.Elems[i].reflectValue(e, target.Type())
+		if err != nil {
+			return err
+		}
+		elem, err = assignableValue(elem, target.Type())
+		if err != nil {
+			return err
+		}
+		target.Set(elem)
+	}
+	return nil
+}
+
+// assignableValue returns the value if it can be assigned to the type, nil interface values become the zero value.
+func assignableValue(rv reflect.Value, rt reflect.Type) (reflect.Value, error) {
+	if rv.Kind() == reflect.Interface && rv.IsNil() {
+		return reflect.Zero(rt), nil
+	}
+	if !rv.Type().AssignableTo(rt) {
+		return NilValue, fmt.Errorf("type %v cannot be assigned to type %v", rv.Type(), rt)
+	}
+	return rv, nil
+}
@@ -0,0 +1,249 @@
+package env
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSnapshot(t *testing.T) {
+	t.Parallel()
+
+	structType := reflect.StructOf([]reflect.StructField{
+		{Name: "X", Type: reflect.TypeOf(int64(0))},
+		{Name: "Y", Type: reflect.TypeOf([]interface{}{}), Tag: `json:"y"`},
+	})
+	structValue := reflect.New(structType)
+	structValue.Elem().Field(0).SetInt(1)
+	structValue.Elem().Field(1).Set(reflect.ValueOf([]interface{}{"a", nil}))
+
+	e := NewEnv()
+	values := map[string]interface{}{
+		"nil":     nil,
+		"bool":    true,
+		"int64":   int64(-1),
+		"int32":   int32(2),
+		"uint8":   uint8(3),
+		"float64": 1.5,
+		"string":  "a",
+		"slice":   []interface{}{int64(1), "b", []interface{}{true}, map[interface{}]interface{}{"c": nil}},
+		"bytes":   []byte("abc"),
+		"nilMap":  map[string]int64(nil),
+		"map":     map[interface{}]interface{}{"a": int64(1), int64(2): []interface{}{"b"}},
+		"array":   [2]string{"a", "b"},
+		"pointer": &[]int64{1},
+	}
+	for symbol, value := range values {
+		err := e.Define(symbol, value)
+		if err != nil {
+			t.Fatal("Define error:", err)
+		}
+	}
+	err := e.DefineValue("struct", structValue)
+	if err != nil {
+		t.Fatal("DefineValue error:", err)
+	}
+	err = e.DefineReflectType("structType", structType)
+	if err != nil {
+		t.Fatal("DefineReflectType error:", err)
+	}
+	module, err := e.NewModule("module")
+	if err != nil {
+		t.Fatal("NewModule error:", err)
+	}
+	err = module.Define("a", "module a")
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+	child := e.NewEnv()
+	err = child.Define("child", int64(1))
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+
+	snapshot, err := child.Snapshot(nil)
+	if err != nil {
+		t.Fatal("Snapshot error:", err)
+	}
+
+	jsonBytes, err := json.Marshal(snapshot)
+	if err != nil {
+		t.Fatal("Marshal error:", err)
+	}
+	var jsonSnapshot Snapshot
+	err = json.Unmarshal(jsonBytes, &jsonSnapshot)
+	if err != nil {
+		t.Fatal("Unmarshal error:", err)
+	}
+
+	var gobBuffer bytes.Buffer
+	err = gob.NewEncoder(&gobBuffer).Encode(snapshot)
+	if err != nil {
+		t.Fatal("Encode error:", err)
+	}
+	var gobSnapshot Snapshot
+	err = gob.NewDecoder(&gobBuffer).Decode(&gobSnapshot)
+	if err != nil {
+		t.Fatal("Decode error:", err)
+	}
+
+	for name, snapshot := range map[string]*Snapshot{"snapshot": snapshot, "json": &jsonSnapshot, "gob": &gobSnapshot} {
+		restored, err := NewEnvFromSnapshot(snapshot)
+		if err != nil {
+			t.Errorf("NewEnvFromSnapshot error - %v - received: %v - expected: %v", name, err, nil)
+			continue
+		}
+
+		value, err := restored.Get("child")
+		if err != nil || value != int64(1) {
+			t.Errorf("child - %v - received: %v, %v - expected: %v, %v", name, value, err, int64(1), nil)
+		}
+		if restored.parent == nil || restored.parent.parent != nil {
+			t.Errorf("scopes - %v - restored env does not have one parent", name)
+			continue
+		}
+
+		for symbol, expected := range values {
+			value, err := restored.Get(symbol)
+			if err != nil {
+				t.Errorf("Get error - %v - symbol: %v - received: %v - expected: %v", name, symbol, err, nil)
+				continue
+			}
+			if !reflect.DeepEqual(value, expected) {
+				t.Errorf("Get - %v - symbol: %v - received: %#v - expected: %#v", name, symbol, value, expected)
+			}
+		}
+
+		restoredType, err := restored.Type("structType")
+		if err != nil || restoredType != structType {
+			t.Errorf("Type - %v - received: %v, %v - expected: %v, %v", name, restoredType, err, structType, nil)
+		}
+		value, err = restored.Get("struct")
+		if err != nil || !reflect.DeepEqual(value, structValue.Interface()) {
+			t.Errorf("struct - %v - received: %#v, %v - expected: %#v, %v", name, value, err, structValue.Interface(), nil)
+		}
+
+		value, err = restored.Get("module")
+		if err != nil {
+			t.Errorf("module error - %v - received: %v - expected: %v", name, err, nil)
+			continue
+		}
+		value, err = value.(*Env).Get("a")
+		if err != nil || value != "module a" {
+			t.Errorf("module a - %v - received: %v, %v - expected: %v, %v", name, value, err, "module a", nil)
+		}
+	}
+
+	// restore into existing scopes
+	e = NewEnv()
+	err = e.Define("keep", "keep")
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+	err = e.NewEnv().Restore(snapshot)
+	if err != nil {
+		t.Fatal("Restore error:", err)
+	}
+	for _, symbol := range []string{"keep", "string", "module"} {
+		_, err = e.Get(symbol)
+		if err != nil {
+			t.Errorf("Get error - symbol: %v - received: %v - expected: %v", symbol, err, nil)
+		}
+	}
+	err = NewEnv().Restore(snapshot)
+	if err == nil || err.Error() != "snapshot has more scopes than env" {
+		t.Errorf("Restore error - received: %v - expected: %v", err, "snapshot has more scopes than env")
+	}
+}
+
+func TestSnapshotUnsupported(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		value interface{}
+		err   string
+	}{
+		{value: func() {}, err: "cannot snapshot symbol 'a': type func() is not supported"},
+		{value: make(chan int64), err: "cannot snapshot symbol 'a': type chan int64 is not supported"},
+		{value: []interface{}{int64(1), func() {}}, err: "cannot snapshot symbol 'a': type func() is not supported"},
+		{value: bytes.Buffer{}, err: "cannot snapshot symbol 'a': type bytes.Buffer is not supported"},
+		{value: struct{ a int64 }{}, err: "cannot snapshot symbol 'a': type struct { a int64 } is not supported"},
+	}
+	for _, test := range tests {
+		e := NewEnv()
+		err := e.Define("a", test.value)
+		if err != nil {
+			t.Fatal("Define error:", err)
+		}
+		err = e.Define("b", int64(1))
+		if err != nil {
+			t.Fatal("Define error:", err)
+		}
+
+		_, err = e.Snapshot(nil)
+		if err == nil || err.Error() != test.err {
+			t.Errorf("Snapshot error - received: %v - expected: %v", err, test.err)
+		}
+
+		snapshot, err := e.Snapshot(&SnapshotOptions{SkipUnsupported: true})
+		if err != nil {
+			t.Errorf("Snapshot error - received: %v - expected: %v", err, nil)
+			continue
+		}
+		if len(snapshot.Values) != 1 || snapshot.Values["b"] == nil {
+			t.Errorf("Snapshot values - received: %v - expected: only b", snapshot.Values)
+		}
+	}
+
+	e := NewEnv()
+	module, err := e.NewModule("module")
+	if err != nil {
+		t.Fatal("NewModule error:", err)
+	}
+	err = module.Define("self", module)
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+	_, err = e.Snapshot(nil)
+	if err == nil || !strings.HasSuffix(err.Error(), "env contains itself") {
+		t.Errorf("Snapshot error - received: %v - expected: %v", err, "env contains itself")
+	}
+
+	self := map[interface{}]interface{}{"a": int64(1)}
+	self["self"] = self
+	list := []interface{}{int64(1), nil}
+	list[1] = list
+	shared := []interface{}{int64(1)}
+	for _, value := range []interface{}{self, list, map[string]interface{}{"a": self}} {
+		e = NewEnv()
+		err = e.Define("a", value)
+		if err != nil {
+			t.Fatal("Define error:", err)
+		}
+		_, err = e.Snapshot(nil)
+		if err == nil || !strings.HasPrefix(err.Error(), "cannot snapshot symbol 'a': value of type ") || !strings.HasSuffix(err.Error(), " contains itself") {
+			t.Errorf("Snapshot error - received: %v - expected: %v", err, "value contains itself")
+		}
+		snapshot, err := e.Snapshot(&SnapshotOptions{SkipUnsupported: true})
+		if err != nil || len(snapshot.Values) != 0 {
+			t.Errorf("Snapshot - received: %v, %v - expected: no values", snapshot, err)
+		}
+	}
+	e = NewEnv()
+	err = e.Define("a", []interface{}{shared, shared})
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+	_, err = e.Snapshot(nil)
+	if err != nil {
+		t.Errorf("Snapshot error - received: %v - expected: %v", err, nil)
+	}
+
+	_, err = NewEnvFromSnapshot(&Snapshot{Values: map[string]*SnapshotValue{"a": {Type: &SnapshotType{Kind: "func"}}}})
+	if err == nil || err.Error() != "cannot restore symbol 'a': invalid type kind func" {
+		t.Errorf("NewEnvFromSnapshot error - received: %v - expected: %v", err, "cannot restore symbol 'a': invalid type kind func")
+	}
+}
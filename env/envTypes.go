@@ -27,8 +27,10 @@ func (e *Env) DefineReflectType(symbol string, reflectType reflect.Type) error {
 	if strings.Contains(symbol, ".") {
 		return ErrSymbolContainsDot
 	}
-
-	e.rwMutex.Lock()
+	err := e.lockWrite()
+	if err != nil {
+		return err
+	}
 	if e.types == nil {
 		e.types = make(map[string]reflect.Type)
 	}
@@ -40,25 +42,25 @@ func (e *Env) DefineReflectType(symbol string, reflectType reflect.Type) error {
 
 // DefineGlobalType defines type in global scope.
 func (e *Env) DefineGlobalType(symbol string, aType interface{}) error {
-	for e.parent != nil {
-		e = e.parent
-	}
-	return e.DefineType(symbol, aType)
+	return e.globalScope().DefineType(symbol, aType)
 }
 
 // DefineGlobalReflectType defines type in global scope.
 func (e *Env) DefineGlobalReflectType(symbol string, reflectType reflect.Type) error {
-	for e.parent != nil {
-		e = e.parent
-	}
-	return e.DefineReflectType(symbol, reflectType)
+	return e.globalScope().DefineReflectType(symbol, reflectType)
 }
 
 // Type returns reflect type from the scope where symbol is frist found.
 func (e *Env) Type(symbol string) (reflect.Type, error) {
-	e.rwMutex.RLock()
-	reflectType, ok := e.types[symbol]
-	e.rwMutex.RUnlock()
+	var reflectType reflect.Type
+	var ok bool
+	if e.IsFrozen() {
+		reflectType, ok = e.types[symbol]
+	} else {
+		e.rwMutex.RLock()
+		reflectType, ok = e.types[symbol]
+		e.rwMutex.RUnlock()
+	}
 	if ok {
 		return reflectType, nil
 	}
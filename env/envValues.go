@@ -21,27 +21,36 @@ func (e *Env) DefineValue(symbol string, value reflect.Value) error {
 	if strings.Contains(symbol, ".") {
 		return ErrSymbolContainsDot
 	}
-	e.rwMutex.Lock()
+	err := e.lockWrite()
+	if err != nil {
+		return err
+	}
+	if definer, ok := e.externalLookup.(ExternalLookupDefiner); ok {
+		oldValue, _ := definer.Get(symbol)
+		err = definer.Define(symbol, value)
+		e.rwMutex.Unlock()
+		if err != nil {
+			return err
+		}
+		e.notify(ChangeDefine, symbol, oldValue, value)
+		return nil
+	}
+	oldValue := e.values[symbol]
 	e.values[symbol] = value
 	e.rwMutex.Unlock()
 
+	e.notify(ChangeDefine, symbol, oldValue, value)
 	return nil
 }
 
 // DefineGlobal defines/sets interface value to symbol in global scope.
 func (e *Env) DefineGlobal(symbol string, value interface{}) error {
-	for e.parent != nil {
-		e = e.parent
-	}
-	return e.Define(symbol, value)
+	return e.globalScope().Define(symbol, value)
 }
 
 // DefineGlobalValue defines/sets reflect value to symbol in global scope.
 func (e *Env) DefineGlobalValue(symbol string, value reflect.Value) error {
-	for e.parent != nil {
-		e = e.parent
-	}
-	return e.DefineValue(symbol, value)
+	return e.globalScope().DefineValue(symbol, value)
 }
 
 // set
@@ -60,15 +69,54 @@ func (e *Env) SetValue(symbol string, value reflect.Value) error {
 	_, ok := e.values[symbol]
 	e.rwMutex.RUnlock()
 	if ok {
-		e.rwMutex.Lock()
+		err := e.lockWrite()
+		if err != nil {
+			return err
+		}
+		oldValue := e.values[symbol]
 		e.values[symbol] = value
 		e.rwMutex.Unlock()
+		e.notify(ChangeSet, symbol, oldValue, value)
 		return nil
 	}
 
+	if setter, ok := e.externalLookup.(ExternalLookupSetter); ok {
+		oldValue, err := setter.Get(symbol)
+		if err == nil {
+			err = e.lockWrite()
+			if err != nil {
+				return err
+			}
+			err = setter.Set(symbol, value)
+			e.rwMutex.Unlock()
+			if err != nil {
+				return err
+			}
+			e.notify(ChangeSet, symbol, oldValue, value)
+			return nil
+		}
+	}
+
 	if e.parent == nil {
 		return fmt.Errorf("undefined symbol '%s'", symbol)
 	}
+
+	if e.overlay {
+		// copy on write, the symbol is set in the overlay
+		oldValue, err := e.parent.GetValue(symbol)
+		if err != nil {
+			return err
+		}
+		err = e.lockWrite()
+		if err != nil {
+			return err
+		}
+		e.values[symbol] = value
+		e.rwMutex.Unlock()
+		e.notify(ChangeSet, symbol, oldValue, value)
+		return nil
+	}
+
 	return e.parent.SetValue(symbol, value)
 }
 
@@ -82,9 +130,15 @@ func (e *Env) Get(symbol string) (interface{}, error) {
 
 // GetValue returns reflect value from the scope where symbol is frist found.
 func (e *Env) GetValue(symbol string) (reflect.Value, error) {
-	e.rwMutex.RLock()
-	value, ok := e.values[symbol]
-	e.rwMutex.RUnlock()
+	var value reflect.Value
+	var ok bool
+	if e.IsFrozen() {
+		value, ok = e.values[symbol]
+	} else {
+		e.rwMutex.RLock()
+		value, ok = e.values[symbol]
+		e.rwMutex.RUnlock()
+	}
 	if ok {
 		return value, nil
 	}
@@ -104,7 +158,8 @@ func (e *Env) GetValue(symbol string) (reflect.Value, error) {
 	return e.parent.GetValue(symbol)
 }
 
-// GetValueSymbols returns all value symbol in the current scope.
+// GetValueSymbols returns all value symbol in the current scope,
+// including the ones of an external lookup that implements ExternalLookupSymbols.
 func (e *Env) GetValueSymbols() []string {
 	symbols := make([]string, 0, len(e.values))
 	e.rwMutex.RLock()
@@ -112,6 +167,17 @@ func (e *Env) GetValueSymbols() []string {
 		symbols = append(symbols, symbol)
 	}
 	e.rwMutex.RUnlock()
+
+	if lookupSymbols, ok := e.externalLookup.(ExternalLookupSymbols); ok {
+		for _, symbol := range lookupSymbols.Symbols() {
+			e.rwMutex.RLock()
+			_, ok := e.values[symbol]
+			e.rwMutex.RUnlock()
+			if !ok {
+				symbols = append(symbols, symbol)
+			}
+		}
+	}
 	return symbols
 }
 
@@ -119,14 +185,21 @@ func (e *Env) GetValueSymbols() []string {
 
 // Delete deletes symbol in current scope.
 func (e *Env) Delete(symbol string) {
-	e.rwMutex.Lock()
+	if e.lockWrite() != nil {
+		return
+	}
+	oldValue, ok := e.values[symbol]
 	delete(e.values, symbol)
 	e.rwMutex.Unlock()
+
+	if ok {
+		e.notify(ChangeDelete, symbol, oldValue, reflect.Value{})
+	}
 }
 
-// DeleteGlobal deletes the first matching symbol found in current or parent scope.
+// DeleteGlobal deletes the first matching symbol found in current or parent scope, stopping at an overlay.
 func (e *Env) DeleteGlobal(symbol string) {
-	if e.parent == nil {
+	if e.parent == nil || e.overlay {
 		e.Delete(symbol)
 		return
 	}
@@ -0,0 +1,194 @@
+//go:build !appengine
+// +build !appengine
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/mattn/anko/parser"
+)
+
+// formatFiles is the fmt subcommand, it prints the formatted scripts, or with -w writes them back.
+// With -l it only prints the names of the scripts that are not formatted.
+// Returns 2 if a script could not be read or written and 3 if a script has a syntax error.
+func formatFiles(arguments []string) int {
+	flagSet := flag.NewFlagSet("fmt", flag.ContinueOnError)
+	flagList := flagSet.Bool("l", false, "list the files that are not formatted")
+	flagWrite := flagSet.Bool("w", false, "write the formatted source back to the files")
+	if err := flagSet.Parse(arguments); err != nil {
+		return 2
+	}
+	names := flagSet.Args()
+	if len(names) == 0 {
+		names = []string{"-"}
+	}
+
+	parser.EnableErrorVerbose()
+	exitCode := 0
+	for _, name := range names {
+		source, err := readScript(name)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "ReadFile error:", err)
+			exitCode = 2
+			continue
+		}
+		formatted, err := formatSource(string(source))
+		if err != nil {
+			if e, ok := err.(*parser.Error); ok {
+				fmt.Fprintf(os.Stderr, "%v:%v:%v: %v\n", name, e.Pos.Line, e.Pos.Column, e.Message)
+			} else {
+				fmt.Fprintf(os.Stderr, "%v: %v\n", name, err)
+			}
+			if exitCode == 0 {
+				exitCode = 3
+			}
+			continue
+		}
+
+		if *flagList {
+			if formatted != string(source) {
+				fmt.Println(name)
+			}
+			continue
+		}
+		if *flagWrite && name != "-" {
+			if formatted == string(source) {
+				continue
+			}
+			info, err := os.Stat(name)
+			if err == nil {
+				err = ioutil.WriteFile(name, []byte(formatted), info.Mode())
+			}
+			if err != nil {
+				fmt.Fprintln(os.Stderr, "WriteFile error:", err)
+				exitCode = 2
+			}
+			continue
+		}
+		fmt.Print(formatted)
+	}
+	return exitCode
+}
+
+// formatSource indents each line with tabs by how deep it is in brackets,
+// removes trailing white space and repeated empty lines, and ends the source with a newline.
+// Raw strings and block comments that span lines are kept as they are.
+// Returns the syntax error if the source does not parse.
+func formatSource(source string) (string, error) {
+	_, err := parser.ParseSrc(source)
+	if err != nil {
+		return "", err
+	}
+
+	var builder strings.Builder
+	var state formatState
+	emptyLines := 0
+	for _, line := range strings.Split(strings.Replace(source, "\r\n", "\n", -1), "\n") {
+		if state.inRawString || state.inComment {
+			state.scan(line)
+			if !state.inRawString {
+				line = strings.TrimRight(line, " \t")
+			}
+			builder.WriteString(line + "\n")
+			continue
+		}
+
+		line = strings.TrimLeft(line, " \t")
+		depth := state.depth
+		state.scan(line)
+		if !state.inRawString {
+			line = strings.TrimRight(line, " \t")
+		}
+		if line == "" {
+			emptyLines++
+			continue
+		}
+		if emptyLines > 0 && builder.Len() > 0 {
+			builder.WriteString("\n")
+		}
+		emptyLines = 0
+
+		// closing brackets at the start of the line are indented like the line that opened them
+		for i := 0; i < len(line) && depth > 0 && strings.IndexByte(")]}", line[i]) >= 0; i++ {
+			depth--
+		}
+		if depth > 0 && isCaseLine(line) {
+			depth--
+		}
+		builder.WriteString(strings.Repeat("\t", depth) + line + "\n")
+	}
+	return builder.String(), nil
+}
+
+// isCaseLine returns true if the line starts a case of a switch, which is indented like the switch.
+func isCaseLine(line string) bool {
+	for _, keyword := range []string{"case", "default"} {
+		if strings.HasPrefix(line, keyword) && (len(line) == len(keyword) || !isWordByte(line[len(keyword)])) {
+			return true
+		}
+	}
+	return false
+}
+
+// formatState is the bracket depth and whether a raw string or block comment is open at the end of a line.
+type formatState struct {
+	depth       int
+	inRawString bool
+	inComment   bool
+}
+
+// scan updates the state with the line.
+func (state *formatState) scan(line string) {
+	for i := 0; i < len(line); i++ {
+		switch {
+		case state.inComment:
+			end := strings.Index(line[i:], "*/")
+			if end < 0 {
+				return
+			}
+			i += end + 1
+			state.inComment = false
+			continue
+		case state.inRawString:
+			end := strings.IndexByte(line[i:], '`')
+			if end < 0 {
+				return
+			}
+			i += end
+			state.inRawString = false
+			continue
+		}
+
+		switch ch := line[i]; ch {
+		case '"', '\'':
+			for i++; i < len(line) && line[i] != ch; i++ {
+				if line[i] == '\\' {
+					i++
+				}
+			}
+		case '`':
+			state.inRawString = true
+		case '#':
+			return
+		case '/':
+			if i+1 < len(line) && line[i+1] == '/' {
+				return
+			}
+			if i+1 < len(line) && line[i+1] == '*' {
+				state.inComment = true
+				i++
+			}
+		case '(', '[', '{':
+			state.depth++
+		case ')', ']', '}':
+			if state.depth > 0 {
+				state.depth--
+			}
+		}
+	}
+}
@@ -1,3 +1,7 @@
 module github.com/mattn/anko
 
-go 1.13
+go 1.16
+
+// liner is only imported by the anko command in the root package for the REPL,
+// Go programs embedding Anko import the other packages and do not build it.
+require github.com/peterh/liner v1.2.2
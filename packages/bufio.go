@@ -0,0 +1,96 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
+package packages
+
+import (
+	"bufio"
+	"reflect"
+
+	"github.com/mattn/anko/env"
+)
+
+func init() {
+	env.Packages["bufio"] = map[string]reflect.Value{
+		"ErrAdvanceTooFar":     reflect.ValueOf(bufio.ErrAdvanceTooFar),
+		"ErrBadReadCount":      reflect.ValueOf(bufio.ErrBadReadCount),
+		"ErrBufferFull":        reflect.ValueOf(bufio.ErrBufferFull),
+		"ErrFinalToken":        reflect.ValueOf(bufio.ErrFinalToken),
+		"ErrInvalidUnreadByte": reflect.ValueOf(bufio.ErrInvalidUnreadByte),
+		"ErrInvalidUnreadRune": reflect.ValueOf(bufio.ErrInvalidUnreadRune),
+		"ErrNegativeAdvance":   reflect.ValueOf(bufio.ErrNegativeAdvance),
+		"ErrNegativeCount":     reflect.ValueOf(bufio.ErrNegativeCount),
+		"ErrTooLong":           reflect.ValueOf(bufio.ErrTooLong),
+		"MaxScanTokenSize":     reflect.ValueOf(bufio.MaxScanTokenSize),
+		"NewReadWriter":        reflect.ValueOf(bufio.NewReadWriter),
+		"NewReader":            reflect.ValueOf(bufio.NewReader),
+		"NewReaderSize":        reflect.ValueOf(bufio.NewReaderSize),
+		"NewScanner":           reflect.ValueOf(bufio.NewScanner),
+		"NewWriter":            reflect.ValueOf(bufio.NewWriter),
+		"NewWriterSize":        reflect.ValueOf(bufio.NewWriterSize),
+		"ScanBytes":            reflect.ValueOf(bufio.ScanBytes),
+		"ScanLines":            reflect.ValueOf(bufio.ScanLines),
+		"ScanRunes":            reflect.ValueOf(bufio.ScanRunes),
+		"ScanWords":            reflect.ValueOf(bufio.ScanWords),
+	}
+	env.PackageTypes["bufio"] = map[string]reflect.Type{
+		"ReadWriter": reflect.TypeOf(bufio.ReadWriter{}),
+		"Reader":     reflect.TypeOf(bufio.Reader{}),
+		"Scanner":    reflect.TypeOf(bufio.Scanner{}),
+		"SplitFunc":  reflect.TypeOf((*bufio.SplitFunc)(nil)).Elem(),
+		"Writer":     reflect.TypeOf(bufio.Writer{}),
+	}
+	env.PackageDocs["bufio"] = map[string]string{
+		"ErrAdvanceTooFar":   "Errors returned by Scanner.",
+		"ErrBadReadCount":    "Errors returned by Scanner.",
+		"ErrFinalToken":      "ErrFinalToken is a special sentinel error value.",
+		"ErrNegativeAdvance": "Errors returned by Scanner.",
+		"ErrTooLong":         "Errors returned by Scanner.",
+		"MaxScanTokenSize":   "MaxScanTokenSize is the maximum size used to buffer a token unless the user provides an explicit buffer with [Scanner.Buffer].",
+		"NewReadWriter":      "NewReadWriter allocates a new [ReadWriter] that dispatches to r and w.",
+		"NewReader":          "NewReader returns a new [Reader] whose buffer has the default size.",
+		"NewReaderSize":      "NewReaderSize returns a new [Reader] whose buffer has at least the specified size.",
+		"NewScanner":         "NewScanner returns a new [Scanner] to read from r.",
+		"NewWriter":          "NewWriter returns a new [Writer] whose buffer has the default size.",
+		"NewWriterSize":      "NewWriterSize returns a new [Writer] whose buffer has at least the specified size.",
+		"ReadWriter":         "ReadWriter stores pointers to a [Reader] and a [Writer].",
+		"Reader":             "Reader implements buffering for an io.Reader object.",
+		"Reader.Buffered":    "Buffered returns the number of bytes that can be read from the current buffer.",
+		"Reader.Discard":     "Discard skips the next n bytes, returning the number of bytes discarded.",
+		"Reader.Peek":        "Peek returns the next n bytes without advancing the reader.",
+		"Reader.Read":        "Read reads data into p.",
+		"Reader.ReadByte":    "ReadByte reads and returns a single byte.",
+		"Reader.ReadBytes":   "ReadBytes reads until the first occurrence of delim in the input, returning a slice containing the data up to and including the delimiter.",
+		"Reader.ReadLine":    "ReadLine is a low-level line-reading primitive.",
+		"Reader.ReadRune":    "ReadRune reads a single UTF-8 encoded Unicode character and returns the rune and its size in bytes.",
+		"Reader.ReadSlice":   "ReadSlice reads until the first occurrence of delim in the input, returning a slice pointing at the bytes in the buffer.",
+		"Reader.ReadString":  "ReadString reads until the first occurrence of delim in the input, returning a string containing the data up to and including the delimiter.",
+		"Reader.Reset":       "Reset discards any buffered data, resets all state, and switches the buffered reader to read from r.",
+		"Reader.Size":        "Size returns the size of the underlying buffer in bytes.",
+		"Reader.UnreadByte":  "UnreadByte unreads the last byte.",
+		"Reader.UnreadRune":  "UnreadRune unreads the last rune.",
+		"Reader.WriteTo":     "WriteTo implements io.WriterTo.",
+		"ScanBytes":          "ScanBytes is a split function for a [Scanner] that returns each byte as a token.",
+		"ScanLines":          "ScanLines is a split function for a [Scanner] that returns each line of text, stripped of any trailing end-of-line marker.",
+		"ScanRunes":          "ScanRunes is a split function for a [Scanner] that returns each UTF-8-encoded rune as a token.",
+		"ScanWords":          "ScanWords is a split function for a [Scanner] that returns each space-separated word of text, with surrounding spaces deleted.",
+		"Scanner":            "Scanner provides a convenient interface for reading data such as a file of newline-delimited lines of text.",
+		"Scanner.Buffer":     "Buffer controls memory allocation by the Scanner.",
+		"Scanner.Bytes":      "Bytes returns the most recent token generated by a call to [Scanner.Scan].",
+		"Scanner.Err":        "Err returns the first non-EOF error that was encountered by the [Scanner].",
+		"Scanner.Scan":       "Scan advances the [Scanner] to the next token, which will then be available through the [Scanner.Bytes] or [Scanner.Text] method.",
+		"Scanner.Split":      "Split sets the split function for the [Scanner].",
+		"Scanner.Text":       "Text returns the most recent token generated by a call to [Scanner.Scan] as a newly allocated string holding its bytes.",
+		"SplitFunc":          "SplitFunc is the signature of the split function used to tokenize the input.",
+		"Writer":             "Writer implements buffering for an io.Writer object.",
+		"Writer.Available":   "Available returns how many bytes are unused in the buffer.",
+		"Writer.Buffered":    "Buffered returns the number of bytes that have been written into the current buffer.",
+		"Writer.Flush":       "Flush writes any buffered data to the underlying io.Writer.",
+		"Writer.ReadFrom":    "ReadFrom implements io.ReaderFrom.",
+		"Writer.Reset":       "Reset discards any unflushed buffered data, clears any error, and resets b to write its output to w.",
+		"Writer.Size":        "Size returns the size of the underlying buffer in bytes.",
+		"Writer.Write":       "Write writes the contents of p into the buffer.",
+		"Writer.WriteByte":   "WriteByte writes a single byte.",
+		"Writer.WriteRune":   "WriteRune writes a single Unicode code point, returning the number of bytes written and any error.",
+		"Writer.WriteString": "WriteString writes a string.",
+	}
+}
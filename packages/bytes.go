@@ -1,3 +1,5 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
 package packages
 
 import (
@@ -11,9 +13,12 @@ func init() {
 	env.Packages["bytes"] = map[string]reflect.Value{
 		"Compare":         reflect.ValueOf(bytes.Compare),
 		"Contains":        reflect.ValueOf(bytes.Contains),
+		"ContainsAny":     reflect.ValueOf(bytes.ContainsAny),
+		"ContainsRune":    reflect.ValueOf(bytes.ContainsRune),
 		"Count":           reflect.ValueOf(bytes.Count),
 		"Equal":           reflect.ValueOf(bytes.Equal),
 		"EqualFold":       reflect.ValueOf(bytes.EqualFold),
+		"ErrTooLarge":     reflect.ValueOf(bytes.ErrTooLarge),
 		"Fields":          reflect.ValueOf(bytes.Fields),
 		"FieldsFunc":      reflect.ValueOf(bytes.FieldsFunc),
 		"HasPrefix":       reflect.ValueOf(bytes.HasPrefix),
@@ -29,11 +34,13 @@ func init() {
 		"LastIndexByte":   reflect.ValueOf(bytes.LastIndexByte),
 		"LastIndexFunc":   reflect.ValueOf(bytes.LastIndexFunc),
 		"Map":             reflect.ValueOf(bytes.Map),
+		"MinRead":         reflect.ValueOf(bytes.MinRead),
 		"NewBuffer":       reflect.ValueOf(bytes.NewBuffer),
 		"NewBufferString": reflect.ValueOf(bytes.NewBufferString),
 		"NewReader":       reflect.ValueOf(bytes.NewReader),
 		"Repeat":          reflect.ValueOf(bytes.Repeat),
 		"Replace":         reflect.ValueOf(bytes.Replace),
+		"ReplaceAll":      reflect.ValueOf(bytes.ReplaceAll),
 		"Runes":           reflect.ValueOf(bytes.Runes),
 		"Split":           reflect.ValueOf(bytes.Split),
 		"SplitAfter":      reflect.ValueOf(bytes.SplitAfter),
@@ -46,6 +53,7 @@ func init() {
 		"ToTitleSpecial":  reflect.ValueOf(bytes.ToTitleSpecial),
 		"ToUpper":         reflect.ValueOf(bytes.ToUpper),
 		"ToUpperSpecial":  reflect.ValueOf(bytes.ToUpperSpecial),
+		"ToValidUTF8":     reflect.ValueOf(bytes.ToValidUTF8),
 		"Trim":            reflect.ValueOf(bytes.Trim),
 		"TrimFunc":        reflect.ValueOf(bytes.TrimFunc),
 		"TrimLeft":        reflect.ValueOf(bytes.TrimLeft),
@@ -60,5 +68,92 @@ func init() {
 		"Buffer": reflect.TypeOf(bytes.Buffer{}),
 		"Reader": reflect.TypeOf(bytes.Reader{}),
 	}
-	bytesGo17()
+	env.PackageDocs["bytes"] = map[string]string{
+		"Buffer":             "A Buffer is a variable-sized buffer of bytes with [Buffer.Read] and [Buffer.Write] methods.",
+		"Buffer.Bytes":       "Bytes returns a slice of length b.Len() holding the unread portion of the buffer.",
+		"Buffer.Cap":         "Cap returns the capacity of the buffer's underlying byte slice, that is, the total space allocated for the buffer's data.",
+		"Buffer.Grow":        "Grow grows the buffer's capacity, if necessary, to guarantee space for another n bytes.",
+		"Buffer.Len":         "Len returns the number of bytes of the unread portion of the buffer; b.Len() == len(b.Bytes()).",
+		"Buffer.Next":        "Next returns a slice containing the next n bytes from the buffer, advancing the buffer as if the bytes had been returned by [Buffer.Read].",
+		"Buffer.Read":        "Read reads the next len(p) bytes from the buffer or until the buffer is drained.",
+		"Buffer.ReadByte":    "ReadByte reads and returns the next byte from the buffer.",
+		"Buffer.ReadBytes":   "ReadBytes reads until the first occurrence of delim in the input, returning a slice containing the data up to and including the delimiter.",
+		"Buffer.ReadFrom":    "ReadFrom reads data from r until EOF and appends it to the buffer, growing the buffer as needed.",
+		"Buffer.ReadRune":    "ReadRune reads and returns the next UTF-8-encoded Unicode code point from the buffer.",
+		"Buffer.ReadString":  "ReadString reads until the first occurrence of delim in the input, returning a string containing the data up to and including the delimiter.",
+		"Buffer.Reset":       "Reset resets the buffer to be empty, but it retains the underlying storage for use by future writes.",
+		"Buffer.String":      "String returns the contents of the unread portion of the buffer as a string.",
+		"Buffer.Truncate":    "Truncate discards all but the first n unread bytes from the buffer but continues to use the same allocated storage.",
+		"Buffer.UnreadByte":  "UnreadByte unreads the last byte returned by the most recent successful read operation that read at least one byte.",
+		"Buffer.UnreadRune":  "UnreadRune unreads the last rune returned by [Buffer.ReadRune].",
+		"Buffer.Write":       "Write appends the contents of p to the buffer, growing the buffer as needed.",
+		"Buffer.WriteByte":   "WriteByte appends the byte c to the buffer, growing the buffer as needed.",
+		"Buffer.WriteRune":   "WriteRune appends the UTF-8 encoding of Unicode code point r to the buffer, returning the number of bytes written and a nil error.",
+		"Buffer.WriteString": "WriteString appends the contents of s to the buffer, growing the buffer as needed.",
+		"Buffer.WriteTo":     "WriteTo writes data to w until the buffer is drained or an error occurs.",
+		"Compare":            "Compare returns an integer comparing two byte slices lexicographically.",
+		"Contains":           "Contains reports whether subslice is within b.",
+		"ContainsAny":        "ContainsAny reports whether any of the UTF-8-encoded code points in chars are within b.",
+		"ContainsRune":       "ContainsRune reports whether the rune is contained in the UTF-8-encoded byte slice b.",
+		"Count":              "Count counts the number of non-overlapping instances of sep in s.",
+		"Equal":              "Equal reports whether a and b are the same length and contain the same bytes.",
+		"EqualFold":          "EqualFold reports whether s and t, interpreted as UTF-8 strings, are equal under simple Unicode case-folding, which is a more general form of case-insensitivity.",
+		"ErrTooLarge":        "ErrTooLarge is passed to panic if memory cannot be allocated to store data in a buffer.",
+		"Fields":             "Fields interprets s as a sequence of UTF-8-encoded code points.",
+		"FieldsFunc":         "FieldsFunc interprets s as a sequence of UTF-8-encoded code points.",
+		"HasPrefix":          "HasPrefix reports whether the byte slice s begins with prefix.",
+		"HasSuffix":          "HasSuffix reports whether the byte slice s ends with suffix.",
+		"Index":              "Index returns the index of the first instance of sep in s, or -1 if sep is not present in s.",
+		"IndexAny":           "IndexAny interprets s as a sequence of UTF-8-encoded Unicode code points.",
+		"IndexByte":          "IndexByte returns the index of the first instance of c in b, or -1 if c is not present in b.",
+		"IndexFunc":          "IndexFunc interprets s as a sequence of UTF-8-encoded code points.",
+		"IndexRune":          "IndexRune interprets s as a sequence of UTF-8-encoded code points.",
+		"Join":               "Join concatenates the elements of s to create a new byte slice.",
+		"LastIndex":          "LastIndex returns the index of the last instance of sep in s, or -1 if sep is not present in s.",
+		"LastIndexAny":       "LastIndexAny interprets s as a sequence of UTF-8-encoded Unicode code points.",
+		"LastIndexByte":      "LastIndexByte returns the index of the last instance of c in s, or -1 if c is not present in s.",
+		"LastIndexFunc":      "LastIndexFunc interprets s as a sequence of UTF-8-encoded code points.",
+		"Map":                "Map returns a copy of the byte slice s with all its characters modified according to the mapping function.",
+		"MinRead":            "MinRead is the minimum slice size passed to a [Buffer.Read] call by [Buffer.ReadFrom].",
+		"NewBuffer":          "NewBuffer creates and initializes a new [Buffer] using buf as its initial contents.",
+		"NewBufferString":    "NewBufferString creates and initializes a new [Buffer] using string s as its initial contents.",
+		"NewReader":          "NewReader returns a new [Reader] reading from b.",
+		"Reader":             "A Reader implements the io.Reader, io.ReaderAt, io.WriterTo, io.Seeker, io.ByteScanner, and io.RuneScanner interfaces by reading from a byte slice.",
+		"Reader.Len":         "Len returns the number of bytes of the unread portion of the slice.",
+		"Reader.Read":        "Read implements the io.Reader interface.",
+		"Reader.ReadAt":      "ReadAt implements the io.ReaderAt interface.",
+		"Reader.ReadByte":    "ReadByte implements the io.ByteReader interface.",
+		"Reader.ReadRune":    "ReadRune implements the io.RuneReader interface.",
+		"Reader.Reset":       "Reset resets the [Reader] to be reading from b.",
+		"Reader.Seek":        "Seek implements the io.Seeker interface.",
+		"Reader.Size":        "Size returns the original length of the underlying byte slice.",
+		"Reader.UnreadByte":  "UnreadByte complements [Reader.ReadByte] in implementing the io.ByteScanner interface.",
+		"Reader.UnreadRune":  "UnreadRune complements [Reader.ReadRune] in implementing the io.RuneScanner interface.",
+		"Reader.WriteTo":     "WriteTo implements the io.WriterTo interface.",
+		"Repeat":             "Repeat returns a new byte slice consisting of count copies of b.",
+		"Replace":            "Replace returns a copy of the slice s with the first n non-overlapping instances of old replaced by new.",
+		"ReplaceAll":         "ReplaceAll returns a copy of the slice s with all non-overlapping instances of old replaced by new.",
+		"Runes":              "Runes interprets s as a sequence of UTF-8-encoded code points.",
+		"Split":              "Split slices s into all subslices separated by sep and returns a slice of the subslices between those separators.",
+		"SplitAfter":         "SplitAfter slices s into all subslices after each instance of sep and returns a slice of those subslices.",
+		"SplitAfterN":        "SplitAfterN slices s into subslices after each instance of sep and returns a slice of those subslices.",
+		"SplitN":             "SplitN slices s into subslices separated by sep and returns a slice of the subslices between those separators.",
+		"Title":              "Title treats s as UTF-8-encoded bytes and returns a copy with all Unicode letters that begin words mapped to their title case.",
+		"ToLower":            "ToLower returns a copy of the byte slice s with all Unicode letters mapped to their lower case.",
+		"ToLowerSpecial":     "ToLowerSpecial treats s as UTF-8-encoded bytes and returns a copy with all the Unicode letters mapped to their lower case, giving priority to the special casing rules.",
+		"ToTitle":            "ToTitle treats s as UTF-8-encoded bytes and returns a copy with all the Unicode letters mapped to their title case.",
+		"ToTitleSpecial":     "ToTitleSpecial treats s as UTF-8-encoded bytes and returns a copy with all the Unicode letters mapped to their title case, giving priority to the special casing rules.",
+		"ToUpper":            "ToUpper returns a copy of the byte slice s with all Unicode letters mapped to their upper case.",
+		"ToUpperSpecial":     "ToUpperSpecial treats s as UTF-8-encoded bytes and returns a copy with all the Unicode letters mapped to their upper case, giving priority to the special casing rules.",
+		"ToValidUTF8":        "ToValidUTF8 treats s as UTF-8-encoded bytes and returns a copy with each run of bytes representing invalid UTF-8 replaced with the bytes in replacement, which may be empty.",
+		"Trim":               "Trim returns a subslice of s by slicing off all leading and trailing UTF-8-encoded code points contained in cutset.",
+		"TrimFunc":           "TrimFunc returns a subslice of s by slicing off all leading and trailing UTF-8-encoded code points c that satisfy f(c).",
+		"TrimLeft":           "TrimLeft returns a subslice of s by slicing off all leading UTF-8-encoded code points contained in cutset.",
+		"TrimLeftFunc":       "TrimLeftFunc treats s as UTF-8-encoded bytes and returns a subslice of s by slicing off all leading UTF-8-encoded code points c that satisfy f(c).",
+		"TrimPrefix":         "TrimPrefix returns s without the provided leading prefix string.",
+		"TrimRight":          "TrimRight returns a subslice of s by slicing off all trailing UTF-8-encoded code points that are contained in cutset.",
+		"TrimRightFunc":      "TrimRightFunc returns a subslice of s by slicing off all trailing UTF-8-encoded code points c that satisfy f(c).",
+		"TrimSpace":          "TrimSpace returns a subslice of s by slicing off all leading and trailing white space, as defined by Unicode.",
+		"TrimSuffix":         "TrimSuffix returns s without the provided trailing suffix string.",
+	}
 }
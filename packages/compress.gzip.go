@@ -0,0 +1,53 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
+package packages
+
+import (
+	"compress/gzip"
+	"reflect"
+
+	"github.com/mattn/anko/env"
+)
+
+func init() {
+	env.Packages["compress/gzip"] = map[string]reflect.Value{
+		"BestCompression":    reflect.ValueOf(gzip.BestCompression),
+		"BestSpeed":          reflect.ValueOf(gzip.BestSpeed),
+		"DefaultCompression": reflect.ValueOf(gzip.DefaultCompression),
+		"ErrChecksum":        reflect.ValueOf(gzip.ErrChecksum),
+		"ErrHeader":          reflect.ValueOf(gzip.ErrHeader),
+		"HuffmanOnly":        reflect.ValueOf(gzip.HuffmanOnly),
+		"NewReader":          reflect.ValueOf(gzip.NewReader),
+		"NewWriter":          reflect.ValueOf(gzip.NewWriter),
+		"NewWriterLevel":     reflect.ValueOf(gzip.NewWriterLevel),
+		"NoCompression":      reflect.ValueOf(gzip.NoCompression),
+	}
+	env.PackageTypes["compress/gzip"] = map[string]reflect.Type{
+		"Header": reflect.TypeOf(gzip.Header{}),
+		"Reader": reflect.TypeOf(gzip.Reader{}),
+		"Writer": reflect.TypeOf(gzip.Writer{}),
+	}
+	env.PackageDocs["compress/gzip"] = map[string]string{
+		"BestCompression":    "These constants are copied from the [flate] package, so that code that imports compress/gzip does not also have to import compress/flate.",
+		"BestSpeed":          "These constants are copied from the [flate] package, so that code that imports compress/gzip does not also have to import compress/flate.",
+		"DefaultCompression": "These constants are copied from the [flate] package, so that code that imports compress/gzip does not also have to import compress/flate.",
+		"ErrChecksum":        "ErrChecksum is returned when reading GZIP data that has an invalid checksum.",
+		"ErrHeader":          "ErrHeader is returned when reading GZIP data that has an invalid header.",
+		"Header":             "The gzip file stores a header giving metadata about the compressed file.",
+		"HuffmanOnly":        "These constants are copied from the [flate] package, so that code that imports compress/gzip does not also have to import compress/flate.",
+		"NewReader":          "NewReader creates a new [Reader] reading the given reader.",
+		"NewWriter":          "NewWriter returns a new [Writer].",
+		"NewWriterLevel":     "NewWriterLevel is like [NewWriter] but specifies the compression level instead of assuming [DefaultCompression].",
+		"NoCompression":      "These constants are copied from the [flate] package, so that code that imports compress/gzip does not also have to import compress/flate.",
+		"Reader":             "A Reader is an io.Reader that can be read to retrieve uncompressed data from a gzip-format compressed file.",
+		"Reader.Close":       "Close closes the [Reader].",
+		"Reader.Multistream": "Multistream controls whether the reader supports multistream files.",
+		"Reader.Read":        "Read implements io.Reader, reading uncompressed bytes from its underlying reader.",
+		"Reader.Reset":       "Reset discards the [Reader] z's state and makes it equivalent to the result of its original state from [NewReader], but reading from r instead.",
+		"Writer":             "A Writer is an io.WriteCloser.",
+		"Writer.Close":       "Close closes the [Writer] by flushing any unwritten data to the underlying io.Writer and writing the GZIP footer.",
+		"Writer.Flush":       "Flush flushes any pending compressed data to the underlying writer.",
+		"Writer.Reset":       "Reset discards the [Writer] z's state and makes it equivalent to the result of its original state from [NewWriter] or [NewWriterLevel], but writing to w instead.",
+		"Writer.Write":       "Write writes a compressed form of p to the underlying io.Writer.",
+	}
+}
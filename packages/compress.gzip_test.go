@@ -0,0 +1,31 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
+package packages
+
+import (
+	"testing"
+
+	"github.com/mattn/anko/env"
+	"github.com/mattn/anko/vm"
+)
+
+func TestPackageCompressGzip(t *testing.T) {
+	value, err := vm.Execute(env.NewEnv(), nil, `import("compress/gzip")`)
+	if err != nil {
+		t.Fatal("import error:", err)
+	}
+	imported, ok := value.(*env.Env)
+	if !ok {
+		t.Fatalf("import - received: %T - expected: *env.Env", value)
+	}
+	for name := range env.Packages["compress/gzip"] {
+		if _, err := imported.Get(name); err != nil {
+			t.Errorf("Get %v error: %v", name, err)
+		}
+	}
+	for name := range env.PackageTypes["compress/gzip"] {
+		if _, err := imported.Type(name); err != nil {
+			t.Errorf("Type %v error: %v", name, err)
+		}
+	}
+}
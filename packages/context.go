@@ -0,0 +1,39 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
+package packages
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/mattn/anko/env"
+)
+
+func init() {
+	env.Packages["context"] = map[string]reflect.Value{
+		"Background":       reflect.ValueOf(context.Background),
+		"Canceled":         reflect.ValueOf(context.Canceled),
+		"DeadlineExceeded": reflect.ValueOf(context.DeadlineExceeded),
+		"TODO":             reflect.ValueOf(context.TODO),
+		"WithCancel":       reflect.ValueOf(context.WithCancel),
+		"WithDeadline":     reflect.ValueOf(context.WithDeadline),
+		"WithTimeout":      reflect.ValueOf(context.WithTimeout),
+		"WithValue":        reflect.ValueOf(context.WithValue),
+	}
+	env.PackageTypes["context"] = map[string]reflect.Type{
+		"CancelFunc": reflect.TypeOf((*context.CancelFunc)(nil)).Elem(),
+		"Context":    reflect.TypeOf((*context.Context)(nil)).Elem(),
+	}
+	env.PackageDocs["context"] = map[string]string{
+		"Background":       "Background returns a non-nil, empty [Context].",
+		"CancelFunc":       "A CancelFunc tells an operation to abandon its work.",
+		"Canceled":         "Canceled is the error returned by [Context.Err] when the context is canceled for some reason other than its deadline passing.",
+		"Context":          "A Context carries a deadline, a cancellation signal, and other values across API boundaries.",
+		"DeadlineExceeded": "DeadlineExceeded is the error returned by [Context.Err] when the context is canceled due to its deadline passing.",
+		"TODO":             "TODO returns a non-nil, empty [Context].",
+		"WithCancel":       "WithCancel returns a derived context that points to the parent context but has a new Done channel.",
+		"WithDeadline":     "WithDeadline returns a derived context that points to the parent context but has the deadline adjusted to be no later than d.",
+		"WithTimeout":      "WithTimeout returns WithDeadline(parent, time.Now().Add(timeout)).",
+		"WithValue":        "WithValue returns a derived context that points to the parent Context.",
+	}
+}
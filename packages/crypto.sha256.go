@@ -0,0 +1,31 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
+package packages
+
+import (
+	"crypto/sha256"
+	"reflect"
+
+	"github.com/mattn/anko/env"
+)
+
+func init() {
+	env.Packages["crypto/sha256"] = map[string]reflect.Value{
+		"BlockSize": reflect.ValueOf(sha256.BlockSize),
+		"New":       reflect.ValueOf(sha256.New),
+		"New224":    reflect.ValueOf(sha256.New224),
+		"Size":      reflect.ValueOf(sha256.Size),
+		"Size224":   reflect.ValueOf(sha256.Size224),
+		"Sum224":    reflect.ValueOf(sha256.Sum224),
+		"Sum256":    reflect.ValueOf(sha256.Sum256),
+	}
+	env.PackageDocs["crypto/sha256"] = map[string]string{
+		"BlockSize": "The blocksize of SHA256 and SHA224 in bytes.",
+		"New":       "New returns a new hash.Hash computing the SHA256 checksum.",
+		"New224":    "New224 returns a new hash.Hash computing the SHA224 checksum.",
+		"Size":      "The size of a SHA256 checksum in bytes.",
+		"Size224":   "The size of a SHA224 checksum in bytes.",
+		"Sum224":    "Sum224 returns the SHA224 checksum of the data.",
+		"Sum256":    "Sum256 returns the SHA256 checksum of the data.",
+	}
+}
@@ -0,0 +1,48 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
+package packages
+
+import (
+	"encoding/base64"
+	"reflect"
+
+	"github.com/mattn/anko/env"
+)
+
+func init() {
+	env.Packages["encoding/base64"] = map[string]reflect.Value{
+		"NewDecoder":     reflect.ValueOf(base64.NewDecoder),
+		"NewEncoder":     reflect.ValueOf(base64.NewEncoder),
+		"NewEncoding":    reflect.ValueOf(base64.NewEncoding),
+		"NoPadding":      reflect.ValueOf(base64.NoPadding),
+		"RawStdEncoding": reflect.ValueOf(base64.RawStdEncoding),
+		"RawURLEncoding": reflect.ValueOf(base64.RawURLEncoding),
+		"StdEncoding":    reflect.ValueOf(base64.StdEncoding),
+		"StdPadding":     reflect.ValueOf(base64.StdPadding),
+		"URLEncoding":    reflect.ValueOf(base64.URLEncoding),
+	}
+	env.PackageTypes["encoding/base64"] = map[string]reflect.Type{
+		"CorruptInputError": reflect.TypeOf((*base64.CorruptInputError)(nil)).Elem(),
+		"Encoding":          reflect.TypeOf(base64.Encoding{}),
+	}
+	env.PackageDocs["encoding/base64"] = map[string]string{
+		"Encoding":                "An Encoding is a radix 64 encoding/decoding scheme, defined by a 64-character alphabet.",
+		"Encoding.Decode":         "Decode decodes src using the encoding enc.",
+		"Encoding.DecodeString":   "DecodeString returns the bytes represented by the base64 string s.",
+		"Encoding.DecodedLen":     "DecodedLen returns the maximum length in bytes of the decoded data corresponding to n bytes of base64-encoded data.",
+		"Encoding.Encode":         "Encode encodes src using the encoding enc, writing [Encoding.EncodedLen](len(src)) bytes to dst.",
+		"Encoding.EncodeToString": "EncodeToString returns the base64 encoding of src.",
+		"Encoding.EncodedLen":     "EncodedLen returns the length in bytes of the base64 encoding of an input buffer of length n.",
+		"Encoding.Strict":         "Strict creates a new encoding identical to enc except with strict decoding enabled.",
+		"Encoding.WithPadding":    "WithPadding creates a new encoding identical to enc except with a specified padding character, or [NoPadding] to disable padding.",
+		"NewDecoder":              "NewDecoder constructs a new base64 stream decoder.",
+		"NewEncoder":              "NewEncoder returns a new base64 stream encoder.",
+		"NewEncoding":             "NewEncoding returns a new padded Encoding defined by the given alphabet, which must be a 64-byte string that contains unique byte values and does not contain the padding character or CR / LF ('\\r', '\\n').",
+		"NoPadding":               "No padding",
+		"RawStdEncoding":          "RawStdEncoding is the standard raw, unpadded base64 encoding, as defined in RFC 4648 section 3.2.",
+		"RawURLEncoding":          "RawURLEncoding is the unpadded alternate base64 encoding defined in RFC 4648.",
+		"StdEncoding":             "StdEncoding is the standard base64 encoding, as defined in RFC 4648.",
+		"StdPadding":              "Standard padding character",
+		"URLEncoding":             "URLEncoding is the alternate base64 encoding defined in RFC 4648.",
+	}
+}
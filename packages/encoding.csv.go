@@ -0,0 +1,43 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
+package packages
+
+import (
+	"encoding/csv"
+	"reflect"
+
+	"github.com/mattn/anko/env"
+)
+
+func init() {
+	env.Packages["encoding/csv"] = map[string]reflect.Value{
+		"ErrBareQuote":     reflect.ValueOf(csv.ErrBareQuote),
+		"ErrFieldCount":    reflect.ValueOf(csv.ErrFieldCount),
+		"ErrQuote":         reflect.ValueOf(csv.ErrQuote),
+		"ErrTrailingComma": reflect.ValueOf(csv.ErrTrailingComma),
+		"NewReader":        reflect.ValueOf(csv.NewReader),
+		"NewWriter":        reflect.ValueOf(csv.NewWriter),
+	}
+	env.PackageTypes["encoding/csv"] = map[string]reflect.Type{
+		"ParseError": reflect.TypeOf(csv.ParseError{}),
+		"Reader":     reflect.TypeOf(csv.Reader{}),
+		"Writer":     reflect.TypeOf(csv.Writer{}),
+	}
+	env.PackageDocs["encoding/csv"] = map[string]string{
+		"ErrBareQuote":     "These are the errors that can be returned in [ParseError.Err].",
+		"ErrFieldCount":    "These are the errors that can be returned in [ParseError.Err].",
+		"ErrQuote":         "These are the errors that can be returned in [ParseError.Err].",
+		"ErrTrailingComma": "Deprecated: ErrTrailingComma is no longer used.",
+		"NewReader":        "NewReader returns a new Reader that reads from r.",
+		"NewWriter":        "NewWriter returns a new Writer that writes to w.",
+		"ParseError":       "A ParseError is returned for parsing errors.",
+		"Reader":           "A Reader reads records from a CSV-encoded file.",
+		"Reader.Read":      "Read reads one record (a slice of fields) from r.",
+		"Reader.ReadAll":   "ReadAll reads all the remaining records from r.",
+		"Writer":           "A Writer writes records using CSV encoding.",
+		"Writer.Error":     "Error reports any error that has occurred during a previous [Writer.Write] or [Writer.Flush].",
+		"Writer.Flush":     "Flush writes any buffered data to the underlying io.Writer.",
+		"Writer.Write":     "Write writes a single CSV record to w along with any necessary quoting.",
+		"Writer.WriteAll":  "WriteAll writes multiple CSV records to w using [Writer.Write] and then calls [Writer.Flush], returning any error from the Flush.",
+	}
+}
@@ -0,0 +1,43 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
+package packages
+
+import (
+	"encoding/hex"
+	"reflect"
+
+	"github.com/mattn/anko/env"
+)
+
+func init() {
+	env.Packages["encoding/hex"] = map[string]reflect.Value{
+		"Decode":         reflect.ValueOf(hex.Decode),
+		"DecodeString":   reflect.ValueOf(hex.DecodeString),
+		"DecodedLen":     reflect.ValueOf(hex.DecodedLen),
+		"Dump":           reflect.ValueOf(hex.Dump),
+		"Dumper":         reflect.ValueOf(hex.Dumper),
+		"Encode":         reflect.ValueOf(hex.Encode),
+		"EncodeToString": reflect.ValueOf(hex.EncodeToString),
+		"EncodedLen":     reflect.ValueOf(hex.EncodedLen),
+		"ErrLength":      reflect.ValueOf(hex.ErrLength),
+		"NewDecoder":     reflect.ValueOf(hex.NewDecoder),
+		"NewEncoder":     reflect.ValueOf(hex.NewEncoder),
+	}
+	env.PackageTypes["encoding/hex"] = map[string]reflect.Type{
+		"InvalidByteError": reflect.TypeOf((*hex.InvalidByteError)(nil)).Elem(),
+	}
+	env.PackageDocs["encoding/hex"] = map[string]string{
+		"Decode":           "Decode decodes src into [DecodedLen](len(src)) bytes, returning the actual number of bytes written to dst.",
+		"DecodeString":     "DecodeString returns the bytes represented by the hexadecimal string s.",
+		"DecodedLen":       "DecodedLen returns the length of a decoding of x source bytes.",
+		"Dump":             "Dump returns a string that contains a hex dump of the given data.",
+		"Dumper":           "Dumper returns a io.WriteCloser that writes a hex dump of all written data to w.",
+		"Encode":           "Encode encodes src into [EncodedLen](len(src)) bytes of dst.",
+		"EncodeToString":   "EncodeToString returns the hexadecimal encoding of src.",
+		"EncodedLen":       "EncodedLen returns the length of an encoding of n source bytes.",
+		"ErrLength":        "ErrLength reports an attempt to decode an odd-length input using [Decode] or [DecodeString].",
+		"InvalidByteError": "InvalidByteError values describe errors resulting from an invalid byte in a hex string.",
+		"NewDecoder":       "NewDecoder returns an io.Reader that decodes hexadecimal characters from r.",
+		"NewEncoder":       "NewEncoder returns an io.Writer that writes lowercase hexadecimal characters to w.",
+	}
+}
@@ -1,3 +1,5 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
 package packages
 
 import (
@@ -9,7 +11,73 @@ import (
 
 func init() {
 	env.Packages["encoding/json"] = map[string]reflect.Value{
-		"Marshal":   reflect.ValueOf(json.Marshal),
-		"Unmarshal": reflect.ValueOf(json.Unmarshal),
+		"Compact":       reflect.ValueOf(json.Compact),
+		"HTMLEscape":    reflect.ValueOf(json.HTMLEscape),
+		"Indent":        reflect.ValueOf(json.Indent),
+		"Marshal":       reflect.ValueOf(json.Marshal),
+		"MarshalIndent": reflect.ValueOf(json.MarshalIndent),
+		"NewDecoder":    reflect.ValueOf(json.NewDecoder),
+		"NewEncoder":    reflect.ValueOf(json.NewEncoder),
+		"Unmarshal":     reflect.ValueOf(json.Unmarshal),
+		"Valid":         reflect.ValueOf(json.Valid),
+	}
+	env.PackageTypes["encoding/json"] = map[string]reflect.Type{
+		"Decoder":               reflect.TypeOf(json.Decoder{}),
+		"Delim":                 reflect.TypeOf((*json.Delim)(nil)).Elem(),
+		"Encoder":               reflect.TypeOf(json.Encoder{}),
+		"InvalidUTF8Error":      reflect.TypeOf(json.InvalidUTF8Error{}),
+		"InvalidUnmarshalError": reflect.TypeOf(json.InvalidUnmarshalError{}),
+		"Marshaler":             reflect.TypeOf((*json.Marshaler)(nil)).Elem(),
+		"MarshalerError":        reflect.TypeOf(json.MarshalerError{}),
+		"Number":                reflect.TypeOf((*json.Number)(nil)).Elem(),
+		"RawMessage":            reflect.TypeOf((*json.RawMessage)(nil)).Elem(),
+		"SyntaxError":           reflect.TypeOf(json.SyntaxError{}),
+		"Token":                 reflect.TypeOf((*json.Token)(nil)).Elem(),
+		"UnmarshalFieldError":   reflect.TypeOf(json.UnmarshalFieldError{}),
+		"UnmarshalTypeError":    reflect.TypeOf(json.UnmarshalTypeError{}),
+		"Unmarshaler":           reflect.TypeOf((*json.Unmarshaler)(nil)).Elem(),
+		"UnsupportedTypeError":  reflect.TypeOf(json.UnsupportedTypeError{}),
+		"UnsupportedValueError": reflect.TypeOf(json.UnsupportedValueError{}),
+	}
+	env.PackageDocs["encoding/json"] = map[string]string{
+		"Compact":                       "Compact appends to dst the JSON-encoded src with insignificant space characters elided.",
+		"Decoder":                       "A Decoder reads and decodes JSON values from an input stream.",
+		"Decoder.Buffered":              "Buffered returns a reader of the data remaining in the unread buffer, which may contain zero or more bytes.",
+		"Decoder.Decode":                "Decode reads the next JSON-encoded value from its input and stores it in the value pointed to by v.",
+		"Decoder.DisallowUnknownFields": "DisallowUnknownFields causes the Decoder to return an error when the destination is a struct and the input contains object keys which do not match any non-ignored, exported fields in the destination.",
+		"Decoder.InputOffset":           "InputOffset returns the input stream byte offset of the current decoder position.",
+		"Decoder.More":                  "More reports whether there is another element in the current array or object being parsed.",
+		"Decoder.Token":                 "Token returns the next JSON token in the input stream.",
+		"Decoder.UseNumber":             "UseNumber causes the Decoder to unmarshal a number into an interface value as a [Number] instead of as a float64.",
+		"Delim":                         "A Delim is a JSON array or object delimiter, one of [ ] { or }.",
+		"Encoder":                       "An Encoder writes JSON values to an output stream.",
+		"Encoder.Encode":                "Encode writes the JSON encoding of v to the stream, followed by a newline character.",
+		"Encoder.SetEscapeHTML":         "SetEscapeHTML specifies whether problematic HTML characters should be escaped inside JSON quoted strings.",
+		"Encoder.SetIndent":             "SetIndent instructs the encoder to format each subsequent encoded value as if indented by the package-level function Indent(dst, src, prefix, indent).",
+		"HTMLEscape":                    "HTMLEscape appends to dst the JSON-encoded src with <, >, &, U+2028 and U+2029 characters inside string literals changed to \\u003c, \\u003e, \\u0026, \\u2028, \\u2029 so that the JSON will be safe to embed inside HTML <script> tags.",
+		"Indent":                        "Indent appends to dst an indented form of the JSON-encoded src.",
+		"InvalidUTF8Error":              "Before Go 1.2, an InvalidUTF8Error was returned by [Marshal] when attempting to encode a string value with invalid UTF-8 sequences.",
+		"InvalidUnmarshalError":         "An InvalidUnmarshalError describes an invalid argument passed to [Unmarshal].",
+		"Marshal":                       "Marshal returns the JSON encoding of v.",
+		"MarshalIndent":                 "MarshalIndent is like [Marshal] but applies [Indent] to format the output.",
+		"Marshaler":                     "Marshaler is the interface implemented by types that can marshal themselves into valid JSON.",
+		"MarshalerError":                "A MarshalerError represents an error from calling a [Marshaler.MarshalJSON] or encoding.TextMarshaler.MarshalText method.",
+		"MarshalerError.Unwrap":         "Unwrap returns the underlying error.",
+		"NewDecoder":                    "NewDecoder returns a new decoder that reads from r.",
+		"NewEncoder":                    "NewEncoder returns a new encoder that writes to w.",
+		"Number":                        "A Number represents a JSON number literal.",
+		"Number.Float64":                "Float64 returns the number as a float64.",
+		"Number.Int64":                  "Int64 returns the number as an int64.",
+		"Number.String":                 "String returns the literal text of the number.",
+		"RawMessage":                    "RawMessage is a raw encoded JSON value.",
+		"SyntaxError":                   "A SyntaxError is a description of a JSON syntax error.",
+		"Token":                         "A Token holds a value of one of these types:",
+		"Unmarshal":                     "Unmarshal parses the JSON-encoded data and stores the result in the value pointed to by v.",
+		"UnmarshalFieldError":           "An UnmarshalFieldError describes a JSON object key that led to an unexported (and therefore unwritable) struct field.",
+		"UnmarshalTypeError":            "An UnmarshalTypeError describes a JSON value that was not appropriate for a value of a specific Go type.",
+		"Unmarshaler":                   "Unmarshaler is the interface implemented by types that can unmarshal a JSON description of themselves.",
+		"UnsupportedTypeError":          "An UnsupportedTypeError is returned by [Marshal] when attempting to encode an unsupported value type.",
+		"UnsupportedValueError":         "An UnsupportedValueError is returned by [Marshal] when attempting to encode an unsupported value.",
+		"Valid":                         "Valid reports whether data is a valid JSON encoding.",
 	}
 }
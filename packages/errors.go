@@ -1,3 +1,5 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
 package packages
 
 import (
@@ -9,6 +11,15 @@ import (
 
 func init() {
 	env.Packages["errors"] = map[string]reflect.Value{
-		"New": reflect.ValueOf(errors.New),
+		"As":     reflect.ValueOf(errors.As),
+		"Is":     reflect.ValueOf(errors.Is),
+		"New":    reflect.ValueOf(errors.New),
+		"Unwrap": reflect.ValueOf(errors.Unwrap),
+	}
+	env.PackageDocs["errors"] = map[string]string{
+		"As":     "As finds the first error in err's tree that matches target, and if one is found, sets target to that error value and returns true.",
+		"Is":     "Is reports whether any error in err's tree matches target.",
+		"New":    "New returns an error that formats as the given text.",
+		"Unwrap": "Unwrap returns the result of calling the Unwrap method on err, if err's type contains an Unwrap method returning error.",
 	}
 }
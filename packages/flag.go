@@ -1,3 +1,5 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
 package packages
 
 import (
@@ -21,6 +23,7 @@ func init() {
 		"ExitOnError":     reflect.ValueOf(flag.ExitOnError),
 		"Float64":         reflect.ValueOf(flag.Float64),
 		"Float64Var":      reflect.ValueOf(flag.Float64Var),
+		"Func":            reflect.ValueOf(flag.Func),
 		"Int":             reflect.ValueOf(flag.Int),
 		"Int64":           reflect.ValueOf(flag.Int64),
 		"Int64Var":        reflect.ValueOf(flag.Int64Var),
@@ -40,9 +43,95 @@ func init() {
 		"Uint64":          reflect.ValueOf(flag.Uint64),
 		"Uint64Var":       reflect.ValueOf(flag.Uint64Var),
 		"UintVar":         reflect.ValueOf(flag.UintVar),
+		"UnquoteUsage":    reflect.ValueOf(flag.UnquoteUsage),
 		"Usage":           reflect.ValueOf(flag.Usage),
 		"Var":             reflect.ValueOf(flag.Var),
 		"Visit":           reflect.ValueOf(flag.Visit),
 		"VisitAll":        reflect.ValueOf(flag.VisitAll),
 	}
+	env.PackageTypes["flag"] = map[string]reflect.Type{
+		"ErrorHandling": reflect.TypeOf((*flag.ErrorHandling)(nil)).Elem(),
+		"Flag":          reflect.TypeOf(flag.Flag{}),
+		"FlagSet":       reflect.TypeOf(flag.FlagSet{}),
+		"Getter":        reflect.TypeOf((*flag.Getter)(nil)).Elem(),
+		"Value":         reflect.TypeOf((*flag.Value)(nil)).Elem(),
+	}
+	env.PackageDocs["flag"] = map[string]string{
+		"Arg":                   "Arg returns the i'th command-line argument.",
+		"Args":                  "Args returns the non-flag command-line arguments.",
+		"Bool":                  "Bool defines a bool flag with specified name, default value, and usage string.",
+		"BoolVar":               "BoolVar defines a bool flag with specified name, default value, and usage string.",
+		"CommandLine":           "CommandLine is the default set of command-line flags, parsed from os.Args.",
+		"ContinueOnError":       "These constants cause [FlagSet.Parse] to behave as described if the parse fails.",
+		"Duration":              "Duration defines a time.Duration flag with specified name, default value, and usage string.",
+		"DurationVar":           "DurationVar defines a time.Duration flag with specified name, default value, and usage string.",
+		"ErrHelp":               "ErrHelp is the error returned if the -help or -h flag is invoked but no such flag is defined.",
+		"ErrorHandling":         "ErrorHandling defines how [FlagSet.Parse] behaves if the parse fails.",
+		"ExitOnError":           "These constants cause [FlagSet.Parse] to behave as described if the parse fails.",
+		"Flag":                  "A Flag represents the state of a flag.",
+		"FlagSet":               "A FlagSet represents a set of defined flags.",
+		"FlagSet.Arg":           "Arg returns the i'th argument.",
+		"FlagSet.Args":          "Args returns the non-flag arguments.",
+		"FlagSet.Bool":          "Bool defines a bool flag with specified name, default value, and usage string.",
+		"FlagSet.BoolVar":       "BoolVar defines a bool flag with specified name, default value, and usage string.",
+		"FlagSet.Duration":      "Duration defines a time.Duration flag with specified name, default value, and usage string.",
+		"FlagSet.DurationVar":   "DurationVar defines a time.Duration flag with specified name, default value, and usage string.",
+		"FlagSet.ErrorHandling": "ErrorHandling returns the error handling behavior of the flag set.",
+		"FlagSet.Float64":       "Float64 defines a float64 flag with specified name, default value, and usage string.",
+		"FlagSet.Float64Var":    "Float64Var defines a float64 flag with specified name, default value, and usage string.",
+		"FlagSet.Func":          "Func defines a flag with the specified name and usage string.",
+		"FlagSet.Init":          "Init sets the name and error handling property for a flag set.",
+		"FlagSet.Int":           "Int defines an int flag with specified name, default value, and usage string.",
+		"FlagSet.Int64":         "Int64 defines an int64 flag with specified name, default value, and usage string.",
+		"FlagSet.Int64Var":      "Int64Var defines an int64 flag with specified name, default value, and usage string.",
+		"FlagSet.IntVar":        "IntVar defines an int flag with specified name, default value, and usage string.",
+		"FlagSet.Lookup":        "Lookup returns the [Flag] structure of the named flag, returning nil if none exists.",
+		"FlagSet.NArg":          "NArg is the number of arguments remaining after flags have been processed.",
+		"FlagSet.NFlag":         "NFlag returns the number of flags that have been set.",
+		"FlagSet.Name":          "Name returns the name of the flag set.",
+		"FlagSet.Output":        "Output returns the destination for usage and error messages.",
+		"FlagSet.Parse":         "Parse parses flag definitions from the argument list, which should not include the command name.",
+		"FlagSet.Parsed":        "Parsed reports whether f.Parse has been called.",
+		"FlagSet.PrintDefaults": "PrintDefaults prints, to standard error unless configured otherwise, the default values of all defined command-line flags in the set.",
+		"FlagSet.Set":           "Set sets the value of the named flag.",
+		"FlagSet.SetOutput":     "SetOutput sets the destination for usage and error messages.",
+		"FlagSet.String":        "String defines a string flag with specified name, default value, and usage string.",
+		"FlagSet.StringVar":     "StringVar defines a string flag with specified name, default value, and usage string.",
+		"FlagSet.Uint":          "Uint defines a uint flag with specified name, default value, and usage string.",
+		"FlagSet.Uint64":        "Uint64 defines a uint64 flag with specified name, default value, and usage string.",
+		"FlagSet.Uint64Var":     "Uint64Var defines a uint64 flag with specified name, default value, and usage string.",
+		"FlagSet.UintVar":       "UintVar defines a uint flag with specified name, default value, and usage string.",
+		"FlagSet.Var":           "Var defines a flag with the specified name and usage string.",
+		"FlagSet.Visit":         "Visit visits the flags in lexicographical order, calling fn for each.",
+		"FlagSet.VisitAll":      "VisitAll visits the flags in lexicographical order, calling fn for each.",
+		"Float64":               "Float64 defines a float64 flag with specified name, default value, and usage string.",
+		"Float64Var":            "Float64Var defines a float64 flag with specified name, default value, and usage string.",
+		"Func":                  "Func defines a flag with the specified name and usage string.",
+		"Getter":                "Getter is an interface that allows the contents of a [Value] to be retrieved.",
+		"Int":                   "Int defines an int flag with specified name, default value, and usage string.",
+		"Int64":                 "Int64 defines an int64 flag with specified name, default value, and usage string.",
+		"Int64Var":              "Int64Var defines an int64 flag with specified name, default value, and usage string.",
+		"IntVar":                "IntVar defines an int flag with specified name, default value, and usage string.",
+		"Lookup":                "Lookup returns the [Flag] structure of the named command-line flag, returning nil if none exists.",
+		"NArg":                  "NArg is the number of arguments remaining after flags have been processed.",
+		"NFlag":                 "NFlag returns the number of command-line flags that have been set.",
+		"NewFlagSet":            "NewFlagSet returns a new, empty flag set with the specified name and error handling property.",
+		"PanicOnError":          "These constants cause [FlagSet.Parse] to behave as described if the parse fails.",
+		"Parse":                 "Parse parses the command-line flags from os.Args[1:].",
+		"Parsed":                "Parsed reports whether the command-line flags have been parsed.",
+		"PrintDefaults":         "PrintDefaults prints, to standard error unless configured otherwise, a usage message showing the default settings of all defined command-line flags.",
+		"Set":                   "Set sets the value of the named command-line flag.",
+		"String":                "String defines a string flag with specified name, default value, and usage string.",
+		"StringVar":             "StringVar defines a string flag with specified name, default value, and usage string.",
+		"Uint":                  "Uint defines a uint flag with specified name, default value, and usage string.",
+		"Uint64":                "Uint64 defines a uint64 flag with specified name, default value, and usage string.",
+		"Uint64Var":             "Uint64Var defines a uint64 flag with specified name, default value, and usage string.",
+		"UintVar":               "UintVar defines a uint flag with specified name, default value, and usage string.",
+		"UnquoteUsage":          "UnquoteUsage extracts a back-quoted name from the usage string for a flag and returns it and the un-quoted usage.",
+		"Usage":                 "Usage prints a usage message documenting all defined command-line flags to [CommandLine]'s output, which by default is os.Stderr.",
+		"Value":                 "Value is the interface to the dynamic value stored in a flag.",
+		"Var":                   "Var defines a flag with the specified name and usage string.",
+		"Visit":                 "Visit visits the command-line flags in lexicographical order, calling fn for each.",
+		"VisitAll":              "VisitAll visits the command-line flags in lexicographical order, calling fn for each.",
+	}
 }
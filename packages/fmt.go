@@ -1,3 +1,5 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
 package packages
 
 import (
@@ -29,4 +31,39 @@ func init() {
 		"Sscanf":   reflect.ValueOf(fmt.Sscanf),
 		"Sscanln":  reflect.ValueOf(fmt.Sscanln),
 	}
+	env.PackageTypes["fmt"] = map[string]reflect.Type{
+		"Formatter":  reflect.TypeOf((*fmt.Formatter)(nil)).Elem(),
+		"GoStringer": reflect.TypeOf((*fmt.GoStringer)(nil)).Elem(),
+		"ScanState":  reflect.TypeOf((*fmt.ScanState)(nil)).Elem(),
+		"Scanner":    reflect.TypeOf((*fmt.Scanner)(nil)).Elem(),
+		"State":      reflect.TypeOf((*fmt.State)(nil)).Elem(),
+		"Stringer":   reflect.TypeOf((*fmt.Stringer)(nil)).Elem(),
+	}
+	env.PackageDocs["fmt"] = map[string]string{
+		"Errorf":     "Errorf formats according to a format specifier and returns the string as a value that satisfies error.",
+		"Formatter":  "Formatter is implemented by any value that has a Format method.",
+		"Fprint":     "Fprint formats using the default formats for its operands and writes to w.",
+		"Fprintf":    "Fprintf formats according to a format specifier and writes to w.",
+		"Fprintln":   "Fprintln formats using the default formats for its operands and writes to w.",
+		"Fscan":      "Fscan scans text read from r, storing successive space-separated values into successive arguments.",
+		"Fscanf":     "Fscanf scans text read from r, storing successive space-separated values into successive arguments as determined by the format.",
+		"Fscanln":    "Fscanln is similar to [Fscan], but stops scanning at a newline and after the final item there must be a newline or EOF.",
+		"GoStringer": "GoStringer is implemented by any value that has a GoString method, which defines the Go syntax for that value.",
+		"Print":      "Print formats using the default formats for its operands and writes to standard output.",
+		"Printf":     "Printf formats according to a format specifier and writes to standard output.",
+		"Println":    "Println formats using the default formats for its operands and writes to standard output.",
+		"Scan":       "Scan scans text read from standard input, storing successive space-separated values into successive arguments.",
+		"ScanState":  "ScanState represents the scanner state passed to custom scanners.",
+		"Scanf":      "Scanf scans text read from standard input, storing successive space-separated values into successive arguments as determined by the format.",
+		"Scanln":     "Scanln is similar to [Scan], but stops scanning at a newline and after the final item there must be a newline or EOF.",
+		"Scanner":    "Scanner is implemented by any value that has a Scan method, which scans the input for the representation of a value and stores the result in the receiver, which must be a pointer to be useful.",
+		"Sprint":     "Sprint formats using the default formats for its operands and returns the resulting string.",
+		"Sprintf":    "Sprintf formats according to a format specifier and returns the resulting string.",
+		"Sprintln":   "Sprintln formats using the default formats for its operands and returns the resulting string.",
+		"Sscan":      "Sscan scans the argument string, storing successive space-separated values into successive arguments.",
+		"Sscanf":     "Sscanf scans the argument string, storing successive space-separated values into successive arguments as determined by the format.",
+		"Sscanln":    "Sscanln is similar to [Sscan], but stops scanning at a newline and after the final item there must be a newline or EOF.",
+		"State":      "State represents the printer state passed to custom formatters.",
+		"Stringer":   "Stringer is implemented by any value that has a String method, which defines the “native” format for that value.",
+	}
 }
@@ -0,0 +1,8 @@
+package packages
+
+// The bindings of the packages are generated by anko-package-gen for the Go version of go.mod,
+// sortFuncs.go and osNotAppEngine.go add the ones that are written by hand.
+
+//go:generate go run ../cmd/anko-package-gen -go 1.16 -test -d . bufio bytes compress/gzip context crypto/sha256 encoding/base64 encoding/csv encoding/hex encoding/json errors flag fmt hash/crc32 html io io/ioutil log math math/big math/rand mime net/http/cookiejar os/exec os/signal path path/filepath regexp runtime sort strconv strings sync text/template time unicode
+//go:generate go run ../cmd/anko-package-gen -go 1.16 -test -d . -exclude Getppid os
+//go:generate go run ../cmd/anko-package-gen -go 1.16 -test -d . -build !appengine net net/http net/http/httptest net/url
@@ -0,0 +1,43 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
+package packages
+
+import (
+	"hash/crc32"
+	"reflect"
+
+	"github.com/mattn/anko/env"
+)
+
+func init() {
+	env.Packages["hash/crc32"] = map[string]reflect.Value{
+		"Castagnoli":   reflect.ValueOf(int64(crc32.Castagnoli)),
+		"Checksum":     reflect.ValueOf(crc32.Checksum),
+		"ChecksumIEEE": reflect.ValueOf(crc32.ChecksumIEEE),
+		"IEEE":         reflect.ValueOf(int64(crc32.IEEE)),
+		"IEEETable":    reflect.ValueOf(crc32.IEEETable),
+		"Koopman":      reflect.ValueOf(int64(crc32.Koopman)),
+		"MakeTable":    reflect.ValueOf(crc32.MakeTable),
+		"New":          reflect.ValueOf(crc32.New),
+		"NewIEEE":      reflect.ValueOf(crc32.NewIEEE),
+		"Size":         reflect.ValueOf(crc32.Size),
+		"Update":       reflect.ValueOf(crc32.Update),
+	}
+	env.PackageTypes["hash/crc32"] = map[string]reflect.Type{
+		"Table": reflect.TypeOf((*crc32.Table)(nil)).Elem(),
+	}
+	env.PackageDocs["hash/crc32"] = map[string]string{
+		"Castagnoli":   "Castagnoli's polynomial, used in iSCSI.",
+		"Checksum":     "Checksum returns the CRC-32 checksum of data using the polynomial represented by the [Table].",
+		"ChecksumIEEE": "ChecksumIEEE returns the CRC-32 checksum of data using the [IEEE] polynomial.",
+		"IEEE":         "IEEE is by far and away the most common CRC-32 polynomial.",
+		"IEEETable":    "IEEETable is the table for the [IEEE] polynomial.",
+		"Koopman":      "Koopman's polynomial.",
+		"MakeTable":    "MakeTable returns a [Table] constructed from the specified polynomial.",
+		"New":          "New creates a new hash.Hash32 computing the CRC-32 checksum using the polynomial represented by the [Table].",
+		"NewIEEE":      "NewIEEE creates a new hash.Hash32 computing the CRC-32 checksum using the [IEEE] polynomial.",
+		"Size":         "The size of a CRC-32 checksum in bytes.",
+		"Table":        "Table is a 256-word table representing the polynomial for efficient processing.",
+		"Update":       "Update returns the result of adding the bytes in p to the crc.",
+	}
+}
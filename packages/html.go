@@ -0,0 +1,21 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
+package packages
+
+import (
+	"html"
+	"reflect"
+
+	"github.com/mattn/anko/env"
+)
+
+func init() {
+	env.Packages["html"] = map[string]reflect.Value{
+		"EscapeString":   reflect.ValueOf(html.EscapeString),
+		"UnescapeString": reflect.ValueOf(html.UnescapeString),
+	}
+	env.PackageDocs["html"] = map[string]string{
+		"EscapeString":   "EscapeString escapes special characters like \"<\" to become \"&lt;\".",
+		"UnescapeString": "UnescapeString unescapes entities like \"&lt;\" to become \"<\".",
+	}
+}
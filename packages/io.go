@@ -1,3 +1,5 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
 package packages
 
 import (
@@ -10,7 +12,9 @@ import (
 func init() {
 	env.Packages["io"] = map[string]reflect.Value{
 		"Copy":             reflect.ValueOf(io.Copy),
+		"CopyBuffer":       reflect.ValueOf(io.CopyBuffer),
 		"CopyN":            reflect.ValueOf(io.CopyN),
+		"Discard":          reflect.ValueOf(io.Discard),
 		"EOF":              reflect.ValueOf(io.EOF),
 		"ErrClosedPipe":    reflect.ValueOf(io.ErrClosedPipe),
 		"ErrNoProgress":    reflect.ValueOf(io.ErrNoProgress),
@@ -21,10 +25,102 @@ func init() {
 		"MultiReader":      reflect.ValueOf(io.MultiReader),
 		"MultiWriter":      reflect.ValueOf(io.MultiWriter),
 		"NewSectionReader": reflect.ValueOf(io.NewSectionReader),
+		"NopCloser":        reflect.ValueOf(io.NopCloser),
 		"Pipe":             reflect.ValueOf(io.Pipe),
+		"ReadAll":          reflect.ValueOf(io.ReadAll),
 		"ReadAtLeast":      reflect.ValueOf(io.ReadAtLeast),
 		"ReadFull":         reflect.ValueOf(io.ReadFull),
+		"SeekCurrent":      reflect.ValueOf(io.SeekCurrent),
+		"SeekEnd":          reflect.ValueOf(io.SeekEnd),
+		"SeekStart":        reflect.ValueOf(io.SeekStart),
 		"TeeReader":        reflect.ValueOf(io.TeeReader),
 		"WriteString":      reflect.ValueOf(io.WriteString),
 	}
+	env.PackageTypes["io"] = map[string]reflect.Type{
+		"ByteReader":      reflect.TypeOf((*io.ByteReader)(nil)).Elem(),
+		"ByteScanner":     reflect.TypeOf((*io.ByteScanner)(nil)).Elem(),
+		"ByteWriter":      reflect.TypeOf((*io.ByteWriter)(nil)).Elem(),
+		"Closer":          reflect.TypeOf((*io.Closer)(nil)).Elem(),
+		"LimitedReader":   reflect.TypeOf(io.LimitedReader{}),
+		"PipeReader":      reflect.TypeOf(io.PipeReader{}),
+		"PipeWriter":      reflect.TypeOf(io.PipeWriter{}),
+		"ReadCloser":      reflect.TypeOf((*io.ReadCloser)(nil)).Elem(),
+		"ReadSeekCloser":  reflect.TypeOf((*io.ReadSeekCloser)(nil)).Elem(),
+		"ReadSeeker":      reflect.TypeOf((*io.ReadSeeker)(nil)).Elem(),
+		"ReadWriteCloser": reflect.TypeOf((*io.ReadWriteCloser)(nil)).Elem(),
+		"ReadWriteSeeker": reflect.TypeOf((*io.ReadWriteSeeker)(nil)).Elem(),
+		"ReadWriter":      reflect.TypeOf((*io.ReadWriter)(nil)).Elem(),
+		"Reader":          reflect.TypeOf((*io.Reader)(nil)).Elem(),
+		"ReaderAt":        reflect.TypeOf((*io.ReaderAt)(nil)).Elem(),
+		"ReaderFrom":      reflect.TypeOf((*io.ReaderFrom)(nil)).Elem(),
+		"RuneReader":      reflect.TypeOf((*io.RuneReader)(nil)).Elem(),
+		"RuneScanner":     reflect.TypeOf((*io.RuneScanner)(nil)).Elem(),
+		"SectionReader":   reflect.TypeOf(io.SectionReader{}),
+		"Seeker":          reflect.TypeOf((*io.Seeker)(nil)).Elem(),
+		"StringWriter":    reflect.TypeOf((*io.StringWriter)(nil)).Elem(),
+		"WriteCloser":     reflect.TypeOf((*io.WriteCloser)(nil)).Elem(),
+		"WriteSeeker":     reflect.TypeOf((*io.WriteSeeker)(nil)).Elem(),
+		"Writer":          reflect.TypeOf((*io.Writer)(nil)).Elem(),
+		"WriterAt":        reflect.TypeOf((*io.WriterAt)(nil)).Elem(),
+		"WriterTo":        reflect.TypeOf((*io.WriterTo)(nil)).Elem(),
+	}
+	env.PackageDocs["io"] = map[string]string{
+		"ByteReader":                "ByteReader is the interface that wraps the ReadByte method.",
+		"ByteScanner":               "ByteScanner is the interface that adds the UnreadByte method to the basic ReadByte method.",
+		"ByteWriter":                "ByteWriter is the interface that wraps the WriteByte method.",
+		"Closer":                    "Closer is the interface that wraps the basic Close method.",
+		"Copy":                      "Copy copies from src to dst until either EOF is reached on src or an error occurs.",
+		"CopyBuffer":                "CopyBuffer is identical to Copy except that it stages through the provided buffer (if one is required) rather than allocating a temporary one.",
+		"CopyN":                     "CopyN copies n bytes (or until an error) from src to dst.",
+		"Discard":                   "Discard is a [Writer] on which all Write calls succeed without doing anything.",
+		"EOF":                       "EOF is the error returned by Read when no more input is available.",
+		"ErrClosedPipe":             "ErrClosedPipe is the error used for read or write operations on a closed pipe.",
+		"ErrNoProgress":             "ErrNoProgress is returned by some clients of a [Reader] when many calls to Read have failed to return any data or error, usually the sign of a broken [Reader] implementation.",
+		"ErrShortBuffer":            "ErrShortBuffer means that a read required a longer buffer than was provided.",
+		"ErrShortWrite":             "ErrShortWrite means that a write accepted fewer bytes than requested but failed to return an explicit error.",
+		"ErrUnexpectedEOF":          "ErrUnexpectedEOF means that EOF was encountered in the middle of reading a fixed-size block or data structure.",
+		"LimitReader":               "LimitReader returns a Reader that reads from r but stops with EOF after n bytes.",
+		"LimitedReader":             "A LimitedReader reads from R but limits the amount of data returned to just N bytes.",
+		"MultiReader":               "MultiReader returns a Reader that's the logical concatenation of the provided input readers.",
+		"MultiWriter":               "MultiWriter creates a writer that duplicates its writes to all the provided writers, similar to the Unix tee(1) command.",
+		"NewSectionReader":          "NewSectionReader returns a [SectionReader] that reads from r starting at offset off and stops with EOF after n bytes.",
+		"NopCloser":                 "NopCloser returns a [ReadCloser] with a no-op Close method wrapping the provided [Reader] r.",
+		"Pipe":                      "Pipe creates a synchronous in-memory pipe.",
+		"PipeReader":                "A PipeReader is the read half of a pipe.",
+		"PipeReader.Close":          "Close closes the reader; subsequent writes to the write half of the pipe will return the error [ErrClosedPipe].",
+		"PipeReader.CloseWithError": "CloseWithError closes the reader; subsequent writes to the write half of the pipe will return the error err.",
+		"PipeReader.Read":           "Read implements the standard Read interface: it reads data from the pipe, blocking until a writer arrives or the write end is closed.",
+		"PipeWriter":                "A PipeWriter is the write half of a pipe.",
+		"PipeWriter.Close":          "Close closes the writer; subsequent reads from the read half of the pipe will return no bytes and EOF.",
+		"PipeWriter.CloseWithError": "CloseWithError closes the writer; subsequent reads from the read half of the pipe will return no bytes and the error err, or EOF if err is nil.",
+		"PipeWriter.Write":          "Write implements the standard Write interface: it writes data to the pipe, blocking until one or more readers have consumed all the data or the read end is closed.",
+		"ReadAll":                   "ReadAll reads from r until an error or EOF and returns the data it read.",
+		"ReadAtLeast":               "ReadAtLeast reads from r into buf until it has read at least min bytes.",
+		"ReadCloser":                "ReadCloser is the interface that groups the basic Read and Close methods.",
+		"ReadFull":                  "ReadFull reads exactly len(buf) bytes from r into buf.",
+		"ReadSeekCloser":            "ReadSeekCloser is the interface that groups the basic Read, Seek and Close methods.",
+		"ReadSeeker":                "ReadSeeker is the interface that groups the basic Read and Seek methods.",
+		"ReadWriteCloser":           "ReadWriteCloser is the interface that groups the basic Read, Write and Close methods.",
+		"ReadWriteSeeker":           "ReadWriteSeeker is the interface that groups the basic Read, Write and Seek methods.",
+		"ReadWriter":                "ReadWriter is the interface that groups the basic Read and Write methods.",
+		"Reader":                    "Reader is the interface that wraps the basic Read method.",
+		"ReaderAt":                  "ReaderAt is the interface that wraps the basic ReadAt method.",
+		"ReaderFrom":                "ReaderFrom is the interface that wraps the ReadFrom method.",
+		"RuneReader":                "RuneReader is the interface that wraps the ReadRune method.",
+		"RuneScanner":               "RuneScanner is the interface that adds the UnreadRune method to the basic ReadRune method.",
+		"SectionReader":             "SectionReader implements Read, Seek, and ReadAt on a section of an underlying [ReaderAt].",
+		"SectionReader.Size":        "Size returns the size of the section in bytes.",
+		"SeekCurrent":               "Seek whence values.",
+		"SeekEnd":                   "Seek whence values.",
+		"SeekStart":                 "Seek whence values.",
+		"Seeker":                    "Seeker is the interface that wraps the basic Seek method.",
+		"StringWriter":              "StringWriter is the interface that wraps the WriteString method.",
+		"TeeReader":                 "TeeReader returns a [Reader] that writes to w what it reads from r.",
+		"WriteCloser":               "WriteCloser is the interface that groups the basic Write and Close methods.",
+		"WriteSeeker":               "WriteSeeker is the interface that groups the basic Write and Seek methods.",
+		"WriteString":               "WriteString writes the contents of the string s to w, which accepts a slice of bytes.",
+		"Writer":                    "Writer is the interface that wraps the basic Write method.",
+		"WriterAt":                  "WriterAt is the interface that wraps the basic WriteAt method.",
+		"WriterTo":                  "WriterTo is the interface that wraps the WriteTo method.",
+	}
 }
@@ -1,3 +1,5 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
 package packages
 
 import (
@@ -9,9 +11,23 @@ import (
 
 func init() {
 	env.Packages["io/ioutil"] = map[string]reflect.Value{
+		"Discard":   reflect.ValueOf(ioutil.Discard),
+		"NopCloser": reflect.ValueOf(ioutil.NopCloser),
 		"ReadAll":   reflect.ValueOf(ioutil.ReadAll),
 		"ReadDir":   reflect.ValueOf(ioutil.ReadDir),
 		"ReadFile":  reflect.ValueOf(ioutil.ReadFile),
+		"TempDir":   reflect.ValueOf(ioutil.TempDir),
+		"TempFile":  reflect.ValueOf(ioutil.TempFile),
 		"WriteFile": reflect.ValueOf(ioutil.WriteFile),
 	}
+	env.PackageDocs["io/ioutil"] = map[string]string{
+		"Discard":   "Discard is an io.Writer on which all Write calls succeed without doing anything.",
+		"NopCloser": "NopCloser returns a ReadCloser with a no-op Close method wrapping the provided Reader r.",
+		"ReadAll":   "ReadAll reads from r until an error or EOF and returns the data it read.",
+		"ReadDir":   "ReadDir reads the directory named by dirname and returns a list of fs.FileInfo for the directory's contents, sorted by filename.",
+		"ReadFile":  "ReadFile reads the file named by filename and returns the contents.",
+		"TempDir":   "TempDir creates a new temporary directory in the directory dir.",
+		"TempFile":  "TempFile creates a new temporary file in the directory dir, opens the file for reading and writing, and returns the resulting *os.File.",
+		"WriteFile": "WriteFile writes data to a file named by filename.",
+	}
 }
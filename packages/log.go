@@ -1,3 +1,5 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
 package packages
 
 import (
@@ -9,21 +11,79 @@ import (
 
 func init() {
 	env.Packages["log"] = map[string]reflect.Value{
-		"Fatal":     reflect.ValueOf(log.Fatal),
-		"Fatalf":    reflect.ValueOf(log.Fatalf),
-		"Fatalln":   reflect.ValueOf(log.Fatalln),
-		"Flags":     reflect.ValueOf(log.Flags),
-		"New":       reflect.ValueOf(log.New),
-		"Output":    reflect.ValueOf(log.Output),
-		"Panic":     reflect.ValueOf(log.Panic),
-		"Panicf":    reflect.ValueOf(log.Panicf),
-		"Panicln":   reflect.ValueOf(log.Panicln),
-		"Prefix":    reflect.ValueOf(log.Prefix),
-		"Print":     reflect.ValueOf(log.Print),
-		"Printf":    reflect.ValueOf(log.Printf),
-		"Println":   reflect.ValueOf(log.Println),
-		"SetFlags":  reflect.ValueOf(log.SetFlags),
-		"SetOutput": reflect.ValueOf(log.SetOutput),
-		"SetPrefix": reflect.ValueOf(log.SetPrefix),
+		"Default":       reflect.ValueOf(log.Default),
+		"Fatal":         reflect.ValueOf(log.Fatal),
+		"Fatalf":        reflect.ValueOf(log.Fatalf),
+		"Fatalln":       reflect.ValueOf(log.Fatalln),
+		"Flags":         reflect.ValueOf(log.Flags),
+		"LUTC":          reflect.ValueOf(log.LUTC),
+		"Ldate":         reflect.ValueOf(log.Ldate),
+		"Llongfile":     reflect.ValueOf(log.Llongfile),
+		"Lmicroseconds": reflect.ValueOf(log.Lmicroseconds),
+		"Lmsgprefix":    reflect.ValueOf(log.Lmsgprefix),
+		"Lshortfile":    reflect.ValueOf(log.Lshortfile),
+		"LstdFlags":     reflect.ValueOf(log.LstdFlags),
+		"Ltime":         reflect.ValueOf(log.Ltime),
+		"New":           reflect.ValueOf(log.New),
+		"Output":        reflect.ValueOf(log.Output),
+		"Panic":         reflect.ValueOf(log.Panic),
+		"Panicf":        reflect.ValueOf(log.Panicf),
+		"Panicln":       reflect.ValueOf(log.Panicln),
+		"Prefix":        reflect.ValueOf(log.Prefix),
+		"Print":         reflect.ValueOf(log.Print),
+		"Printf":        reflect.ValueOf(log.Printf),
+		"Println":       reflect.ValueOf(log.Println),
+		"SetFlags":      reflect.ValueOf(log.SetFlags),
+		"SetOutput":     reflect.ValueOf(log.SetOutput),
+		"SetPrefix":     reflect.ValueOf(log.SetPrefix),
+		"Writer":        reflect.ValueOf(log.Writer),
+	}
+	env.PackageTypes["log"] = map[string]reflect.Type{
+		"Logger": reflect.TypeOf(log.Logger{}),
+	}
+	env.PackageDocs["log"] = map[string]string{
+		"Default":          "Default returns the standard logger used by the package-level output functions.",
+		"Fatal":            "Fatal is equivalent to [Print] followed by a call to os.Exit(1).",
+		"Fatalf":           "Fatalf is equivalent to [Printf] followed by a call to os.Exit(1).",
+		"Fatalln":          "Fatalln is equivalent to [Println] followed by a call to os.Exit(1).",
+		"Flags":            "Flags returns the output flags for the standard logger.",
+		"LUTC":             "These flags define which text to prefix to each log entry generated by the [Logger].",
+		"Ldate":            "These flags define which text to prefix to each log entry generated by the [Logger].",
+		"Llongfile":        "These flags define which text to prefix to each log entry generated by the [Logger].",
+		"Lmicroseconds":    "These flags define which text to prefix to each log entry generated by the [Logger].",
+		"Lmsgprefix":       "These flags define which text to prefix to each log entry generated by the [Logger].",
+		"Logger":           "A Logger represents an active logging object that generates lines of output to an io.Writer.",
+		"Logger.Fatal":     "Fatal is equivalent to l.Print() followed by a call to os.Exit(1).",
+		"Logger.Fatalf":    "Fatalf is equivalent to l.Printf() followed by a call to os.Exit(1).",
+		"Logger.Fatalln":   "Fatalln is equivalent to l.Println() followed by a call to os.Exit(1).",
+		"Logger.Flags":     "Flags returns the output flags for the logger.",
+		"Logger.Output":    "Output writes the output for a logging event.",
+		"Logger.Panic":     "Panic is equivalent to l.Print() followed by a call to panic().",
+		"Logger.Panicf":    "Panicf is equivalent to l.Printf() followed by a call to panic().",
+		"Logger.Panicln":   "Panicln is equivalent to l.Println() followed by a call to panic().",
+		"Logger.Prefix":    "Prefix returns the output prefix for the logger.",
+		"Logger.Print":     "Print calls l.Output to print to the logger.",
+		"Logger.Printf":    "Printf calls l.Output to print to the logger.",
+		"Logger.Println":   "Println calls l.Output to print to the logger.",
+		"Logger.SetFlags":  "SetFlags sets the output flags for the logger.",
+		"Logger.SetOutput": "SetOutput sets the output destination for the logger.",
+		"Logger.SetPrefix": "SetPrefix sets the output prefix for the logger.",
+		"Logger.Writer":    "Writer returns the output destination for the logger.",
+		"Lshortfile":       "These flags define which text to prefix to each log entry generated by the [Logger].",
+		"LstdFlags":        "These flags define which text to prefix to each log entry generated by the [Logger].",
+		"Ltime":            "These flags define which text to prefix to each log entry generated by the [Logger].",
+		"New":              "New creates a new [Logger].",
+		"Output":           "Output writes the output for a logging event.",
+		"Panic":            "Panic is equivalent to [Print] followed by a call to panic().",
+		"Panicf":           "Panicf is equivalent to [Printf] followed by a call to panic().",
+		"Panicln":          "Panicln is equivalent to [Println] followed by a call to panic().",
+		"Prefix":           "Prefix returns the output prefix for the standard logger.",
+		"Print":            "Print calls Output to print to the standard logger.",
+		"Printf":           "Printf calls Output to print to the standard logger.",
+		"Println":          "Println calls Output to print to the standard logger.",
+		"SetFlags":         "SetFlags sets the output flags for the standard logger.",
+		"SetOutput":        "SetOutput sets the output destination for the standard logger.",
+		"SetPrefix":        "SetPrefix sets the output prefix for the standard logger.",
+		"Writer":           "Writer returns the output destination for the standard logger.",
 	}
 }
@@ -1,3 +1,5 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
 package packages
 
 import (
@@ -9,15 +11,14 @@ import (
 
 func init() {
 	env.Packages["math/big"] = map[string]reflect.Value{
-		"Above":        reflect.ValueOf(big.Above),
-		"AwayFromZero": reflect.ValueOf(big.AwayFromZero),
-		"Below":        reflect.ValueOf(big.Below),
-		"Exact":        reflect.ValueOf(big.Exact),
-		"Jacobi":       reflect.ValueOf(big.Jacobi),
-		"MaxBase":      reflect.ValueOf(big.MaxBase),
-		"MaxExp":       reflect.ValueOf(big.MaxExp),
-		// TODO: https://github.com/mattn/anko/issues/49
-		// "MaxPrec":       reflect.ValueOf(big.MaxPrec),
+		"Above":         reflect.ValueOf(big.Above),
+		"AwayFromZero":  reflect.ValueOf(big.AwayFromZero),
+		"Below":         reflect.ValueOf(big.Below),
+		"Exact":         reflect.ValueOf(big.Exact),
+		"Jacobi":        reflect.ValueOf(big.Jacobi),
+		"MaxBase":       reflect.ValueOf(big.MaxBase),
+		"MaxExp":        reflect.ValueOf(big.MaxExp),
+		"MaxPrec":       reflect.ValueOf(int64(big.MaxPrec)),
 		"MinExp":        reflect.ValueOf(big.MinExp),
 		"NewFloat":      reflect.ValueOf(big.NewFloat),
 		"NewInt":        reflect.ValueOf(big.NewInt),
@@ -29,4 +30,173 @@ func init() {
 		"ToPositiveInf": reflect.ValueOf(big.ToPositiveInf),
 		"ToZero":        reflect.ValueOf(big.ToZero),
 	}
+	env.PackageTypes["math/big"] = map[string]reflect.Type{
+		"Accuracy":     reflect.TypeOf((*big.Accuracy)(nil)).Elem(),
+		"ErrNaN":       reflect.TypeOf(big.ErrNaN{}),
+		"Float":        reflect.TypeOf(big.Float{}),
+		"Int":          reflect.TypeOf(big.Int{}),
+		"Rat":          reflect.TypeOf(big.Rat{}),
+		"RoundingMode": reflect.TypeOf((*big.RoundingMode)(nil)).Elem(),
+		"Word":         reflect.TypeOf((*big.Word)(nil)).Elem(),
+	}
+	env.PackageDocs["math/big"] = map[string]string{
+		"Above":                "Constants describing the [Accuracy] of a [Float].",
+		"Accuracy":             "Accuracy describes the rounding error produced by the most recent operation that generated a [Float] value, relative to the exact value.",
+		"AwayFromZero":         "These constants define supported rounding modes.",
+		"Below":                "Constants describing the [Accuracy] of a [Float].",
+		"ErrNaN":               "An ErrNaN panic is raised by a [Float] operation that would lead to a NaN under IEEE 754 rules.",
+		"Exact":                "Constants describing the [Accuracy] of a [Float].",
+		"Float":                "A nonzero finite Float represents a multi-precision floating point number",
+		"Float.Abs":            "Abs sets z to the (possibly rounded) value |x| (the absolute value of x) and returns z.",
+		"Float.Acc":            "Acc returns the accuracy of x produced by the most recent operation, unless explicitly documented otherwise by that operation.",
+		"Float.Add":            "Add sets z to the rounded sum x+y and returns z.",
+		"Float.Append":         "Append appends to buf the string form of the floating-point number x, as generated by x.Text, and returns the extended buffer.",
+		"Float.Cmp":            "Cmp compares x and y and returns:",
+		"Float.Copy":           "Copy sets z to x, with the same precision, rounding mode, and accuracy as x.",
+		"Float.Float32":        "Float32 returns the float32 value nearest to x.",
+		"Float.Float64":        "Float64 returns the float64 value nearest to x.",
+		"Float.Format":         "Format implements fmt.Formatter.",
+		"Float.GobDecode":      "GobDecode implements the encoding/gob.GobDecoder interface.",
+		"Float.GobEncode":      "GobEncode implements the encoding/gob.GobEncoder interface.",
+		"Float.Int":            "Int returns the result of truncating x towards zero; or nil if x is an infinity.",
+		"Float.Int64":          "Int64 returns the integer resulting from truncating x towards zero.",
+		"Float.IsInf":          "IsInf reports whether x is +Inf or -Inf.",
+		"Float.IsInt":          "IsInt reports whether x is an integer.",
+		"Float.MantExp":        "MantExp breaks x into its mantissa and exponent components and returns the exponent.",
+		"Float.MarshalText":    "MarshalText implements the encoding.TextMarshaler interface.",
+		"Float.MinPrec":        "MinPrec returns the minimum precision required to represent x exactly (i.e., the smallest prec before x.SetPrec(prec) would start rounding x).",
+		"Float.Mode":           "Mode returns the rounding mode of x.",
+		"Float.Mul":            "Mul sets z to the rounded product x*y and returns z.",
+		"Float.Neg":            "Neg sets z to the (possibly rounded) value of x with its sign negated, and returns z.",
+		"Float.Parse":          "Parse parses s which must contain a text representation of a floating- point number with a mantissa in the given conversion base (the exponent is always a decimal number), or a string representing an infinite value.",
+		"Float.Prec":           "Prec returns the mantissa precision of x in bits.",
+		"Float.Quo":            "Quo sets z to the rounded quotient x/y and returns z.",
+		"Float.Rat":            "Rat returns the rational number corresponding to x; or nil if x is an infinity.",
+		"Float.Scan":           "Scan is a support routine for fmt.Scanner; it sets z to the value of the scanned number.",
+		"Float.Set":            "Set sets z to the (possibly rounded) value of x and returns z.",
+		"Float.SetFloat64":     "SetFloat64 sets z to the (possibly rounded) value of x and returns z.",
+		"Float.SetInf":         "SetInf sets z to the infinite Float -Inf if signbit is set, or +Inf if signbit is not set, and returns z.",
+		"Float.SetInt":         "SetInt sets z to the (possibly rounded) value of x and returns z.",
+		"Float.SetInt64":       "SetInt64 sets z to the (possibly rounded) value of x and returns z.",
+		"Float.SetMantExp":     "SetMantExp sets z to mant × 2**exp and returns z.",
+		"Float.SetMode":        "SetMode sets z's rounding mode to mode and returns an exact z.",
+		"Float.SetPrec":        "SetPrec sets z's precision to prec and returns the (possibly) rounded value of z.",
+		"Float.SetRat":         "SetRat sets z to the (possibly rounded) value of x and returns z.",
+		"Float.SetString":      "SetString sets z to the value of s and returns z and a boolean indicating success.",
+		"Float.SetUint64":      "SetUint64 sets z to the (possibly rounded) value of x and returns z.",
+		"Float.Sign":           "Sign returns:",
+		"Float.Signbit":        "Signbit reports whether x is negative or negative zero.",
+		"Float.Sqrt":           "Sqrt sets z to the rounded square root of x, and returns it.",
+		"Float.String":         "String formats x like x.Text('g', 10).",
+		"Float.Sub":            "Sub sets z to the rounded difference x-y and returns z.",
+		"Float.Text":           "Text converts the floating-point number x to a string according to the given format and precision prec.",
+		"Float.Uint64":         "Uint64 returns the unsigned integer resulting from truncating x towards zero.",
+		"Float.UnmarshalText":  "UnmarshalText implements the encoding.TextUnmarshaler interface.",
+		"Int":                  "An Int represents a signed multi-precision integer.",
+		"Int.Abs":              "Abs sets z to |x| (the absolute value of x) and returns z.",
+		"Int.Add":              "Add sets z to the sum x+y and returns z.",
+		"Int.And":              "And sets z = x & y and returns z.",
+		"Int.AndNot":           "AndNot sets z = x &^ y and returns z.",
+		"Int.Append":           "Append appends the string representation of x, as generated by x.Text(base), to buf and returns the extended buffer.",
+		"Int.Binomial":         "Binomial sets z to the binomial coefficient C(n, k) and returns z.",
+		"Int.Bit":              "Bit returns the value of the i'th bit of x.",
+		"Int.BitLen":           "BitLen returns the length of the absolute value of x in bits.",
+		"Int.Bits":             "Bits provides raw (unchecked but fast) access to x by returning its absolute value as a little-endian [Word] slice.",
+		"Int.Bytes":            "Bytes returns the absolute value of x as a big-endian byte slice.",
+		"Int.Cmp":              "Cmp compares x and y and returns:",
+		"Int.CmpAbs":           "CmpAbs compares the absolute values of x and y and returns:",
+		"Int.Div":              "Div sets z to the quotient x/y for y != 0 and returns z.",
+		"Int.DivMod":           "DivMod sets z to the quotient x div y and m to the modulus x mod y and returns the pair (z, m) for y != 0.",
+		"Int.Exp":              "Exp sets z = x**y mod |m| (i.e.",
+		"Int.FillBytes":        "FillBytes sets buf to the absolute value of x, storing it as a zero-extended big-endian byte slice, and returns buf.",
+		"Int.Format":           "Format implements fmt.Formatter.",
+		"Int.GCD":              "GCD sets z to the greatest common divisor of a and b and returns z.",
+		"Int.GobDecode":        "GobDecode implements the encoding/gob.GobDecoder interface.",
+		"Int.GobEncode":        "GobEncode implements the encoding/gob.GobEncoder interface.",
+		"Int.Int64":            "Int64 returns the int64 representation of x.",
+		"Int.IsInt64":          "IsInt64 reports whether x can be represented as an int64.",
+		"Int.IsUint64":         "IsUint64 reports whether x can be represented as a uint64.",
+		"Int.Lsh":              "Lsh sets z = x << n and returns z.",
+		"Int.MarshalJSON":      "MarshalJSON implements the encoding/json.Marshaler interface.",
+		"Int.MarshalText":      "MarshalText implements the encoding.TextMarshaler interface.",
+		"Int.Mod":              "Mod sets z to the modulus x%y for y != 0 and returns z.",
+		"Int.ModInverse":       "ModInverse sets z to the multiplicative inverse of g in the ring ℤ/nℤ and returns z.",
+		"Int.ModSqrt":          "ModSqrt sets z to a square root of x mod p if such a square root exists, and returns z.",
+		"Int.Mul":              "Mul sets z to the product x*y and returns z.",
+		"Int.MulRange":         "MulRange sets z to the product of all integers in the range [a, b] inclusively and returns z.",
+		"Int.Neg":              "Neg sets z to -x and returns z.",
+		"Int.Not":              "Not sets z = ^x and returns z.",
+		"Int.Or":               "Or sets z = x | y and returns z.",
+		"Int.ProbablyPrime":    "ProbablyPrime reports whether x is probably prime, applying the Miller-Rabin test with n pseudorandomly chosen bases as well as a Baillie-PSW test.",
+		"Int.Quo":              "Quo sets z to the quotient x/y for y != 0 and returns z.",
+		"Int.QuoRem":           "QuoRem sets z to the quotient x/y and r to the remainder x%y and returns the pair (z, r) for y != 0.",
+		"Int.Rand":             "Rand sets z to a pseudo-random number in [0, n) and returns z.",
+		"Int.Rem":              "Rem sets z to the remainder x%y for y != 0 and returns z.",
+		"Int.Rsh":              "Rsh sets z = x >> n and returns z.",
+		"Int.Scan":             "Scan is a support routine for fmt.Scanner; it sets z to the value of the scanned number.",
+		"Int.Set":              "Set sets z to x and returns z.",
+		"Int.SetBit":           "SetBit sets z to x, with x's i'th bit set to b (0 or 1).",
+		"Int.SetBits":          "SetBits provides raw (unchecked but fast) access to z by setting its value to abs, interpreted as a little-endian [Word] slice, and returning z.",
+		"Int.SetBytes":         "SetBytes interprets buf as the bytes of a big-endian unsigned integer, sets z to that value, and returns z.",
+		"Int.SetInt64":         "SetInt64 sets z to x and returns z.",
+		"Int.SetString":        "SetString sets z to the value of s, interpreted in the given base, and returns z and a boolean indicating success.",
+		"Int.SetUint64":        "SetUint64 sets z to x and returns z.",
+		"Int.Sign":             "Sign returns:",
+		"Int.Sqrt":             "Sqrt sets z to ⌊√x⌋, the largest integer such that z² ≤ x, and returns z.",
+		"Int.String":           "String returns the decimal representation of x as generated by x.Text(10).",
+		"Int.Sub":              "Sub sets z to the difference x-y and returns z.",
+		"Int.Text":             "Text returns the string representation of x in the given base.",
+		"Int.TrailingZeroBits": "TrailingZeroBits returns the number of consecutive least significant zero bits of |x|.",
+		"Int.Uint64":           "Uint64 returns the uint64 representation of x.",
+		"Int.UnmarshalJSON":    "UnmarshalJSON implements the encoding/json.Unmarshaler interface.",
+		"Int.UnmarshalText":    "UnmarshalText implements the encoding.TextUnmarshaler interface.",
+		"Int.Xor":              "Xor sets z = x ^ y and returns z.",
+		"Jacobi":               "Jacobi returns the Jacobi symbol (x/y), either +1, -1, or 0.",
+		"MaxBase":              "MaxBase is the largest number base accepted for string conversions.",
+		"MaxExp":               "Exponent and precision limits.",
+		"MaxPrec":              "Exponent and precision limits.",
+		"MinExp":               "Exponent and precision limits.",
+		"NewFloat":             "NewFloat allocates and returns a new [Float] set to x, with precision 53 and rounding mode [ToNearestEven].",
+		"NewInt":               "NewInt allocates and returns a new [Int] set to x.",
+		"NewRat":               "NewRat creates a new [Rat] with numerator a and denominator b.",
+		"ParseFloat":           "ParseFloat is like f.Parse(s, base) with f set to the given precision and rounding mode.",
+		"Rat":                  "A Rat represents a quotient a/b of arbitrary precision.",
+		"Rat.Abs":              "Abs sets z to |x| (the absolute value of x) and returns z.",
+		"Rat.Add":              "Add sets z to the sum x+y and returns z.",
+		"Rat.Cmp":              "Cmp compares x and y and returns:",
+		"Rat.Denom":            "Denom returns the denominator of x; it is always > 0.",
+		"Rat.Float32":          "Float32 returns the nearest float32 value for x and a bool indicating whether f represents x exactly.",
+		"Rat.Float64":          "Float64 returns the nearest float64 value for x and a bool indicating whether f represents x exactly.",
+		"Rat.FloatString":      "FloatString returns a string representation of x in decimal form with prec digits of precision after the radix point.",
+		"Rat.GobDecode":        "GobDecode implements the encoding/gob.GobDecoder interface.",
+		"Rat.GobEncode":        "GobEncode implements the encoding/gob.GobEncoder interface.",
+		"Rat.Inv":              "Inv sets z to 1/x and returns z.",
+		"Rat.IsInt":            "IsInt reports whether the denominator of x is 1.",
+		"Rat.MarshalText":      "MarshalText implements the encoding.TextMarshaler interface.",
+		"Rat.Mul":              "Mul sets z to the product x*y and returns z.",
+		"Rat.Neg":              "Neg sets z to -x and returns z.",
+		"Rat.Num":              "Num returns the numerator of x; it may be <= 0.",
+		"Rat.Quo":              "Quo sets z to the quotient x/y and returns z.",
+		"Rat.RatString":        "RatString returns a string representation of x in the form \"a/b\" if b != 1, and in the form \"a\" if b == 1.",
+		"Rat.Scan":             "Scan is a support routine for fmt.Scanner.",
+		"Rat.Set":              "Set sets z to x (by making a copy of x) and returns z.",
+		"Rat.SetFloat64":       "SetFloat64 sets z to exactly f and returns z.",
+		"Rat.SetFrac":          "SetFrac sets z to a/b and returns z.",
+		"Rat.SetFrac64":        "SetFrac64 sets z to a/b and returns z.",
+		"Rat.SetInt":           "SetInt sets z to x (by making a copy of x) and returns z.",
+		"Rat.SetInt64":         "SetInt64 sets z to x and returns z.",
+		"Rat.SetString":        "SetString sets z to the value of s and returns z and a boolean indicating success.",
+		"Rat.SetUint64":        "SetUint64 sets z to x and returns z.",
+		"Rat.Sign":             "Sign returns:",
+		"Rat.String":           "String returns a string representation of x in the form \"a/b\" (even if b == 1).",
+		"Rat.Sub":              "Sub sets z to the difference x-y and returns z.",
+		"Rat.UnmarshalText":    "UnmarshalText implements the encoding.TextUnmarshaler interface.",
+		"RoundingMode":         "RoundingMode determines how a [Float] value is rounded to the desired precision.",
+		"ToNearestAway":        "These constants define supported rounding modes.",
+		"ToNearestEven":        "These constants define supported rounding modes.",
+		"ToNegativeInf":        "These constants define supported rounding modes.",
+		"ToPositiveInf":        "These constants define supported rounding modes.",
+		"ToZero":               "These constants define supported rounding modes.",
+		"Word":                 "A Word represents a single digit of a multi-precision unsigned integer.",
+	}
 }
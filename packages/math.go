@@ -1,3 +1,5 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
 package packages
 
 import (
@@ -9,66 +11,195 @@ import (
 
 func init() {
 	env.Packages["math"] = map[string]reflect.Value{
-		"Abs":             reflect.ValueOf(math.Abs),
-		"Acos":            reflect.ValueOf(math.Acos),
-		"Acosh":           reflect.ValueOf(math.Acosh),
-		"Asin":            reflect.ValueOf(math.Asin),
-		"Asinh":           reflect.ValueOf(math.Asinh),
-		"Atan":            reflect.ValueOf(math.Atan),
-		"Atan2":           reflect.ValueOf(math.Atan2),
-		"Atanh":           reflect.ValueOf(math.Atanh),
-		"Cbrt":            reflect.ValueOf(math.Cbrt),
-		"Ceil":            reflect.ValueOf(math.Ceil),
-		"Copysign":        reflect.ValueOf(math.Copysign),
-		"Cos":             reflect.ValueOf(math.Cos),
-		"Cosh":            reflect.ValueOf(math.Cosh),
-		"Dim":             reflect.ValueOf(math.Dim),
-		"Erf":             reflect.ValueOf(math.Erf),
-		"Erfc":            reflect.ValueOf(math.Erfc),
-		"Exp":             reflect.ValueOf(math.Exp),
-		"Exp2":            reflect.ValueOf(math.Exp2),
-		"Expm1":           reflect.ValueOf(math.Expm1),
-		"Float32bits":     reflect.ValueOf(math.Float32bits),
-		"Float32frombits": reflect.ValueOf(math.Float32frombits),
-		"Float64bits":     reflect.ValueOf(math.Float64bits),
-		"Float64frombits": reflect.ValueOf(math.Float64frombits),
-		"Floor":           reflect.ValueOf(math.Floor),
-		"Frexp":           reflect.ValueOf(math.Frexp),
-		"Gamma":           reflect.ValueOf(math.Gamma),
-		"Hypot":           reflect.ValueOf(math.Hypot),
-		"Ilogb":           reflect.ValueOf(math.Ilogb),
-		"Inf":             reflect.ValueOf(math.Inf),
-		"IsInf":           reflect.ValueOf(math.IsInf),
-		"IsNaN":           reflect.ValueOf(math.IsNaN),
-		"J0":              reflect.ValueOf(math.J0),
-		"J1":              reflect.ValueOf(math.J1),
-		"Jn":              reflect.ValueOf(math.Jn),
-		"Ldexp":           reflect.ValueOf(math.Ldexp),
-		"Lgamma":          reflect.ValueOf(math.Lgamma),
-		"Log":             reflect.ValueOf(math.Log),
-		"Log10":           reflect.ValueOf(math.Log10),
-		"Log1p":           reflect.ValueOf(math.Log1p),
-		"Log2":            reflect.ValueOf(math.Log2),
-		"Logb":            reflect.ValueOf(math.Logb),
-		"Max":             reflect.ValueOf(math.Max),
-		"Min":             reflect.ValueOf(math.Min),
-		"Mod":             reflect.ValueOf(math.Mod),
-		"Modf":            reflect.ValueOf(math.Modf),
-		"NaN":             reflect.ValueOf(math.NaN),
-		"Nextafter":       reflect.ValueOf(math.Nextafter),
-		"Pow":             reflect.ValueOf(math.Pow),
-		"Pow10":           reflect.ValueOf(math.Pow10),
-		"Remainder":       reflect.ValueOf(math.Remainder),
-		"Signbit":         reflect.ValueOf(math.Signbit),
-		"Sin":             reflect.ValueOf(math.Sin),
-		"Sincos":          reflect.ValueOf(math.Sincos),
-		"Sinh":            reflect.ValueOf(math.Sinh),
-		"Sqrt":            reflect.ValueOf(math.Sqrt),
-		"Tan":             reflect.ValueOf(math.Tan),
-		"Tanh":            reflect.ValueOf(math.Tanh),
-		"Trunc":           reflect.ValueOf(math.Trunc),
-		"Y0":              reflect.ValueOf(math.Y0),
-		"Y1":              reflect.ValueOf(math.Y1),
-		"Yn":              reflect.ValueOf(math.Yn),
+		"Abs":                    reflect.ValueOf(math.Abs),
+		"Acos":                   reflect.ValueOf(math.Acos),
+		"Acosh":                  reflect.ValueOf(math.Acosh),
+		"Asin":                   reflect.ValueOf(math.Asin),
+		"Asinh":                  reflect.ValueOf(math.Asinh),
+		"Atan":                   reflect.ValueOf(math.Atan),
+		"Atan2":                  reflect.ValueOf(math.Atan2),
+		"Atanh":                  reflect.ValueOf(math.Atanh),
+		"Cbrt":                   reflect.ValueOf(math.Cbrt),
+		"Ceil":                   reflect.ValueOf(math.Ceil),
+		"Copysign":               reflect.ValueOf(math.Copysign),
+		"Cos":                    reflect.ValueOf(math.Cos),
+		"Cosh":                   reflect.ValueOf(math.Cosh),
+		"Dim":                    reflect.ValueOf(math.Dim),
+		"E":                      reflect.ValueOf(math.E),
+		"Erf":                    reflect.ValueOf(math.Erf),
+		"Erfc":                   reflect.ValueOf(math.Erfc),
+		"Erfcinv":                reflect.ValueOf(math.Erfcinv),
+		"Erfinv":                 reflect.ValueOf(math.Erfinv),
+		"Exp":                    reflect.ValueOf(math.Exp),
+		"Exp2":                   reflect.ValueOf(math.Exp2),
+		"Expm1":                  reflect.ValueOf(math.Expm1),
+		"FMA":                    reflect.ValueOf(math.FMA),
+		"Float32bits":            reflect.ValueOf(math.Float32bits),
+		"Float32frombits":        reflect.ValueOf(math.Float32frombits),
+		"Float64bits":            reflect.ValueOf(math.Float64bits),
+		"Float64frombits":        reflect.ValueOf(math.Float64frombits),
+		"Floor":                  reflect.ValueOf(math.Floor),
+		"Frexp":                  reflect.ValueOf(math.Frexp),
+		"Gamma":                  reflect.ValueOf(math.Gamma),
+		"Hypot":                  reflect.ValueOf(math.Hypot),
+		"Ilogb":                  reflect.ValueOf(math.Ilogb),
+		"Inf":                    reflect.ValueOf(math.Inf),
+		"IsInf":                  reflect.ValueOf(math.IsInf),
+		"IsNaN":                  reflect.ValueOf(math.IsNaN),
+		"J0":                     reflect.ValueOf(math.J0),
+		"J1":                     reflect.ValueOf(math.J1),
+		"Jn":                     reflect.ValueOf(math.Jn),
+		"Ldexp":                  reflect.ValueOf(math.Ldexp),
+		"Lgamma":                 reflect.ValueOf(math.Lgamma),
+		"Ln10":                   reflect.ValueOf(math.Ln10),
+		"Ln2":                    reflect.ValueOf(math.Ln2),
+		"Log":                    reflect.ValueOf(math.Log),
+		"Log10":                  reflect.ValueOf(math.Log10),
+		"Log10E":                 reflect.ValueOf(math.Log10E),
+		"Log1p":                  reflect.ValueOf(math.Log1p),
+		"Log2":                   reflect.ValueOf(math.Log2),
+		"Log2E":                  reflect.ValueOf(math.Log2E),
+		"Logb":                   reflect.ValueOf(math.Logb),
+		"Max":                    reflect.ValueOf(math.Max),
+		"MaxFloat32":             reflect.ValueOf(math.MaxFloat32),
+		"MaxFloat64":             reflect.ValueOf(math.MaxFloat64),
+		"MaxInt16":               reflect.ValueOf(math.MaxInt16),
+		"MaxInt32":               reflect.ValueOf(math.MaxInt32),
+		"MaxInt64":               reflect.ValueOf(int64(math.MaxInt64)),
+		"MaxInt8":                reflect.ValueOf(math.MaxInt8),
+		"MaxUint16":              reflect.ValueOf(math.MaxUint16),
+		"MaxUint32":              reflect.ValueOf(int64(math.MaxUint32)),
+		"MaxUint64":              reflect.ValueOf(uint64(math.MaxUint64)),
+		"MaxUint8":               reflect.ValueOf(math.MaxUint8),
+		"Min":                    reflect.ValueOf(math.Min),
+		"MinInt16":               reflect.ValueOf(math.MinInt16),
+		"MinInt32":               reflect.ValueOf(math.MinInt32),
+		"MinInt64":               reflect.ValueOf(int64(math.MinInt64)),
+		"MinInt8":                reflect.ValueOf(math.MinInt8),
+		"Mod":                    reflect.ValueOf(math.Mod),
+		"Modf":                   reflect.ValueOf(math.Modf),
+		"NaN":                    reflect.ValueOf(math.NaN),
+		"Nextafter":              reflect.ValueOf(math.Nextafter),
+		"Nextafter32":            reflect.ValueOf(math.Nextafter32),
+		"Phi":                    reflect.ValueOf(math.Phi),
+		"Pi":                     reflect.ValueOf(math.Pi),
+		"Pow":                    reflect.ValueOf(math.Pow),
+		"Pow10":                  reflect.ValueOf(math.Pow10),
+		"Remainder":              reflect.ValueOf(math.Remainder),
+		"Round":                  reflect.ValueOf(math.Round),
+		"RoundToEven":            reflect.ValueOf(math.RoundToEven),
+		"Signbit":                reflect.ValueOf(math.Signbit),
+		"Sin":                    reflect.ValueOf(math.Sin),
+		"Sincos":                 reflect.ValueOf(math.Sincos),
+		"Sinh":                   reflect.ValueOf(math.Sinh),
+		"SmallestNonzeroFloat32": reflect.ValueOf(math.SmallestNonzeroFloat32),
+		"SmallestNonzeroFloat64": reflect.ValueOf(math.SmallestNonzeroFloat64),
+		"Sqrt":                   reflect.ValueOf(math.Sqrt),
+		"Sqrt2":                  reflect.ValueOf(math.Sqrt2),
+		"SqrtE":                  reflect.ValueOf(math.SqrtE),
+		"SqrtPhi":                reflect.ValueOf(math.SqrtPhi),
+		"SqrtPi":                 reflect.ValueOf(math.SqrtPi),
+		"Tan":                    reflect.ValueOf(math.Tan),
+		"Tanh":                   reflect.ValueOf(math.Tanh),
+		"Trunc":                  reflect.ValueOf(math.Trunc),
+		"Y0":                     reflect.ValueOf(math.Y0),
+		"Y1":                     reflect.ValueOf(math.Y1),
+		"Yn":                     reflect.ValueOf(math.Yn),
+	}
+	env.PackageDocs["math"] = map[string]string{
+		"Abs":                    "Abs returns the absolute value of x.",
+		"Acos":                   "Acos returns the arccosine, in radians, of x.",
+		"Acosh":                  "Acosh returns the inverse hyperbolic cosine of x.",
+		"Asin":                   "Asin returns the arcsine, in radians, of x.",
+		"Asinh":                  "Asinh returns the inverse hyperbolic sine of x.",
+		"Atan":                   "Atan returns the arctangent, in radians, of x.",
+		"Atan2":                  "Atan2 returns the arc tangent of y/x, using the signs of the two to determine the quadrant of the return value.",
+		"Atanh":                  "Atanh returns the inverse hyperbolic tangent of x.",
+		"Cbrt":                   "Cbrt returns the cube root of x.",
+		"Ceil":                   "Ceil returns the least integer value greater than or equal to x.",
+		"Copysign":               "Copysign returns a value with the magnitude of f and the sign of sign.",
+		"Cos":                    "Cos returns the cosine of the radian argument x.",
+		"Cosh":                   "Cosh returns the hyperbolic cosine of x.",
+		"Dim":                    "Dim returns the maximum of x-y or 0.",
+		"E":                      "Mathematical constants.",
+		"Erf":                    "Erf returns the error function of x.",
+		"Erfc":                   "Erfc returns the complementary error function of x.",
+		"Erfcinv":                "Erfcinv returns the inverse of [Erfc](x).",
+		"Erfinv":                 "Erfinv returns the inverse error function of x.",
+		"Exp":                    "Exp returns e**x, the base-e exponential of x.",
+		"Exp2":                   "Exp2 returns 2**x, the base-2 exponential of x.",
+		"Expm1":                  "Expm1 returns e**x - 1, the base-e exponential of x minus 1.",
+		"FMA":                    "FMA returns x * y + z, computed with only one rounding.",
+		"Float32bits":            "Float32bits returns the IEEE 754 binary representation of f, with the sign bit of f and the result in the same bit position.",
+		"Float32frombits":        "Float32frombits returns the floating-point number corresponding to the IEEE 754 binary representation b, with the sign bit of b and the result in the same bit position.",
+		"Float64bits":            "Float64bits returns the IEEE 754 binary representation of f, with the sign bit of f and the result in the same bit position, and Float64bits(Float64frombits(x)) == x.",
+		"Float64frombits":        "Float64frombits returns the floating-point number corresponding to the IEEE 754 binary representation b, with the sign bit of b and the result in the same bit position.",
+		"Floor":                  "Floor returns the greatest integer value less than or equal to x.",
+		"Frexp":                  "Frexp breaks f into a normalized fraction and an integral power of two.",
+		"Gamma":                  "Gamma returns the Gamma function of x.",
+		"Hypot":                  "Hypot returns [Sqrt](p*p + q*q), taking care to avoid unnecessary overflow and underflow.",
+		"Ilogb":                  "Ilogb returns the binary exponent of x as an integer.",
+		"Inf":                    "Inf returns positive infinity if sign >= 0, negative infinity if sign < 0.",
+		"IsInf":                  "IsInf reports whether f is an infinity, according to sign.",
+		"IsNaN":                  "IsNaN reports whether f is an IEEE 754 “not-a-number” value.",
+		"J0":                     "J0 returns the order-zero Bessel function of the first kind.",
+		"J1":                     "J1 returns the order-one Bessel function of the first kind.",
+		"Jn":                     "Jn returns the order-n Bessel function of the first kind.",
+		"Ldexp":                  "Ldexp is the inverse of [Frexp].",
+		"Lgamma":                 "Lgamma returns the natural logarithm and sign (-1 or +1) of [Gamma](x).",
+		"Ln10":                   "Mathematical constants.",
+		"Ln2":                    "Mathematical constants.",
+		"Log":                    "Log returns the natural logarithm of x.",
+		"Log10":                  "Log10 returns the decimal logarithm of x.",
+		"Log10E":                 "Mathematical constants.",
+		"Log1p":                  "Log1p returns the natural logarithm of 1 plus its argument x.",
+		"Log2":                   "Log2 returns the binary logarithm of x.",
+		"Log2E":                  "Mathematical constants.",
+		"Logb":                   "Logb returns the binary exponent of x.",
+		"Max":                    "Max returns the larger of x or y.",
+		"MaxFloat32":             "Floating-point limit values.",
+		"MaxFloat64":             "Floating-point limit values.",
+		"MaxInt16":               "Integer limit values.",
+		"MaxInt32":               "Integer limit values.",
+		"MaxInt64":               "Integer limit values.",
+		"MaxInt8":                "Integer limit values.",
+		"MaxUint16":              "Integer limit values.",
+		"MaxUint32":              "Integer limit values.",
+		"MaxUint64":              "Integer limit values.",
+		"MaxUint8":               "Integer limit values.",
+		"Min":                    "Min returns the smaller of x or y.",
+		"MinInt16":               "Integer limit values.",
+		"MinInt32":               "Integer limit values.",
+		"MinInt64":               "Integer limit values.",
+		"MinInt8":                "Integer limit values.",
+		"Mod":                    "Mod returns the floating-point remainder of x/y.",
+		"Modf":                   "Modf returns integer and fractional floating-point numbers that sum to f.",
+		"NaN":                    "NaN returns an IEEE 754 “not-a-number” value.",
+		"Nextafter":              "Nextafter returns the next representable float64 value after x towards y.",
+		"Nextafter32":            "Nextafter32 returns the next representable float32 value after x towards y.",
+		"Phi":                    "Mathematical constants.",
+		"Pi":                     "Mathematical constants.",
+		"Pow":                    "Pow returns x**y, the base-x exponential of y.",
+		"Pow10":                  "Pow10 returns 10**n, the base-10 exponential of n.",
+		"Remainder":              "Remainder returns the IEEE 754 floating-point remainder of x/y.",
+		"Round":                  "Round returns the nearest integer, rounding half away from zero.",
+		"RoundToEven":            "RoundToEven returns the nearest integer, rounding ties to even.",
+		"Signbit":                "Signbit reports whether x is negative or negative zero.",
+		"Sin":                    "Sin returns the sine of the radian argument x.",
+		"Sincos":                 "Sincos returns Sin(x), Cos(x).",
+		"Sinh":                   "Sinh returns the hyperbolic sine of x.",
+		"SmallestNonzeroFloat32": "Floating-point limit values.",
+		"SmallestNonzeroFloat64": "Floating-point limit values.",
+		"Sqrt":                   "Sqrt returns the square root of x.",
+		"Sqrt2":                  "Mathematical constants.",
+		"SqrtE":                  "Mathematical constants.",
+		"SqrtPhi":                "Mathematical constants.",
+		"SqrtPi":                 "Mathematical constants.",
+		"Tan":                    "Tan returns the tangent of the radian argument x.",
+		"Tanh":                   "Tanh returns the hyperbolic tangent of x.",
+		"Trunc":                  "Trunc returns the integer value of x.",
+		"Y0":                     "Y0 returns the order-zero Bessel function of the second kind.",
+		"Y1":                     "Y1 returns the order-one Bessel function of the second kind.",
+		"Yn":                     "Yn returns the order-n Bessel function of the second kind.",
 	}
 }
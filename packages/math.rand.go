@@ -1,3 +1,5 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
 package packages
 
 import (
@@ -18,9 +20,63 @@ func init() {
 		"Int63":       reflect.ValueOf(rand.Int63),
 		"Int63n":      reflect.ValueOf(rand.Int63n),
 		"Intn":        reflect.ValueOf(rand.Intn),
+		"New":         reflect.ValueOf(rand.New),
+		"NewSource":   reflect.ValueOf(rand.NewSource),
+		"NewZipf":     reflect.ValueOf(rand.NewZipf),
 		"NormFloat64": reflect.ValueOf(rand.NormFloat64),
 		"Perm":        reflect.ValueOf(rand.Perm),
+		"Read":        reflect.ValueOf(rand.Read),
 		"Seed":        reflect.ValueOf(rand.Seed),
+		"Shuffle":     reflect.ValueOf(rand.Shuffle),
 		"Uint32":      reflect.ValueOf(rand.Uint32),
+		"Uint64":      reflect.ValueOf(rand.Uint64),
+	}
+	env.PackageTypes["math/rand"] = map[string]reflect.Type{
+		"Rand":     reflect.TypeOf(rand.Rand{}),
+		"Source":   reflect.TypeOf((*rand.Source)(nil)).Elem(),
+		"Source64": reflect.TypeOf((*rand.Source64)(nil)).Elem(),
+		"Zipf":     reflect.TypeOf(rand.Zipf{}),
+	}
+	env.PackageDocs["math/rand"] = map[string]string{
+		"ExpFloat64":       "ExpFloat64 returns an exponentially distributed float64 in the range (0, +[math.MaxFloat64]] with an exponential distribution whose rate parameter (lambda) is 1 and whose mean is 1/lambda (1) from the default [Source].",
+		"Float32":          "Float32 returns, as a float32, a pseudo-random number in the half-open interval [0.0,1.0) from the default [Source].",
+		"Float64":          "Float64 returns, as a float64, a pseudo-random number in the half-open interval [0.0,1.0) from the default [Source].",
+		"Int":              "Int returns a non-negative pseudo-random int from the default [Source].",
+		"Int31":            "Int31 returns a non-negative pseudo-random 31-bit integer as an int32 from the default [Source].",
+		"Int31n":           "Int31n returns, as an int32, a non-negative pseudo-random number in the half-open interval [0,n) from the default [Source].",
+		"Int63":            "Int63 returns a non-negative pseudo-random 63-bit integer as an int64 from the default [Source].",
+		"Int63n":           "Int63n returns, as an int64, a non-negative pseudo-random number in the half-open interval [0,n) from the default [Source].",
+		"Intn":             "Intn returns, as an int, a non-negative pseudo-random number in the half-open interval [0,n) from the default [Source].",
+		"New":              "New returns a new [Rand] that uses random values from src to generate other random values.",
+		"NewSource":        "NewSource returns a new pseudo-random [Source] seeded with the given value.",
+		"NewZipf":          "NewZipf returns a [Zipf] variate generator.",
+		"NormFloat64":      "NormFloat64 returns a normally distributed float64 in the range [-math.MaxFloat64, +[math.MaxFloat64]] with standard normal distribution (mean = 0, stddev = 1) from the default [Source].",
+		"Perm":             "Perm returns, as a slice of n ints, a pseudo-random permutation of the integers in the half-open interval [0,n) from the default [Source].",
+		"Rand":             "A Rand is a source of random numbers.",
+		"Rand.ExpFloat64":  "ExpFloat64 returns an exponentially distributed float64 in the range (0, +[math.MaxFloat64]] with an exponential distribution whose rate parameter (lambda) is 1 and whose mean is 1/lambda (1).",
+		"Rand.Float32":     "Float32 returns, as a float32, a pseudo-random number in the half-open interval [0.0,1.0).",
+		"Rand.Float64":     "Float64 returns, as a float64, a pseudo-random number in the half-open interval [0.0,1.0).",
+		"Rand.Int":         "Int returns a non-negative pseudo-random int.",
+		"Rand.Int31":       "Int31 returns a non-negative pseudo-random 31-bit integer as an int32.",
+		"Rand.Int31n":      "Int31n returns, as an int32, a non-negative pseudo-random number in the half-open interval [0,n).",
+		"Rand.Int63":       "Int63 returns a non-negative pseudo-random 63-bit integer as an int64.",
+		"Rand.Int63n":      "Int63n returns, as an int64, a non-negative pseudo-random number in the half-open interval [0,n).",
+		"Rand.Intn":        "Intn returns, as an int, a non-negative pseudo-random number in the half-open interval [0,n).",
+		"Rand.NormFloat64": "NormFloat64 returns a normally distributed float64 in the range -math.MaxFloat64 through +[math.MaxFloat64] inclusive, with standard normal distribution (mean = 0, stddev = 1).",
+		"Rand.Perm":        "Perm returns, as a slice of n ints, a pseudo-random permutation of the integers in the half-open interval [0,n).",
+		"Rand.Read":        "Read generates len(p) random bytes and writes them into p.",
+		"Rand.Seed":        "Seed uses the provided seed value to initialize the generator to a deterministic state.",
+		"Rand.Shuffle":     "Shuffle pseudo-randomizes the order of elements.",
+		"Rand.Uint32":      "Uint32 returns a pseudo-random 32-bit value as a uint32.",
+		"Rand.Uint64":      "Uint64 returns a pseudo-random 64-bit value as a uint64.",
+		"Read":             "Read generates len(p) random bytes from the default [Source] and writes them into p.",
+		"Seed":             "Seed uses the provided seed value to initialize the default Source to a deterministic state.",
+		"Shuffle":          "Shuffle pseudo-randomizes the order of elements using the default [Source].",
+		"Source":           "A Source represents a source of uniformly-distributed pseudo-random int64 values in the range [0, 1<<63).",
+		"Source64":         "A Source64 is a [Source] that can also generate uniformly-distributed pseudo-random uint64 values in the range [0, 1<<64) directly.",
+		"Uint32":           "Uint32 returns a pseudo-random 32-bit value as a uint32 from the default [Source].",
+		"Uint64":           "Uint64 returns a pseudo-random 64-bit value as a uint64 from the default [Source].",
+		"Zipf":             "A Zipf generates Zipf distributed variates.",
+		"Zipf.Uint64":      "Uint64 returns a value drawn from the [Zipf] distribution described by the [Zipf] object.",
 	}
 }
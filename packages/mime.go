@@ -0,0 +1,42 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
+package packages
+
+import (
+	"mime"
+	"reflect"
+
+	"github.com/mattn/anko/env"
+)
+
+func init() {
+	env.Packages["mime"] = map[string]reflect.Value{
+		"AddExtensionType":         reflect.ValueOf(mime.AddExtensionType),
+		"BEncoding":                reflect.ValueOf(mime.BEncoding),
+		"ErrInvalidMediaParameter": reflect.ValueOf(mime.ErrInvalidMediaParameter),
+		"ExtensionsByType":         reflect.ValueOf(mime.ExtensionsByType),
+		"FormatMediaType":          reflect.ValueOf(mime.FormatMediaType),
+		"ParseMediaType":           reflect.ValueOf(mime.ParseMediaType),
+		"QEncoding":                reflect.ValueOf(mime.QEncoding),
+		"TypeByExtension":          reflect.ValueOf(mime.TypeByExtension),
+	}
+	env.PackageTypes["mime"] = map[string]reflect.Type{
+		"WordDecoder": reflect.TypeOf(mime.WordDecoder{}),
+		"WordEncoder": reflect.TypeOf((*mime.WordEncoder)(nil)).Elem(),
+	}
+	env.PackageDocs["mime"] = map[string]string{
+		"AddExtensionType":         "AddExtensionType sets the MIME type associated with the extension ext to typ.",
+		"BEncoding":                "BEncoding represents Base64 encoding scheme as defined by RFC 2045.",
+		"ErrInvalidMediaParameter": "ErrInvalidMediaParameter is returned by [ParseMediaType] if the media type value was found but there was an error parsing the optional parameters",
+		"ExtensionsByType":         "ExtensionsByType returns the extensions known to be associated with the MIME type typ.",
+		"FormatMediaType":          "FormatMediaType serializes mediatype t and the parameters param as a media type conforming to RFC 2045 and RFC 2616.",
+		"ParseMediaType":           "ParseMediaType parses a media type value and any optional parameters, per RFC 1521.",
+		"QEncoding":                "QEncoding represents the Q-encoding scheme as defined by RFC 2047.",
+		"TypeByExtension":          "TypeByExtension returns the MIME type associated with the file extension ext.",
+		"WordDecoder":              "A WordDecoder decodes MIME headers containing RFC 2047 encoded-words.",
+		"WordDecoder.Decode":       "Decode decodes an RFC 2047 encoded-word.",
+		"WordDecoder.DecodeHeader": "DecodeHeader decodes all encoded-words of the given string.",
+		"WordEncoder":              "A WordEncoder is an RFC 2047 encoded-word encoder.",
+		"WordEncoder.Encode":       "Encode returns the encoded-word form of s.",
+	}
+}
@@ -1,3 +1,6 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
+//go:build !appengine
 // +build !appengine
 
 package packages
@@ -12,12 +15,14 @@ import (
 func init() {
 	env.Packages["net"] = map[string]reflect.Value{
 		"CIDRMask":                   reflect.ValueOf(net.CIDRMask),
+		"DefaultResolver":            reflect.ValueOf(net.DefaultResolver),
 		"Dial":                       reflect.ValueOf(net.Dial),
 		"DialIP":                     reflect.ValueOf(net.DialIP),
 		"DialTCP":                    reflect.ValueOf(net.DialTCP),
 		"DialTimeout":                reflect.ValueOf(net.DialTimeout),
 		"DialUDP":                    reflect.ValueOf(net.DialUDP),
 		"DialUnix":                   reflect.ValueOf(net.DialUnix),
+		"ErrClosed":                  reflect.ValueOf(net.ErrClosed),
 		"ErrWriteToConnected":        reflect.ValueOf(net.ErrWriteToConnected),
 		"FileConn":                   reflect.ValueOf(net.FileConn),
 		"FileListener":               reflect.ValueOf(net.FileListener),
@@ -73,4 +78,227 @@ func init() {
 		"ResolveUnixAddr":            reflect.ValueOf(net.ResolveUnixAddr),
 		"SplitHostPort":              reflect.ValueOf(net.SplitHostPort),
 	}
+	env.PackageTypes["net"] = map[string]reflect.Type{
+		"Addr":                reflect.TypeOf((*net.Addr)(nil)).Elem(),
+		"AddrError":           reflect.TypeOf(net.AddrError{}),
+		"Buffers":             reflect.TypeOf((*net.Buffers)(nil)).Elem(),
+		"Conn":                reflect.TypeOf((*net.Conn)(nil)).Elem(),
+		"DNSConfigError":      reflect.TypeOf(net.DNSConfigError{}),
+		"DNSError":            reflect.TypeOf(net.DNSError{}),
+		"Dialer":              reflect.TypeOf(net.Dialer{}),
+		"Error":               reflect.TypeOf((*net.Error)(nil)).Elem(),
+		"Flags":               reflect.TypeOf((*net.Flags)(nil)).Elem(),
+		"HardwareAddr":        reflect.TypeOf((*net.HardwareAddr)(nil)).Elem(),
+		"IP":                  reflect.TypeOf((*net.IP)(nil)).Elem(),
+		"IPAddr":              reflect.TypeOf(net.IPAddr{}),
+		"IPConn":              reflect.TypeOf(net.IPConn{}),
+		"IPMask":              reflect.TypeOf((*net.IPMask)(nil)).Elem(),
+		"IPNet":               reflect.TypeOf(net.IPNet{}),
+		"Interface":           reflect.TypeOf(net.Interface{}),
+		"InvalidAddrError":    reflect.TypeOf((*net.InvalidAddrError)(nil)).Elem(),
+		"ListenConfig":        reflect.TypeOf(net.ListenConfig{}),
+		"Listener":            reflect.TypeOf((*net.Listener)(nil)).Elem(),
+		"MX":                  reflect.TypeOf(net.MX{}),
+		"NS":                  reflect.TypeOf(net.NS{}),
+		"OpError":             reflect.TypeOf(net.OpError{}),
+		"PacketConn":          reflect.TypeOf((*net.PacketConn)(nil)).Elem(),
+		"ParseError":          reflect.TypeOf(net.ParseError{}),
+		"Resolver":            reflect.TypeOf(net.Resolver{}),
+		"SRV":                 reflect.TypeOf(net.SRV{}),
+		"TCPAddr":             reflect.TypeOf(net.TCPAddr{}),
+		"TCPConn":             reflect.TypeOf(net.TCPConn{}),
+		"TCPListener":         reflect.TypeOf(net.TCPListener{}),
+		"UDPAddr":             reflect.TypeOf(net.UDPAddr{}),
+		"UDPConn":             reflect.TypeOf(net.UDPConn{}),
+		"UnixAddr":            reflect.TypeOf(net.UnixAddr{}),
+		"UnixConn":            reflect.TypeOf(net.UnixConn{}),
+		"UnixListener":        reflect.TypeOf(net.UnixListener{}),
+		"UnknownNetworkError": reflect.TypeOf((*net.UnknownNetworkError)(nil)).Elem(),
+	}
+	env.PackageDocs["net"] = map[string]string{
+		"Addr":                          "Addr represents a network end point address.",
+		"Buffers":                       "Buffers contains zero or more runs of bytes to write.",
+		"Buffers.Read":                  "Read from the buffers.",
+		"Buffers.WriteTo":               "WriteTo writes contents of the buffers to w.",
+		"CIDRMask":                      "CIDRMask returns an [IPMask] consisting of 'ones' 1 bits followed by 0s up to a total length of 'bits' bits.",
+		"Conn":                          "Conn is a generic stream-oriented network connection.",
+		"DNSConfigError":                "DNSConfigError represents an error reading the machine's DNS configuration.",
+		"DNSError":                      "DNSError represents a DNS lookup error.",
+		"DNSError.Temporary":            "Temporary reports whether the DNS error is known to be temporary.",
+		"DNSError.Timeout":              "Timeout reports whether the DNS lookup is known to have timed out.",
+		"DefaultResolver":               "DefaultResolver is the resolver used by the package-level Lookup functions and by Dialers without a specified Resolver.",
+		"Dial":                          "Dial connects to the address on the named network.",
+		"DialIP":                        "DialIP acts like [Dial] for IP networks.",
+		"DialTCP":                       "DialTCP acts like [Dial] for TCP networks.",
+		"DialTimeout":                   "DialTimeout acts like [Dial] but takes a timeout.",
+		"DialUDP":                       "DialUDP acts like [Dial] for UDP networks.",
+		"DialUnix":                      "DialUnix acts like [Dial] for Unix networks.",
+		"Dialer":                        "A Dialer contains options for connecting to an address.",
+		"Dialer.Dial":                   "Dial connects to the address on the named network.",
+		"Dialer.DialContext":            "DialContext connects to the address on the named network using the provided context.",
+		"ErrClosed":                     "ErrClosed is the error returned by an I/O call on a network connection that has already been closed, or that is closed by another goroutine before the I/O is completed.",
+		"ErrWriteToConnected":           "Various errors contained in OpError.",
+		"Error":                         "An Error represents a network error.",
+		"FileConn":                      "FileConn returns a copy of the network connection corresponding to the open file f.",
+		"FileListener":                  "FileListener returns a copy of the network listener corresponding to the open file f.",
+		"FilePacketConn":                "FilePacketConn returns a copy of the packet network connection corresponding to the open file f.",
+		"FlagBroadcast":                 "interface supports broadcast access capability",
+		"FlagLoopback":                  "interface is a loopback interface",
+		"FlagMulticast":                 "interface supports multicast access capability",
+		"FlagPointToPoint":              "interface belongs to a point-to-point link",
+		"FlagUp":                        "interface is administratively up",
+		"HardwareAddr":                  "A HardwareAddr represents a physical hardware address.",
+		"IP":                            "An IP is a single IP address, a slice of bytes.",
+		"IP.DefaultMask":                "DefaultMask returns the default IP mask for the IP address ip.",
+		"IP.Equal":                      "Equal reports whether ip and x are the same IP address.",
+		"IP.IsGlobalUnicast":            "IsGlobalUnicast reports whether ip is a global unicast address.",
+		"IP.IsInterfaceLocalMulticast":  "IsInterfaceLocalMulticast reports whether ip is an interface-local multicast address.",
+		"IP.IsLinkLocalMulticast":       "IsLinkLocalMulticast reports whether ip is a link-local multicast address.",
+		"IP.IsLinkLocalUnicast":         "IsLinkLocalUnicast reports whether ip is a link-local unicast address.",
+		"IP.IsLoopback":                 "IsLoopback reports whether ip is a loopback address.",
+		"IP.IsMulticast":                "IsMulticast reports whether ip is a multicast address.",
+		"IP.IsUnspecified":              "IsUnspecified reports whether ip is an unspecified address, either the IPv4 address \"0.0.0.0\" or the IPv6 address \"::\".",
+		"IP.MarshalText":                "MarshalText implements the encoding.TextMarshaler interface.",
+		"IP.Mask":                       "Mask returns the result of masking the IP address ip with mask.",
+		"IP.String":                     "String returns the string form of the IP address ip.",
+		"IP.To16":                       "To16 converts the IP address ip to a 16-byte representation.",
+		"IP.To4":                        "To4 converts the IPv4 address ip to a 4-byte representation.",
+		"IP.UnmarshalText":              "UnmarshalText implements the encoding.TextUnmarshaler interface.",
+		"IPAddr":                        "IPAddr represents the address of an IP end point.",
+		"IPAddr.Network":                "Network returns the address's network name, \"ip\".",
+		"IPConn":                        "IPConn is the implementation of the [Conn] and [PacketConn] interfaces for IP network connections.",
+		"IPConn.ReadFrom":               "ReadFrom implements the [PacketConn] ReadFrom method.",
+		"IPConn.ReadFromIP":             "ReadFromIP acts like ReadFrom but returns an IPAddr.",
+		"IPConn.ReadMsgIP":              "ReadMsgIP reads a message from c, copying the payload into b and the associated out-of-band data into oob.",
+		"IPConn.SyscallConn":            "SyscallConn returns a raw network connection.",
+		"IPConn.WriteMsgIP":             "WriteMsgIP writes a message to addr via c, copying the payload from b and the associated out-of-band data from oob.",
+		"IPConn.WriteTo":                "WriteTo implements the [PacketConn] WriteTo method.",
+		"IPConn.WriteToIP":              "WriteToIP acts like [IPConn.WriteTo] but takes an [IPAddr].",
+		"IPMask":                        "An IPMask is a bitmask that can be used to manipulate IP addresses for IP addressing and routing.",
+		"IPMask.Size":                   "Size returns the number of leading ones and total bits in the mask.",
+		"IPMask.String":                 "String returns the hexadecimal form of m, with no punctuation.",
+		"IPNet":                         "An IPNet represents an IP network.",
+		"IPNet.Contains":                "Contains reports whether the network includes ip.",
+		"IPNet.Network":                 "Network returns the address's network name, \"ip+net\".",
+		"IPNet.String":                  "String returns the CIDR notation of n like \"192.0.2.0/24\" or \"2001:db8::/48\" as defined in RFC 4632 and RFC 4291.",
+		"IPv4":                          "IPv4 returns the IP address (in 16-byte form) of the IPv4 address a.b.c.d.",
+		"IPv4Mask":                      "IPv4Mask returns the IP mask (in 4-byte form) of the IPv4 mask a.b.c.d.",
+		"IPv4allrouter":                 "Well-known IPv4 addresses",
+		"IPv4allsys":                    "Well-known IPv4 addresses",
+		"IPv4bcast":                     "Well-known IPv4 addresses",
+		"IPv4len":                       "IP address lengths (bytes).",
+		"IPv4zero":                      "Well-known IPv4 addresses",
+		"IPv6interfacelocalallnodes":    "Well-known IPv6 addresses",
+		"IPv6len":                       "IP address lengths (bytes).",
+		"IPv6linklocalallnodes":         "Well-known IPv6 addresses",
+		"IPv6linklocalallrouters":       "Well-known IPv6 addresses",
+		"IPv6loopback":                  "Well-known IPv6 addresses",
+		"IPv6unspecified":               "Well-known IPv6 addresses",
+		"IPv6zero":                      "Well-known IPv6 addresses",
+		"Interface":                     "Interface represents a mapping between network interface name and index.",
+		"Interface.Addrs":               "Addrs returns a list of unicast interface addresses for a specific interface.",
+		"Interface.MulticastAddrs":      "MulticastAddrs returns a list of multicast, joined group addresses for a specific interface.",
+		"InterfaceAddrs":                "InterfaceAddrs returns a list of the system's unicast interface addresses.",
+		"InterfaceByIndex":              "InterfaceByIndex returns the interface specified by index.",
+		"InterfaceByName":               "InterfaceByName returns the interface specified by name.",
+		"Interfaces":                    "Interfaces returns a list of the system's network interfaces.",
+		"JoinHostPort":                  "JoinHostPort combines host and port into a network address of the form \"host:port\".",
+		"Listen":                        "Listen announces on the local network address.",
+		"ListenConfig":                  "ListenConfig contains options for listening to an address.",
+		"ListenConfig.Listen":           "Listen announces on the local network address.",
+		"ListenConfig.ListenPacket":     "ListenPacket announces on the local network address.",
+		"ListenIP":                      "ListenIP acts like [ListenPacket] for IP networks.",
+		"ListenMulticastUDP":            "ListenMulticastUDP acts like [ListenPacket] for UDP networks but takes a group address on a specific network interface.",
+		"ListenPacket":                  "ListenPacket announces on the local network address.",
+		"ListenTCP":                     "ListenTCP acts like [Listen] for TCP networks.",
+		"ListenUDP":                     "ListenUDP acts like [ListenPacket] for UDP networks.",
+		"ListenUnix":                    "ListenUnix acts like [Listen] for Unix networks.",
+		"ListenUnixgram":                "ListenUnixgram acts like [ListenPacket] for Unix networks.",
+		"Listener":                      "A Listener is a generic network listener for stream-oriented protocols.",
+		"LookupAddr":                    "LookupAddr performs a reverse lookup for the given address, returning a list of names mapping to that address.",
+		"LookupCNAME":                   "LookupCNAME returns the canonical name for the given host.",
+		"LookupHost":                    "LookupHost looks up the given host using the local resolver.",
+		"LookupIP":                      "LookupIP looks up host using the local resolver.",
+		"LookupMX":                      "LookupMX returns the DNS MX records for the given domain name sorted by preference.",
+		"LookupNS":                      "LookupNS returns the DNS NS records for the given domain name.",
+		"LookupPort":                    "LookupPort looks up the port for the given network and service.",
+		"LookupSRV":                     "LookupSRV tries to resolve an [SRV] query of the given service, protocol, and domain name.",
+		"LookupTXT":                     "LookupTXT returns the DNS TXT records for the given domain name.",
+		"MX":                            "An MX represents a single DNS MX record.",
+		"NS":                            "An NS represents a single DNS NS record.",
+		"OpError":                       "OpError is the error type usually returned by functions in the net package.",
+		"PacketConn":                    "PacketConn is a generic packet-oriented network connection.",
+		"ParseCIDR":                     "ParseCIDR parses s as a CIDR notation IP address and prefix length, like \"192.0.2.0/24\" or \"2001:db8::/32\", as defined in RFC 4632 and RFC 4291.",
+		"ParseError":                    "A ParseError is the error type of literal network address parsers.",
+		"ParseIP":                       "ParseIP parses s as an IP address, returning the result.",
+		"ParseMAC":                      "ParseMAC parses s as an IEEE 802 MAC-48, EUI-48, EUI-64, or a 20-octet IP over InfiniBand link-layer address using one of the following formats:",
+		"Pipe":                          "Pipe creates a synchronous, in-memory, full duplex network connection; both ends implement the [Conn] interface.",
+		"ResolveIPAddr":                 "ResolveIPAddr returns an address of IP end point.",
+		"ResolveTCPAddr":                "ResolveTCPAddr returns an address of TCP end point.",
+		"ResolveUDPAddr":                "ResolveUDPAddr returns an address of UDP end point.",
+		"ResolveUnixAddr":               "ResolveUnixAddr returns an address of Unix domain socket end point.",
+		"Resolver":                      "A Resolver looks up names and numbers.",
+		"Resolver.LookupAddr":           "LookupAddr performs a reverse lookup for the given address, returning a list of names mapping to that address.",
+		"Resolver.LookupCNAME":          "LookupCNAME returns the canonical name for the given host.",
+		"Resolver.LookupHost":           "LookupHost looks up the given host using the local resolver.",
+		"Resolver.LookupIP":             "LookupIP looks up host for the given network using the local resolver.",
+		"Resolver.LookupIPAddr":         "LookupIPAddr looks up host using the local resolver.",
+		"Resolver.LookupMX":             "LookupMX returns the DNS MX records for the given domain name sorted by preference.",
+		"Resolver.LookupNS":             "LookupNS returns the DNS NS records for the given domain name.",
+		"Resolver.LookupPort":           "LookupPort looks up the port for the given network and service.",
+		"Resolver.LookupSRV":            "LookupSRV tries to resolve an [SRV] query of the given service, protocol, and domain name.",
+		"Resolver.LookupTXT":            "LookupTXT returns the DNS TXT records for the given domain name.",
+		"SRV":                           "An SRV represents a single DNS SRV record.",
+		"SplitHostPort":                 "SplitHostPort splits a network address of the form \"host:port\", \"host%zone:port\", \"[host]:port\" or \"[host%zone]:port\" into host or host%zone and port.",
+		"TCPAddr":                       "TCPAddr represents the address of a TCP end point.",
+		"TCPAddr.Network":               "Network returns the address's network name, \"tcp\".",
+		"TCPConn":                       "TCPConn is an implementation of the [Conn] interface for TCP network connections.",
+		"TCPConn.CloseRead":             "CloseRead shuts down the reading side of the TCP connection.",
+		"TCPConn.CloseWrite":            "CloseWrite shuts down the writing side of the TCP connection.",
+		"TCPConn.ReadFrom":              "ReadFrom implements the io.ReaderFrom ReadFrom method.",
+		"TCPConn.SetKeepAlive":          "SetKeepAlive sets whether the operating system should send keep-alive messages on the connection.",
+		"TCPConn.SetKeepAlivePeriod":    "SetKeepAlivePeriod sets the duration the connection needs to remain idle before TCP starts sending keepalive probes.",
+		"TCPConn.SetLinger":             "SetLinger sets the behavior of Close on a connection which still has data waiting to be sent or to be acknowledged.",
+		"TCPConn.SetNoDelay":            "SetNoDelay controls whether the operating system should delay packet transmission in hopes of sending fewer packets (Nagle's algorithm).",
+		"TCPConn.SyscallConn":           "SyscallConn returns a raw network connection.",
+		"TCPListener":                   "TCPListener is a TCP network listener.",
+		"TCPListener.Accept":            "Accept implements the Accept method in the [Listener] interface; it waits for the next call and returns a generic [Conn].",
+		"TCPListener.AcceptTCP":         "AcceptTCP accepts the next incoming call and returns the new connection.",
+		"TCPListener.Addr":              "Addr returns the listener's network address, a [*TCPAddr].",
+		"TCPListener.Close":             "Close stops listening on the TCP address.",
+		"TCPListener.File":              "File returns a copy of the underlying os.File.",
+		"TCPListener.SetDeadline":       "SetDeadline sets the deadline associated with the listener.",
+		"TCPListener.SyscallConn":       "SyscallConn returns a raw network connection.",
+		"UDPAddr":                       "UDPAddr represents the address of a UDP end point.",
+		"UDPAddr.Network":               "Network returns the address's network name, \"udp\".",
+		"UDPConn":                       "UDPConn is the implementation of the [Conn] and [PacketConn] interfaces for UDP network connections.",
+		"UDPConn.ReadFrom":              "ReadFrom implements the [PacketConn] ReadFrom method.",
+		"UDPConn.ReadFromUDP":           "ReadFromUDP acts like [UDPConn.ReadFrom] but returns a UDPAddr.",
+		"UDPConn.ReadMsgUDP":            "ReadMsgUDP reads a message from c, copying the payload into b and the associated out-of-band data into oob.",
+		"UDPConn.SyscallConn":           "SyscallConn returns a raw network connection.",
+		"UDPConn.WriteMsgUDP":           "WriteMsgUDP writes a message to addr via c if c isn't connected, or to c's remote address if c is connected (in which case addr must be nil).",
+		"UDPConn.WriteTo":               "WriteTo implements the [PacketConn] WriteTo method.",
+		"UDPConn.WriteToUDP":            "WriteToUDP acts like [UDPConn.WriteTo] but takes a [UDPAddr].",
+		"UnixAddr":                      "UnixAddr represents the address of a Unix domain socket end point.",
+		"UnixAddr.Network":              "Network returns the address's network name, \"unix\", \"unixgram\" or \"unixpacket\".",
+		"UnixConn":                      "UnixConn is an implementation of the [Conn] interface for connections to Unix domain sockets.",
+		"UnixConn.CloseRead":            "CloseRead shuts down the reading side of the Unix domain connection.",
+		"UnixConn.CloseWrite":           "CloseWrite shuts down the writing side of the Unix domain connection.",
+		"UnixConn.ReadFrom":             "ReadFrom implements the [PacketConn].ReadFrom method.",
+		"UnixConn.ReadFromUnix":         "ReadFromUnix acts like [UnixConn.ReadFrom] but returns a [UnixAddr].",
+		"UnixConn.ReadMsgUnix":          "ReadMsgUnix reads a message from c, copying the payload into b and the associated out-of-band data into oob.",
+		"UnixConn.SyscallConn":          "SyscallConn returns a raw network connection.",
+		"UnixConn.WriteMsgUnix":         "WriteMsgUnix writes a message to addr via c, copying the payload from b and the associated out-of-band data from oob.",
+		"UnixConn.WriteTo":              "WriteTo implements the [PacketConn].WriteTo method.",
+		"UnixConn.WriteToUnix":          "WriteToUnix acts like [UnixConn.WriteTo] but takes a [UnixAddr].",
+		"UnixListener":                  "UnixListener is a Unix domain socket listener.",
+		"UnixListener.Accept":           "Accept implements the Accept method in the [Listener] interface.",
+		"UnixListener.AcceptUnix":       "AcceptUnix accepts the next incoming call and returns the new connection.",
+		"UnixListener.Addr":             "Addr returns the listener's network address.",
+		"UnixListener.Close":            "Close stops listening on the Unix address.",
+		"UnixListener.File":             "File returns a copy of the underlying os.File.",
+		"UnixListener.SetDeadline":      "SetDeadline sets the deadline associated with the listener.",
+		"UnixListener.SetUnlinkOnClose": "SetUnlinkOnClose sets whether the underlying socket file should be removed from the file system when the listener is closed.",
+		"UnixListener.SyscallConn":      "SyscallConn returns a raw network connection.",
+	}
 }
@@ -1,3 +1,5 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
 package packages
 
 import (
@@ -12,6 +14,16 @@ func init() {
 		"New": reflect.ValueOf(cookiejar.New),
 	}
 	env.PackageTypes["net/http/cookiejar"] = map[string]reflect.Type{
-		"Options": reflect.TypeOf(cookiejar.Options{}),
+		"Jar":              reflect.TypeOf(cookiejar.Jar{}),
+		"Options":          reflect.TypeOf(cookiejar.Options{}),
+		"PublicSuffixList": reflect.TypeOf((*cookiejar.PublicSuffixList)(nil)).Elem(),
+	}
+	env.PackageDocs["net/http/cookiejar"] = map[string]string{
+		"Jar":              "Jar implements the net/http.CookieJar interface.",
+		"Jar.Cookies":      "Cookies implements the Cookies method of the [http.CookieJar] interface.",
+		"Jar.SetCookies":   "SetCookies implements the SetCookies method of the [http.CookieJar] interface.",
+		"New":              "New returns a new cookie jar.",
+		"Options":          "Options are the options for creating a new [Jar].",
+		"PublicSuffixList": "PublicSuffixList provides the public suffix of a domain.",
 	}
 }
@@ -1,3 +1,6 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
+//go:build !appengine
 // +build !appengine
 
 package packages
@@ -11,22 +14,404 @@ import (
 
 func init() {
 	env.Packages["net/http"] = map[string]reflect.Value{
-		"DefaultClient":     reflect.ValueOf(http.DefaultClient),
-		"DefaultServeMux":   reflect.ValueOf(http.DefaultServeMux),
-		"DefaultTransport":  reflect.ValueOf(http.DefaultTransport),
-		"Handle":            reflect.ValueOf(http.Handle),
-		"HandleFunc":        reflect.ValueOf(http.HandleFunc),
-		"ListenAndServe":    reflect.ValueOf(http.ListenAndServe),
-		"ListenAndServeTLS": reflect.ValueOf(http.ListenAndServeTLS),
-		"NewRequest":        reflect.ValueOf(http.NewRequest),
-		"NewServeMux":       reflect.ValueOf(http.NewServeMux),
-		"Serve":             reflect.ValueOf(http.Serve),
-		"SetCookie":         reflect.ValueOf(http.SetCookie),
+		"CanonicalHeaderKey":                  reflect.ValueOf(http.CanonicalHeaderKey),
+		"DefaultClient":                       reflect.ValueOf(http.DefaultClient),
+		"DefaultMaxHeaderBytes":               reflect.ValueOf(http.DefaultMaxHeaderBytes),
+		"DefaultMaxIdleConnsPerHost":          reflect.ValueOf(http.DefaultMaxIdleConnsPerHost),
+		"DefaultServeMux":                     reflect.ValueOf(http.DefaultServeMux),
+		"DefaultTransport":                    reflect.ValueOf(http.DefaultTransport),
+		"DetectContentType":                   reflect.ValueOf(http.DetectContentType),
+		"ErrAbortHandler":                     reflect.ValueOf(http.ErrAbortHandler),
+		"ErrBodyNotAllowed":                   reflect.ValueOf(http.ErrBodyNotAllowed),
+		"ErrBodyReadAfterClose":               reflect.ValueOf(http.ErrBodyReadAfterClose),
+		"ErrContentLength":                    reflect.ValueOf(http.ErrContentLength),
+		"ErrHandlerTimeout":                   reflect.ValueOf(http.ErrHandlerTimeout),
+		"ErrHeaderTooLong":                    reflect.ValueOf(http.ErrHeaderTooLong),
+		"ErrHijacked":                         reflect.ValueOf(http.ErrHijacked),
+		"ErrLineTooLong":                      reflect.ValueOf(http.ErrLineTooLong),
+		"ErrMissingBoundary":                  reflect.ValueOf(http.ErrMissingBoundary),
+		"ErrMissingContentLength":             reflect.ValueOf(http.ErrMissingContentLength),
+		"ErrMissingFile":                      reflect.ValueOf(http.ErrMissingFile),
+		"ErrNoCookie":                         reflect.ValueOf(http.ErrNoCookie),
+		"ErrNoLocation":                       reflect.ValueOf(http.ErrNoLocation),
+		"ErrNotMultipart":                     reflect.ValueOf(http.ErrNotMultipart),
+		"ErrNotSupported":                     reflect.ValueOf(http.ErrNotSupported),
+		"ErrServerClosed":                     reflect.ValueOf(http.ErrServerClosed),
+		"ErrShortBody":                        reflect.ValueOf(http.ErrShortBody),
+		"ErrSkipAltProtocol":                  reflect.ValueOf(http.ErrSkipAltProtocol),
+		"ErrUnexpectedTrailer":                reflect.ValueOf(http.ErrUnexpectedTrailer),
+		"ErrUseLastResponse":                  reflect.ValueOf(http.ErrUseLastResponse),
+		"ErrWriteAfterFlush":                  reflect.ValueOf(http.ErrWriteAfterFlush),
+		"Error":                               reflect.ValueOf(http.Error),
+		"FS":                                  reflect.ValueOf(http.FS),
+		"FileServer":                          reflect.ValueOf(http.FileServer),
+		"Get":                                 reflect.ValueOf(http.Get),
+		"Handle":                              reflect.ValueOf(http.Handle),
+		"HandleFunc":                          reflect.ValueOf(http.HandleFunc),
+		"Head":                                reflect.ValueOf(http.Head),
+		"ListenAndServe":                      reflect.ValueOf(http.ListenAndServe),
+		"ListenAndServeTLS":                   reflect.ValueOf(http.ListenAndServeTLS),
+		"LocalAddrContextKey":                 reflect.ValueOf(http.LocalAddrContextKey),
+		"MaxBytesReader":                      reflect.ValueOf(http.MaxBytesReader),
+		"MethodConnect":                       reflect.ValueOf(http.MethodConnect),
+		"MethodDelete":                        reflect.ValueOf(http.MethodDelete),
+		"MethodGet":                           reflect.ValueOf(http.MethodGet),
+		"MethodHead":                          reflect.ValueOf(http.MethodHead),
+		"MethodOptions":                       reflect.ValueOf(http.MethodOptions),
+		"MethodPatch":                         reflect.ValueOf(http.MethodPatch),
+		"MethodPost":                          reflect.ValueOf(http.MethodPost),
+		"MethodPut":                           reflect.ValueOf(http.MethodPut),
+		"MethodTrace":                         reflect.ValueOf(http.MethodTrace),
+		"NewFileTransport":                    reflect.ValueOf(http.NewFileTransport),
+		"NewRequest":                          reflect.ValueOf(http.NewRequest),
+		"NewRequestWithContext":               reflect.ValueOf(http.NewRequestWithContext),
+		"NewServeMux":                         reflect.ValueOf(http.NewServeMux),
+		"NoBody":                              reflect.ValueOf(http.NoBody),
+		"NotFound":                            reflect.ValueOf(http.NotFound),
+		"NotFoundHandler":                     reflect.ValueOf(http.NotFoundHandler),
+		"ParseHTTPVersion":                    reflect.ValueOf(http.ParseHTTPVersion),
+		"ParseTime":                           reflect.ValueOf(http.ParseTime),
+		"Post":                                reflect.ValueOf(http.Post),
+		"PostForm":                            reflect.ValueOf(http.PostForm),
+		"ProxyFromEnvironment":                reflect.ValueOf(http.ProxyFromEnvironment),
+		"ProxyURL":                            reflect.ValueOf(http.ProxyURL),
+		"ReadRequest":                         reflect.ValueOf(http.ReadRequest),
+		"ReadResponse":                        reflect.ValueOf(http.ReadResponse),
+		"Redirect":                            reflect.ValueOf(http.Redirect),
+		"RedirectHandler":                     reflect.ValueOf(http.RedirectHandler),
+		"SameSiteDefaultMode":                 reflect.ValueOf(http.SameSiteDefaultMode),
+		"SameSiteLaxMode":                     reflect.ValueOf(http.SameSiteLaxMode),
+		"SameSiteNoneMode":                    reflect.ValueOf(http.SameSiteNoneMode),
+		"SameSiteStrictMode":                  reflect.ValueOf(http.SameSiteStrictMode),
+		"Serve":                               reflect.ValueOf(http.Serve),
+		"ServeContent":                        reflect.ValueOf(http.ServeContent),
+		"ServeFile":                           reflect.ValueOf(http.ServeFile),
+		"ServeTLS":                            reflect.ValueOf(http.ServeTLS),
+		"ServerContextKey":                    reflect.ValueOf(http.ServerContextKey),
+		"SetCookie":                           reflect.ValueOf(http.SetCookie),
+		"StateActive":                         reflect.ValueOf(http.StateActive),
+		"StateClosed":                         reflect.ValueOf(http.StateClosed),
+		"StateHijacked":                       reflect.ValueOf(http.StateHijacked),
+		"StateIdle":                           reflect.ValueOf(http.StateIdle),
+		"StateNew":                            reflect.ValueOf(http.StateNew),
+		"StatusAccepted":                      reflect.ValueOf(http.StatusAccepted),
+		"StatusAlreadyReported":               reflect.ValueOf(http.StatusAlreadyReported),
+		"StatusBadGateway":                    reflect.ValueOf(http.StatusBadGateway),
+		"StatusBadRequest":                    reflect.ValueOf(http.StatusBadRequest),
+		"StatusConflict":                      reflect.ValueOf(http.StatusConflict),
+		"StatusContinue":                      reflect.ValueOf(http.StatusContinue),
+		"StatusCreated":                       reflect.ValueOf(http.StatusCreated),
+		"StatusEarlyHints":                    reflect.ValueOf(http.StatusEarlyHints),
+		"StatusExpectationFailed":             reflect.ValueOf(http.StatusExpectationFailed),
+		"StatusFailedDependency":              reflect.ValueOf(http.StatusFailedDependency),
+		"StatusForbidden":                     reflect.ValueOf(http.StatusForbidden),
+		"StatusFound":                         reflect.ValueOf(http.StatusFound),
+		"StatusGatewayTimeout":                reflect.ValueOf(http.StatusGatewayTimeout),
+		"StatusGone":                          reflect.ValueOf(http.StatusGone),
+		"StatusHTTPVersionNotSupported":       reflect.ValueOf(http.StatusHTTPVersionNotSupported),
+		"StatusIMUsed":                        reflect.ValueOf(http.StatusIMUsed),
+		"StatusInsufficientStorage":           reflect.ValueOf(http.StatusInsufficientStorage),
+		"StatusInternalServerError":           reflect.ValueOf(http.StatusInternalServerError),
+		"StatusLengthRequired":                reflect.ValueOf(http.StatusLengthRequired),
+		"StatusLocked":                        reflect.ValueOf(http.StatusLocked),
+		"StatusLoopDetected":                  reflect.ValueOf(http.StatusLoopDetected),
+		"StatusMethodNotAllowed":              reflect.ValueOf(http.StatusMethodNotAllowed),
+		"StatusMisdirectedRequest":            reflect.ValueOf(http.StatusMisdirectedRequest),
+		"StatusMovedPermanently":              reflect.ValueOf(http.StatusMovedPermanently),
+		"StatusMultiStatus":                   reflect.ValueOf(http.StatusMultiStatus),
+		"StatusMultipleChoices":               reflect.ValueOf(http.StatusMultipleChoices),
+		"StatusNetworkAuthenticationRequired": reflect.ValueOf(http.StatusNetworkAuthenticationRequired),
+		"StatusNoContent":                     reflect.ValueOf(http.StatusNoContent),
+		"StatusNonAuthoritativeInfo":          reflect.ValueOf(http.StatusNonAuthoritativeInfo),
+		"StatusNotAcceptable":                 reflect.ValueOf(http.StatusNotAcceptable),
+		"StatusNotExtended":                   reflect.ValueOf(http.StatusNotExtended),
+		"StatusNotFound":                      reflect.ValueOf(http.StatusNotFound),
+		"StatusNotImplemented":                reflect.ValueOf(http.StatusNotImplemented),
+		"StatusNotModified":                   reflect.ValueOf(http.StatusNotModified),
+		"StatusOK":                            reflect.ValueOf(http.StatusOK),
+		"StatusPartialContent":                reflect.ValueOf(http.StatusPartialContent),
+		"StatusPaymentRequired":               reflect.ValueOf(http.StatusPaymentRequired),
+		"StatusPermanentRedirect":             reflect.ValueOf(http.StatusPermanentRedirect),
+		"StatusPreconditionFailed":            reflect.ValueOf(http.StatusPreconditionFailed),
+		"StatusPreconditionRequired":          reflect.ValueOf(http.StatusPreconditionRequired),
+		"StatusProcessing":                    reflect.ValueOf(http.StatusProcessing),
+		"StatusProxyAuthRequired":             reflect.ValueOf(http.StatusProxyAuthRequired),
+		"StatusRequestEntityTooLarge":         reflect.ValueOf(http.StatusRequestEntityTooLarge),
+		"StatusRequestHeaderFieldsTooLarge":   reflect.ValueOf(http.StatusRequestHeaderFieldsTooLarge),
+		"StatusRequestTimeout":                reflect.ValueOf(http.StatusRequestTimeout),
+		"StatusRequestURITooLong":             reflect.ValueOf(http.StatusRequestURITooLong),
+		"StatusRequestedRangeNotSatisfiable":  reflect.ValueOf(http.StatusRequestedRangeNotSatisfiable),
+		"StatusResetContent":                  reflect.ValueOf(http.StatusResetContent),
+		"StatusSeeOther":                      reflect.ValueOf(http.StatusSeeOther),
+		"StatusServiceUnavailable":            reflect.ValueOf(http.StatusServiceUnavailable),
+		"StatusSwitchingProtocols":            reflect.ValueOf(http.StatusSwitchingProtocols),
+		"StatusTeapot":                        reflect.ValueOf(http.StatusTeapot),
+		"StatusTemporaryRedirect":             reflect.ValueOf(http.StatusTemporaryRedirect),
+		"StatusText":                          reflect.ValueOf(http.StatusText),
+		"StatusTooEarly":                      reflect.ValueOf(http.StatusTooEarly),
+		"StatusTooManyRequests":               reflect.ValueOf(http.StatusTooManyRequests),
+		"StatusUnauthorized":                  reflect.ValueOf(http.StatusUnauthorized),
+		"StatusUnavailableForLegalReasons":    reflect.ValueOf(http.StatusUnavailableForLegalReasons),
+		"StatusUnprocessableEntity":           reflect.ValueOf(http.StatusUnprocessableEntity),
+		"StatusUnsupportedMediaType":          reflect.ValueOf(http.StatusUnsupportedMediaType),
+		"StatusUpgradeRequired":               reflect.ValueOf(http.StatusUpgradeRequired),
+		"StatusUseProxy":                      reflect.ValueOf(http.StatusUseProxy),
+		"StatusVariantAlsoNegotiates":         reflect.ValueOf(http.StatusVariantAlsoNegotiates),
+		"StripPrefix":                         reflect.ValueOf(http.StripPrefix),
+		"TimeFormat":                          reflect.ValueOf(http.TimeFormat),
+		"TimeoutHandler":                      reflect.ValueOf(http.TimeoutHandler),
+		"TrailerPrefix":                       reflect.ValueOf(http.TrailerPrefix),
 	}
 	env.PackageTypes["net/http"] = map[string]reflect.Type{
-		"Client":   reflect.TypeOf(http.Client{}),
-		"Cookie":   reflect.TypeOf(http.Cookie{}),
-		"Request":  reflect.TypeOf(http.Request{}),
-		"Response": reflect.TypeOf(http.Response{}),
+		"Client":         reflect.TypeOf(http.Client{}),
+		"CloseNotifier":  reflect.TypeOf((*http.CloseNotifier)(nil)).Elem(),
+		"ConnState":      reflect.TypeOf((*http.ConnState)(nil)).Elem(),
+		"Cookie":         reflect.TypeOf(http.Cookie{}),
+		"CookieJar":      reflect.TypeOf((*http.CookieJar)(nil)).Elem(),
+		"Dir":            reflect.TypeOf((*http.Dir)(nil)).Elem(),
+		"File":           reflect.TypeOf((*http.File)(nil)).Elem(),
+		"FileSystem":     reflect.TypeOf((*http.FileSystem)(nil)).Elem(),
+		"Flusher":        reflect.TypeOf((*http.Flusher)(nil)).Elem(),
+		"Handler":        reflect.TypeOf((*http.Handler)(nil)).Elem(),
+		"HandlerFunc":    reflect.TypeOf((*http.HandlerFunc)(nil)).Elem(),
+		"Header":         reflect.TypeOf((*http.Header)(nil)).Elem(),
+		"Hijacker":       reflect.TypeOf((*http.Hijacker)(nil)).Elem(),
+		"ProtocolError":  reflect.TypeOf(http.ProtocolError{}),
+		"PushOptions":    reflect.TypeOf(http.PushOptions{}),
+		"Pusher":         reflect.TypeOf((*http.Pusher)(nil)).Elem(),
+		"Request":        reflect.TypeOf(http.Request{}),
+		"Response":       reflect.TypeOf(http.Response{}),
+		"ResponseWriter": reflect.TypeOf((*http.ResponseWriter)(nil)).Elem(),
+		"RoundTripper":   reflect.TypeOf((*http.RoundTripper)(nil)).Elem(),
+		"SameSite":       reflect.TypeOf((*http.SameSite)(nil)).Elem(),
+		"ServeMux":       reflect.TypeOf(http.ServeMux{}),
+		"Server":         reflect.TypeOf(http.Server{}),
+		"Transport":      reflect.TypeOf(http.Transport{}),
+	}
+	env.PackageDocs["net/http"] = map[string]string{
+		"CanonicalHeaderKey":                  "CanonicalHeaderKey returns the canonical format of the header key s.",
+		"Client":                              "A Client is an HTTP client.",
+		"Client.CloseIdleConnections":         "CloseIdleConnections closes any connections on its [Transport] which were previously connected from previous requests but are now sitting idle in a \"keep-alive\" state.",
+		"Client.Do":                           "Do sends an HTTP request and returns an HTTP response, following policy (such as redirects, cookies, auth) as configured on the client.",
+		"Client.Get":                          "Get issues a GET to the specified URL.",
+		"Client.Head":                         "Head issues a HEAD to the specified URL.",
+		"Client.Post":                         "Post issues a POST to the specified URL.",
+		"Client.PostForm":                     "PostForm issues a POST to the specified URL, with data's keys and values URL-encoded as the request body.",
+		"CloseNotifier":                       "The CloseNotifier interface is implemented by ResponseWriters which allow detecting when the underlying connection has gone away.",
+		"ConnState":                           "A ConnState represents the state of a client connection to a server.",
+		"Cookie":                              "A Cookie represents an HTTP cookie as sent in the Set-Cookie header of an HTTP response or the Cookie header of an HTTP request.",
+		"Cookie.String":                       "String returns the serialization of the cookie for use in a [Cookie] header (if only Name and Value are set) or a Set-Cookie response header (if other fields are set).",
+		"CookieJar":                           "A CookieJar manages storage and use of cookies in HTTP requests.",
+		"DefaultClient":                       "DefaultClient is the default [Client] and is used by [Get], [Head], and [Post].",
+		"DefaultMaxHeaderBytes":               "DefaultMaxHeaderBytes is the maximum permitted size of the headers in an HTTP request.",
+		"DefaultMaxIdleConnsPerHost":          "DefaultMaxIdleConnsPerHost is the default value of [Transport]'s MaxIdleConnsPerHost.",
+		"DefaultServeMux":                     "DefaultServeMux is the default [ServeMux] used by [Serve].",
+		"DefaultTransport":                    "DefaultTransport is the default implementation of [Transport] and is used by [DefaultClient].",
+		"DetectContentType":                   "DetectContentType implements the algorithm described at https://mimesniff.spec.whatwg.org/ to determine the Content-Type of the given data.",
+		"Dir":                                 "A Dir implements [FileSystem] using the native file system restricted to a specific directory tree.",
+		"Dir.Open":                            "Open implements [FileSystem] using os.Open, opening files for reading rooted and relative to the directory d.",
+		"ErrAbortHandler":                     "ErrAbortHandler is a sentinel panic value to abort a handler.",
+		"ErrBodyNotAllowed":                   "ErrBodyNotAllowed is returned by ResponseWriter.Write calls when the HTTP method or response code does not permit a body.",
+		"ErrBodyReadAfterClose":               "ErrBodyReadAfterClose is returned when reading a [Request] or [Response] Body after the body has been closed.",
+		"ErrContentLength":                    "ErrContentLength is returned by ResponseWriter.Write calls when a Handler set a Content-Length response header with a declared size and then attempted to write more bytes than declared.",
+		"ErrHandlerTimeout":                   "ErrHandlerTimeout is returned on [ResponseWriter] Write calls in handlers which have timed out.",
+		"ErrHeaderTooLong":                    "Deprecated: ErrHeaderTooLong is no longer returned by anything in the net/http package.",
+		"ErrHijacked":                         "ErrHijacked is returned by ResponseWriter.Write calls when the underlying connection has been hijacked using the Hijacker interface.",
+		"ErrLineTooLong":                      "ErrLineTooLong is returned when reading request or response bodies with malformed chunked encoding.",
+		"ErrMissingBoundary":                  "ErrMissingBoundary is returned by Request.MultipartReader when the request's Content-Type does not include a \"boundary\" parameter.",
+		"ErrMissingContentLength":             "Deprecated: ErrMissingContentLength is no longer returned by anything in the net/http package.",
+		"ErrMissingFile":                      "ErrMissingFile is returned by FormFile when the provided file field name is either not present in the request or not a file field.",
+		"ErrNoCookie":                         "ErrNoCookie is returned by Request's Cookie method when a cookie is not found.",
+		"ErrNoLocation":                       "ErrNoLocation is returned by the [Response.Location] method when no Location header is present.",
+		"ErrNotMultipart":                     "ErrNotMultipart is returned by Request.MultipartReader when the request's Content-Type is not multipart/form-data.",
+		"ErrNotSupported":                     "ErrNotSupported indicates that a feature is not supported.",
+		"ErrServerClosed":                     "ErrServerClosed is returned by the [Server.Serve], [ServeTLS], [ListenAndServe], and [ListenAndServeTLS] methods after a call to [Server.Shutdown] or [Server.Close].",
+		"ErrShortBody":                        "Deprecated: ErrShortBody is no longer returned by anything in the net/http package.",
+		"ErrSkipAltProtocol":                  "ErrSkipAltProtocol is a sentinel error value defined by Transport.RegisterProtocol.",
+		"ErrUnexpectedTrailer":                "Deprecated: ErrUnexpectedTrailer is no longer returned by anything in the net/http package.",
+		"ErrUseLastResponse":                  "ErrUseLastResponse can be returned by Client.CheckRedirect hooks to control how redirects are processed.",
+		"ErrWriteAfterFlush":                  "Deprecated: ErrWriteAfterFlush is no longer returned by anything in the net/http package.",
+		"Error":                               "Error replies to the request with the specified error message and HTTP code.",
+		"FS":                                  "FS converts fsys to a [FileSystem] implementation, for use with [FileServer] and [NewFileTransport].",
+		"File":                                "A File is returned by a [FileSystem]'s Open method and can be served by the [FileServer] implementation.",
+		"FileServer":                          "FileServer returns a handler that serves HTTP requests with the contents of the file system rooted at root.",
+		"FileSystem":                          "A FileSystem implements access to a collection of named files.",
+		"Flusher":                             "The Flusher interface is implemented by ResponseWriters that allow an HTTP handler to flush buffered data to the client.",
+		"Get":                                 "Get issues a GET to the specified URL.",
+		"Handle":                              "Handle registers the handler for the given pattern in [DefaultServeMux].",
+		"HandleFunc":                          "HandleFunc registers the handler function for the given pattern in [DefaultServeMux].",
+		"Handler":                             "A Handler responds to an HTTP request.",
+		"HandlerFunc":                         "The HandlerFunc type is an adapter to allow the use of ordinary functions as HTTP handlers.",
+		"HandlerFunc.ServeHTTP":               "ServeHTTP calls f(w, r).",
+		"Head":                                "Head issues a HEAD to the specified URL.",
+		"Header":                              "A Header represents the key-value pairs in an HTTP header.",
+		"Header.Add":                          "Add adds the key, value pair to the header.",
+		"Header.Clone":                        "Clone returns a copy of h or nil if h is nil.",
+		"Header.Del":                          "Del deletes the values associated with key.",
+		"Header.Get":                          "Get gets the first value associated with the given key.",
+		"Header.Set":                          "Set sets the header entries associated with key to the single element value.",
+		"Header.Values":                       "Values returns all values associated with the given key.",
+		"Header.Write":                        "Write writes a header in wire format.",
+		"Header.WriteSubset":                  "WriteSubset writes a header in wire format.",
+		"Hijacker":                            "The Hijacker interface is implemented by ResponseWriters that allow an HTTP handler to take over the connection.",
+		"ListenAndServe":                      "ListenAndServe listens on the TCP network address addr and then calls [Serve] with handler to handle requests on incoming connections.",
+		"ListenAndServeTLS":                   "ListenAndServeTLS acts identically to [ListenAndServe], except that it expects HTTPS connections.",
+		"LocalAddrContextKey":                 "LocalAddrContextKey is a context key.",
+		"MaxBytesReader":                      "MaxBytesReader is similar to io.LimitReader but is intended for limiting the size of incoming request bodies.",
+		"MethodConnect":                       "Common HTTP methods.",
+		"MethodDelete":                        "Common HTTP methods.",
+		"MethodGet":                           "Common HTTP methods.",
+		"MethodHead":                          "Common HTTP methods.",
+		"MethodOptions":                       "Common HTTP methods.",
+		"MethodPatch":                         "Common HTTP methods.",
+		"MethodPost":                          "Common HTTP methods.",
+		"MethodPut":                           "Common HTTP methods.",
+		"MethodTrace":                         "Common HTTP methods.",
+		"NewFileTransport":                    "NewFileTransport returns a new [RoundTripper], serving the provided [FileSystem].",
+		"NewRequest":                          "NewRequest wraps [NewRequestWithContext] using context.Background.",
+		"NewRequestWithContext":               "NewRequestWithContext returns a new [Request] given a method, URL, and optional body.",
+		"NewServeMux":                         "NewServeMux allocates and returns a new [ServeMux].",
+		"NoBody":                              "NoBody is an io.ReadCloser with no bytes.",
+		"NotFound":                            "NotFound replies to the request with an HTTP 404 not found error.",
+		"NotFoundHandler":                     "NotFoundHandler returns a simple request handler that replies to each request with a “404 page not found” reply.",
+		"ParseHTTPVersion":                    "ParseHTTPVersion parses an HTTP version string according to RFC 7230, section 2.6.",
+		"ParseTime":                           "ParseTime parses a time header (such as the Date: header), trying each of the three formats allowed by HTTP/1.1: [TimeFormat], time.RFC850, and time.ANSIC.",
+		"Post":                                "Post issues a POST to the specified URL.",
+		"PostForm":                            "PostForm issues a POST to the specified URL, with data's keys and values URL-encoded as the request body.",
+		"ProtocolError":                       "ProtocolError represents an HTTP protocol error.",
+		"ProxyFromEnvironment":                "ProxyFromEnvironment returns the URL of the proxy to use for a given request, as indicated by the environment variables HTTP_PROXY, HTTPS_PROXY and NO_PROXY (or the lowercase versions thereof).",
+		"ProxyURL":                            "ProxyURL returns a proxy function (for use in a [Transport]) that always returns the same URL.",
+		"PushOptions":                         "PushOptions describes options for [Pusher.Push].",
+		"Pusher":                              "Pusher is the interface implemented by ResponseWriters that support HTTP/2 server push.",
+		"ReadRequest":                         "ReadRequest reads and parses an incoming request from b.",
+		"ReadResponse":                        "ReadResponse reads and returns an HTTP response from r.",
+		"Redirect":                            "Redirect replies to the request with a redirect to url, which may be a path relative to the request path.",
+		"RedirectHandler":                     "RedirectHandler returns a request handler that redirects each request it receives to the given url using the given status code.",
+		"Request":                             "A Request represents an HTTP request received by a server or to be sent by a client.",
+		"Request.AddCookie":                   "AddCookie adds a cookie to the request.",
+		"Request.BasicAuth":                   "BasicAuth returns the username and password provided in the request's Authorization header, if the request uses HTTP Basic Authentication.",
+		"Request.Clone":                       "Clone returns a deep copy of r with its context changed to ctx.",
+		"Request.Context":                     "Context returns the request's context.",
+		"Request.Cookie":                      "Cookie returns the named cookie provided in the request or [ErrNoCookie] if not found.",
+		"Request.Cookies":                     "Cookies parses and returns the HTTP cookies sent with the request.",
+		"Request.FormFile":                    "FormFile returns the first file for the provided form key.",
+		"Request.FormValue":                   "FormValue returns the first value for the named component of the query.",
+		"Request.MultipartReader":             "MultipartReader returns a MIME multipart reader if this is a multipart/form-data or a multipart/mixed POST request, else returns nil and an error.",
+		"Request.ParseForm":                   "ParseForm populates r.Form and r.PostForm.",
+		"Request.ParseMultipartForm":          "ParseMultipartForm parses a request body as multipart/form-data.",
+		"Request.PostFormValue":               "PostFormValue returns the first value for the named component of the POST, PUT, or PATCH request body.",
+		"Request.ProtoAtLeast":                "ProtoAtLeast reports whether the HTTP protocol used in the request is at least major.minor.",
+		"Request.Referer":                     "Referer returns the referring URL, if sent in the request.",
+		"Request.SetBasicAuth":                "SetBasicAuth sets the request's Authorization header to use HTTP Basic Authentication with the provided username and password.",
+		"Request.UserAgent":                   "UserAgent returns the client's User-Agent, if sent in the request.",
+		"Request.WithContext":                 "WithContext returns a shallow copy of r with its context changed to ctx.",
+		"Request.Write":                       "Write writes an HTTP/1.1 request, which is the header and body, in wire format.",
+		"Request.WriteProxy":                  "WriteProxy is like [Request.Write] but writes the request in the form expected by an HTTP proxy.",
+		"Response":                            "Response represents the response from an HTTP request.",
+		"Response.Cookies":                    "Cookies parses and returns the cookies set in the Set-Cookie headers.",
+		"Response.Location":                   "Location returns the URL of the response's \"Location\" header, if present.",
+		"Response.ProtoAtLeast":               "ProtoAtLeast reports whether the HTTP protocol used in the response is at least major.minor.",
+		"Response.Write":                      "Write writes r to w in the HTTP/1.x server response format, including the status line, headers, body, and optional trailer.",
+		"ResponseWriter":                      "A ResponseWriter interface is used by an HTTP handler to construct an HTTP response.",
+		"RoundTripper":                        "RoundTripper is an interface representing the ability to execute a single HTTP transaction, obtaining the [Response] for a given [Request].",
+		"SameSite":                            "SameSite allows a server to define a cookie attribute making it impossible for the browser to send this cookie along with cross-site requests.",
+		"Serve":                               "Serve accepts incoming HTTP connections on the listener l, creating a new service goroutine for each.",
+		"ServeContent":                        "ServeContent replies to the request using the content in the provided ReadSeeker.",
+		"ServeFile":                           "ServeFile replies to the request with the contents of the named file or directory.",
+		"ServeMux":                            "ServeMux is an HTTP request multiplexer.",
+		"ServeMux.Handle":                     "Handle registers the handler for the given pattern.",
+		"ServeMux.HandleFunc":                 "HandleFunc registers the handler function for the given pattern.",
+		"ServeMux.Handler":                    "Handler returns the handler to use for the given request, consulting r.Method, r.Host, and r.URL.Path.",
+		"ServeMux.ServeHTTP":                  "ServeHTTP dispatches the request to the handler whose pattern most closely matches the request URL.",
+		"ServeTLS":                            "ServeTLS accepts incoming HTTPS connections on the listener l, creating a new service goroutine for each.",
+		"Server":                              "A Server defines parameters for running an HTTP server.",
+		"Server.Close":                        "Close immediately closes all active net.Listeners and any connections in state [StateNew], [StateActive], or [StateIdle].",
+		"Server.ListenAndServe":               "ListenAndServe listens on the TCP network address s.Addr and then calls [Serve] to handle requests on incoming connections.",
+		"Server.ListenAndServeTLS":            "ListenAndServeTLS listens on the TCP network address s.Addr and then calls [ServeTLS] to handle requests on incoming TLS connections.",
+		"Server.RegisterOnShutdown":           "RegisterOnShutdown registers a function to call on [Server.Shutdown].",
+		"Server.Serve":                        "Serve accepts incoming connections on the Listener l, creating a new service goroutine for each.",
+		"Server.ServeTLS":                     "ServeTLS accepts incoming connections on the Listener l, creating a new service goroutine for each.",
+		"Server.SetKeepAlivesEnabled":         "SetKeepAlivesEnabled controls whether HTTP keep-alives are enabled.",
+		"Server.Shutdown":                     "Shutdown gracefully shuts down the server without interrupting any active connections.",
+		"ServerContextKey":                    "ServerContextKey is a context key.",
+		"SetCookie":                           "SetCookie adds a Set-Cookie header to the provided [ResponseWriter]'s headers.",
+		"StateActive":                         "StateActive represents a connection that has read 1 or more bytes of a request.",
+		"StateClosed":                         "StateClosed represents a closed connection.",
+		"StateHijacked":                       "StateHijacked represents a hijacked connection.",
+		"StateIdle":                           "StateIdle represents a connection that has finished handling a request and is in the keep-alive state, waiting for a new request.",
+		"StateNew":                            "StateNew represents a new connection that is expected to send a request immediately.",
+		"StatusAccepted":                      "HTTP status codes as registered with IANA.",
+		"StatusAlreadyReported":               "HTTP status codes as registered with IANA.",
+		"StatusBadGateway":                    "HTTP status codes as registered with IANA.",
+		"StatusBadRequest":                    "HTTP status codes as registered with IANA.",
+		"StatusConflict":                      "HTTP status codes as registered with IANA.",
+		"StatusContinue":                      "HTTP status codes as registered with IANA.",
+		"StatusCreated":                       "HTTP status codes as registered with IANA.",
+		"StatusEarlyHints":                    "HTTP status codes as registered with IANA.",
+		"StatusExpectationFailed":             "HTTP status codes as registered with IANA.",
+		"StatusFailedDependency":              "HTTP status codes as registered with IANA.",
+		"StatusForbidden":                     "HTTP status codes as registered with IANA.",
+		"StatusFound":                         "HTTP status codes as registered with IANA.",
+		"StatusGatewayTimeout":                "HTTP status codes as registered with IANA.",
+		"StatusGone":                          "HTTP status codes as registered with IANA.",
+		"StatusHTTPVersionNotSupported":       "HTTP status codes as registered with IANA.",
+		"StatusIMUsed":                        "HTTP status codes as registered with IANA.",
+		"StatusInsufficientStorage":           "HTTP status codes as registered with IANA.",
+		"StatusInternalServerError":           "HTTP status codes as registered with IANA.",
+		"StatusLengthRequired":                "HTTP status codes as registered with IANA.",
+		"StatusLocked":                        "HTTP status codes as registered with IANA.",
+		"StatusLoopDetected":                  "HTTP status codes as registered with IANA.",
+		"StatusMethodNotAllowed":              "HTTP status codes as registered with IANA.",
+		"StatusMisdirectedRequest":            "HTTP status codes as registered with IANA.",
+		"StatusMovedPermanently":              "HTTP status codes as registered with IANA.",
+		"StatusMultiStatus":                   "HTTP status codes as registered with IANA.",
+		"StatusMultipleChoices":               "HTTP status codes as registered with IANA.",
+		"StatusNetworkAuthenticationRequired": "HTTP status codes as registered with IANA.",
+		"StatusNoContent":                     "HTTP status codes as registered with IANA.",
+		"StatusNonAuthoritativeInfo":          "HTTP status codes as registered with IANA.",
+		"StatusNotAcceptable":                 "HTTP status codes as registered with IANA.",
+		"StatusNotExtended":                   "HTTP status codes as registered with IANA.",
+		"StatusNotFound":                      "HTTP status codes as registered with IANA.",
+		"StatusNotImplemented":                "HTTP status codes as registered with IANA.",
+		"StatusNotModified":                   "HTTP status codes as registered with IANA.",
+		"StatusOK":                            "HTTP status codes as registered with IANA.",
+		"StatusPartialContent":                "HTTP status codes as registered with IANA.",
+		"StatusPaymentRequired":               "HTTP status codes as registered with IANA.",
+		"StatusPermanentRedirect":             "HTTP status codes as registered with IANA.",
+		"StatusPreconditionFailed":            "HTTP status codes as registered with IANA.",
+		"StatusPreconditionRequired":          "HTTP status codes as registered with IANA.",
+		"StatusProcessing":                    "HTTP status codes as registered with IANA.",
+		"StatusProxyAuthRequired":             "HTTP status codes as registered with IANA.",
+		"StatusRequestEntityTooLarge":         "HTTP status codes as registered with IANA.",
+		"StatusRequestHeaderFieldsTooLarge":   "HTTP status codes as registered with IANA.",
+		"StatusRequestTimeout":                "HTTP status codes as registered with IANA.",
+		"StatusRequestURITooLong":             "HTTP status codes as registered with IANA.",
+		"StatusRequestedRangeNotSatisfiable":  "HTTP status codes as registered with IANA.",
+		"StatusResetContent":                  "HTTP status codes as registered with IANA.",
+		"StatusSeeOther":                      "HTTP status codes as registered with IANA.",
+		"StatusServiceUnavailable":            "HTTP status codes as registered with IANA.",
+		"StatusSwitchingProtocols":            "HTTP status codes as registered with IANA.",
+		"StatusTeapot":                        "HTTP status codes as registered with IANA.",
+		"StatusTemporaryRedirect":             "HTTP status codes as registered with IANA.",
+		"StatusText":                          "StatusText returns a text for the HTTP status code.",
+		"StatusTooEarly":                      "HTTP status codes as registered with IANA.",
+		"StatusTooManyRequests":               "HTTP status codes as registered with IANA.",
+		"StatusUnauthorized":                  "HTTP status codes as registered with IANA.",
+		"StatusUnavailableForLegalReasons":    "HTTP status codes as registered with IANA.",
+		"StatusUnprocessableEntity":           "HTTP status codes as registered with IANA.",
+		"StatusUnsupportedMediaType":          "HTTP status codes as registered with IANA.",
+		"StatusUpgradeRequired":               "HTTP status codes as registered with IANA.",
+		"StatusUseProxy":                      "HTTP status codes as registered with IANA.",
+		"StatusVariantAlsoNegotiates":         "HTTP status codes as registered with IANA.",
+		"StripPrefix":                         "StripPrefix returns a handler that serves HTTP requests by removing the given prefix from the request URL's Path (and RawPath if set) and invoking the handler h.",
+		"TimeFormat":                          "TimeFormat is the time format to use when generating times in HTTP headers.",
+		"TimeoutHandler":                      "TimeoutHandler returns a [Handler] that runs h with the given time limit.",
+		"TrailerPrefix":                       "TrailerPrefix is a magic prefix for [ResponseWriter.Header] map keys that, if present, signals that the map entry is actually for the response trailers, and not the response headers.",
+		"Transport":                           "Transport is an implementation of [RoundTripper] that supports HTTP, HTTPS, and HTTP proxies (for either HTTP or HTTPS with CONNECT).",
+		"Transport.CancelRequest":             "CancelRequest cancels an in-flight request by closing its connection.",
+		"Transport.Clone":                     "Clone returns a deep copy of t's exported fields.",
+		"Transport.CloseIdleConnections":      "CloseIdleConnections closes any connections which were previously connected from previous requests but are now sitting idle in a \"keep-alive\" state.",
+		"Transport.RegisterProtocol":          "RegisterProtocol registers a new protocol with scheme.",
+		"Transport.RoundTrip":                 "RoundTrip implements the [RoundTripper] interface.",
 	}
 }
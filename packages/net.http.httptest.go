@@ -0,0 +1,50 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
+//go:build !appengine
+// +build !appengine
+
+package packages
+
+import (
+	"net/http/httptest"
+	"reflect"
+
+	"github.com/mattn/anko/env"
+)
+
+func init() {
+	env.Packages["net/http/httptest"] = map[string]reflect.Value{
+		"DefaultRemoteAddr":  reflect.ValueOf(httptest.DefaultRemoteAddr),
+		"NewRecorder":        reflect.ValueOf(httptest.NewRecorder),
+		"NewRequest":         reflect.ValueOf(httptest.NewRequest),
+		"NewServer":          reflect.ValueOf(httptest.NewServer),
+		"NewTLSServer":       reflect.ValueOf(httptest.NewTLSServer),
+		"NewUnstartedServer": reflect.ValueOf(httptest.NewUnstartedServer),
+	}
+	env.PackageTypes["net/http/httptest"] = map[string]reflect.Type{
+		"ResponseRecorder": reflect.TypeOf(httptest.ResponseRecorder{}),
+		"Server":           reflect.TypeOf(httptest.Server{}),
+	}
+	env.PackageDocs["net/http/httptest"] = map[string]string{
+		"DefaultRemoteAddr":             "DefaultRemoteAddr is the default remote address to return in RemoteAddr if an explicit DefaultRemoteAddr isn't set on [ResponseRecorder].",
+		"NewRecorder":                   "NewRecorder returns an initialized [ResponseRecorder].",
+		"NewRequest":                    "NewRequest wraps NewRequestWithContext using context.Background.",
+		"NewServer":                     "NewServer starts and returns a new [Server] listening on a local network loopback interface.",
+		"NewTLSServer":                  "NewTLSServer starts and returns a new [Server] using TLS and listening on a local network loopback interface.",
+		"NewUnstartedServer":            "NewUnstartedServer returns a new [Server] listening on a local network loopback interface.",
+		"ResponseRecorder":              "ResponseRecorder is an implementation of [http.ResponseWriter] that records its mutations for later inspection in tests.",
+		"ResponseRecorder.Flush":        "Flush implements [http.Flusher].",
+		"ResponseRecorder.Header":       "Header implements [http.ResponseWriter].",
+		"ResponseRecorder.Result":       "Result returns the response generated by the handler.",
+		"ResponseRecorder.Write":        "Write implements http.ResponseWriter.",
+		"ResponseRecorder.WriteHeader":  "WriteHeader implements [http.ResponseWriter].",
+		"ResponseRecorder.WriteString":  "WriteString implements io.StringWriter.",
+		"Server":                        "A Server is an HTTP server for use in end-to-end HTTP tests.",
+		"Server.Certificate":            "Certificate returns the certificate used by the server, or nil if the server doesn't use TLS.",
+		"Server.Client":                 "Client returns an HTTP client configured for making requests to the server.",
+		"Server.Close":                  "Close shuts down the server and blocks until all outstanding requests on this server have completed.",
+		"Server.CloseClientConnections": "CloseClientConnections closes any open HTTP connections to the test Server.",
+		"Server.Start":                  "Start starts a server on a local loopback network interface.",
+		"Server.StartTLS":               "Start starts TLS on a server on a local loopback network interface.",
+	}
+}
@@ -1,3 +1,6 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
+//go:build !appengine
 // +build !appengine
 
 package packages
@@ -11,17 +14,56 @@ import (
 
 func init() {
 	env.Packages["net/url"] = map[string]reflect.Value{
-		"QueryEscape":     reflect.ValueOf(url.QueryEscape),
-		"QueryUnescape":   reflect.ValueOf(url.QueryUnescape),
 		"Parse":           reflect.ValueOf(url.Parse),
+		"ParseQuery":      reflect.ValueOf(url.ParseQuery),
 		"ParseRequestURI": reflect.ValueOf(url.ParseRequestURI),
+		"PathEscape":      reflect.ValueOf(url.PathEscape),
+		"PathUnescape":    reflect.ValueOf(url.PathUnescape),
+		"QueryEscape":     reflect.ValueOf(url.QueryEscape),
+		"QueryUnescape":   reflect.ValueOf(url.QueryUnescape),
 		"User":            reflect.ValueOf(url.User),
 		"UserPassword":    reflect.ValueOf(url.UserPassword),
-		"ParseQuery":      reflect.ValueOf(url.ParseQuery),
 	}
 	env.PackageTypes["net/url"] = map[string]reflect.Type{
-		"Error":  reflect.TypeOf(url.Error{}),
-		"URL":    reflect.TypeOf(url.URL{}),
-		"Values": reflect.TypeOf(url.Values{}),
+		"Error":            reflect.TypeOf(url.Error{}),
+		"EscapeError":      reflect.TypeOf((*url.EscapeError)(nil)).Elem(),
+		"InvalidHostError": reflect.TypeOf((*url.InvalidHostError)(nil)).Elem(),
+		"URL":              reflect.TypeOf(url.URL{}),
+		"Userinfo":         reflect.TypeOf(url.Userinfo{}),
+		"Values":           reflect.TypeOf((*url.Values)(nil)).Elem(),
+	}
+	env.PackageDocs["net/url"] = map[string]string{
+		"Error":                "Error reports an error and the operation and URL that caused it.",
+		"Parse":                "Parse parses a raw url into a [URL] structure.",
+		"ParseQuery":           "ParseQuery parses the URL-encoded query string and returns a map listing the values specified for each key.",
+		"ParseRequestURI":      "ParseRequestURI parses a raw url into a [URL] structure.",
+		"PathEscape":           "PathEscape escapes the string so it can be safely placed inside a [URL] path segment, replacing special characters (including /) with %XX sequences as needed.",
+		"PathUnescape":         "PathUnescape does the inverse transformation of [PathEscape], converting each 3-byte encoded substring of the form \"%AB\" into the hex-decoded byte 0xAB.",
+		"QueryEscape":          "QueryEscape escapes the string so it can be safely placed inside a [URL] query.",
+		"QueryUnescape":        "QueryUnescape does the inverse transformation of [QueryEscape], converting each 3-byte encoded substring of the form \"%AB\" into the hex-decoded byte 0xAB.",
+		"URL":                  "A URL represents a parsed URL (technically, a URI reference).",
+		"URL.EscapedFragment":  "EscapedFragment returns the escaped form of u.Fragment.",
+		"URL.EscapedPath":      "EscapedPath returns the escaped form of u.Path.",
+		"URL.Hostname":         "Hostname returns u.Host, stripping any valid port number if present.",
+		"URL.IsAbs":            "IsAbs reports whether the [URL] is absolute.",
+		"URL.Parse":            "Parse parses a [URL] in the context of the receiver.",
+		"URL.Port":             "Port returns the port part of u.Host, without the leading colon.",
+		"URL.Query":            "Query parses RawQuery and returns the corresponding values.",
+		"URL.Redacted":         "Redacted is like [URL.String] but replaces any password with \"xxxxx\".",
+		"URL.RequestURI":       "RequestURI returns the encoded path?query or opaque?query string that would be used in an HTTP request for u.",
+		"URL.ResolveReference": "ResolveReference resolves a URI reference to an absolute URI from an absolute base URI u, per RFC 3986 Section 5.2.",
+		"URL.String":           "String reassembles the [URL] into a valid URL string.",
+		"User":                 "User returns a [Userinfo] containing the provided username and no password set.",
+		"UserPassword":         "UserPassword returns a [Userinfo] containing the provided username and password.",
+		"Userinfo":             "The Userinfo type is an immutable encapsulation of username and password details for a [URL].",
+		"Userinfo.Password":    "Password returns the password in case it is set, and whether it is set.",
+		"Userinfo.String":      "String returns the encoded userinfo information in the standard form of \"username[:password]\".",
+		"Userinfo.Username":    "Username returns the username.",
+		"Values":               "Values maps a string key to a list of values.",
+		"Values.Add":           "Add adds the value to key.",
+		"Values.Del":           "Del deletes the values associated with key.",
+		"Values.Encode":        "Encode encodes the values into “URL encoded” form (\"bar=baz&foo=quux\") sorted by key.",
+		"Values.Get":           "Get gets the first value associated with the given key.",
+		"Values.Set":           "Set sets the key to value.",
 	}
 }
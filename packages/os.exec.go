@@ -1,3 +1,5 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
 package packages
 
 import (
@@ -9,8 +11,32 @@ import (
 
 func init() {
 	env.Packages["os/exec"] = map[string]reflect.Value{
-		"ErrNotFound": reflect.ValueOf(exec.ErrNotFound),
-		"LookPath":    reflect.ValueOf(exec.LookPath),
-		"Command":     reflect.ValueOf(exec.Command),
+		"Command":        reflect.ValueOf(exec.Command),
+		"CommandContext": reflect.ValueOf(exec.CommandContext),
+		"ErrNotFound":    reflect.ValueOf(exec.ErrNotFound),
+		"LookPath":       reflect.ValueOf(exec.LookPath),
+	}
+	env.PackageTypes["os/exec"] = map[string]reflect.Type{
+		"Cmd":       reflect.TypeOf(exec.Cmd{}),
+		"Error":     reflect.TypeOf(exec.Error{}),
+		"ExitError": reflect.TypeOf(exec.ExitError{}),
+	}
+	env.PackageDocs["os/exec"] = map[string]string{
+		"Cmd":                "Cmd represents an external command being prepared or run.",
+		"Cmd.CombinedOutput": "CombinedOutput runs the command and returns its combined standard output and standard error.",
+		"Cmd.Output":         "Output runs the command and returns its standard output.",
+		"Cmd.Run":            "Run starts the specified command and waits for it to complete.",
+		"Cmd.Start":          "Start starts the specified command but does not wait for it to complete.",
+		"Cmd.StderrPipe":     "StderrPipe returns a pipe that will be connected to the command's standard error when the command starts.",
+		"Cmd.StdinPipe":      "StdinPipe returns a pipe that will be connected to the command's standard input when the command starts.",
+		"Cmd.StdoutPipe":     "StdoutPipe returns a pipe that will be connected to the command's standard output when the command starts.",
+		"Cmd.String":         "String returns a human-readable description of c.",
+		"Cmd.Wait":           "Wait waits for the command to exit and waits for any copying to stdin or copying from stdout or stderr to complete.",
+		"Command":            "Command returns the [Cmd] struct to execute the named program with the given arguments.",
+		"CommandContext":     "CommandContext is like [Command] but includes a context.",
+		"ErrNotFound":        "ErrNotFound is the error resulting if a path search failed to find an executable file.",
+		"Error":              "Error is returned by [LookPath] when it fails to classify a file as an executable.",
+		"ExitError":          "An ExitError reports an unsuccessful exit by a command.",
+		"LookPath":           "LookPath searches for an executable named file in the current path, following the conventions of the host operating system.",
 	}
 }
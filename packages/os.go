@@ -1,3 +1,5 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
 package packages
 
 import (
@@ -9,94 +11,271 @@ import (
 
 func init() {
 	env.Packages["os"] = map[string]reflect.Value{
-		"Args":              reflect.ValueOf(os.Args),
-		"Chdir":             reflect.ValueOf(os.Chdir),
-		"Chmod":             reflect.ValueOf(os.Chmod),
-		"Chown":             reflect.ValueOf(os.Chown),
-		"Chtimes":           reflect.ValueOf(os.Chtimes),
-		"Clearenv":          reflect.ValueOf(os.Clearenv),
-		"Create":            reflect.ValueOf(os.Create),
-		"DevNull":           reflect.ValueOf(os.DevNull),
-		"Environ":           reflect.ValueOf(os.Environ),
-		"ErrExist":          reflect.ValueOf(os.ErrExist),
-		"ErrInvalid":        reflect.ValueOf(os.ErrInvalid),
-		"ErrNotExist":       reflect.ValueOf(os.ErrNotExist),
-		"ErrPermission":     reflect.ValueOf(os.ErrPermission),
-		"Exit":              reflect.ValueOf(os.Exit),
-		"Expand":            reflect.ValueOf(os.Expand),
-		"ExpandEnv":         reflect.ValueOf(os.ExpandEnv),
-		"FindProcess":       reflect.ValueOf(os.FindProcess),
-		"Getegid":           reflect.ValueOf(os.Getegid),
-		"Getenv":            reflect.ValueOf(os.Getenv),
-		"Geteuid":           reflect.ValueOf(os.Geteuid),
-		"Getgid":            reflect.ValueOf(os.Getgid),
-		"Getgroups":         reflect.ValueOf(os.Getgroups),
-		"Getpagesize":       reflect.ValueOf(os.Getpagesize),
-		"Getpid":            reflect.ValueOf(os.Getpid),
-		"Getuid":            reflect.ValueOf(os.Getuid),
-		"Getwd":             reflect.ValueOf(os.Getwd),
-		"Hostname":          reflect.ValueOf(os.Hostname),
-		"Interrupt":         reflect.ValueOf(os.Interrupt),
-		"IsExist":           reflect.ValueOf(os.IsExist),
-		"IsNotExist":        reflect.ValueOf(os.IsNotExist),
-		"IsPathSeparator":   reflect.ValueOf(os.IsPathSeparator),
-		"IsPermission":      reflect.ValueOf(os.IsPermission),
-		"Kill":              reflect.ValueOf(os.Kill),
-		"Lchown":            reflect.ValueOf(os.Lchown),
-		"Link":              reflect.ValueOf(os.Link),
-		"Lstat":             reflect.ValueOf(os.Lstat),
-		"Mkdir":             reflect.ValueOf(os.Mkdir),
-		"MkdirAll":          reflect.ValueOf(os.MkdirAll),
-		"ModeAppend":        reflect.ValueOf(os.ModeAppend),
-		"ModeCharDevice":    reflect.ValueOf(os.ModeCharDevice),
-		"ModeDevice":        reflect.ValueOf(os.ModeDevice),
-		"ModeDir":           reflect.ValueOf(os.ModeDir),
-		"ModeExclusive":     reflect.ValueOf(os.ModeExclusive),
-		"ModeNamedPipe":     reflect.ValueOf(os.ModeNamedPipe),
-		"ModePerm":          reflect.ValueOf(os.ModePerm),
-		"ModeSetgid":        reflect.ValueOf(os.ModeSetgid),
-		"ModeSetuid":        reflect.ValueOf(os.ModeSetuid),
-		"ModeSocket":        reflect.ValueOf(os.ModeSocket),
-		"ModeSticky":        reflect.ValueOf(os.ModeSticky),
-		"ModeSymlink":       reflect.ValueOf(os.ModeSymlink),
-		"ModeTemporary":     reflect.ValueOf(os.ModeTemporary),
-		"ModeType":          reflect.ValueOf(os.ModeType),
-		"NewFile":           reflect.ValueOf(os.NewFile),
-		"NewSyscallError":   reflect.ValueOf(os.NewSyscallError),
-		"O_APPEND":          reflect.ValueOf(os.O_APPEND),
-		"O_CREATE":          reflect.ValueOf(os.O_CREATE),
-		"O_EXCL":            reflect.ValueOf(os.O_EXCL),
-		"O_RDONLY":          reflect.ValueOf(os.O_RDONLY),
-		"O_RDWR":            reflect.ValueOf(os.O_RDWR),
-		"O_SYNC":            reflect.ValueOf(os.O_SYNC),
-		"O_TRUNC":           reflect.ValueOf(os.O_TRUNC),
-		"O_WRONLY":          reflect.ValueOf(os.O_WRONLY),
-		"Open":              reflect.ValueOf(os.Open),
-		"OpenFile":          reflect.ValueOf(os.OpenFile),
-		"PathListSeparator": reflect.ValueOf(os.PathListSeparator),
-		"PathSeparator":     reflect.ValueOf(os.PathSeparator),
-		"Pipe":              reflect.ValueOf(os.Pipe),
-		"Readlink":          reflect.ValueOf(os.Readlink),
-		"Remove":            reflect.ValueOf(os.Remove),
-		"RemoveAll":         reflect.ValueOf(os.RemoveAll),
-		"Rename":            reflect.ValueOf(os.Rename),
-		"SEEK_CUR":          reflect.ValueOf(os.SEEK_CUR),
-		"SEEK_END":          reflect.ValueOf(os.SEEK_END),
-		"SEEK_SET":          reflect.ValueOf(os.SEEK_SET),
-		"SameFile":          reflect.ValueOf(os.SameFile),
-		"Setenv":            reflect.ValueOf(os.Setenv),
-		"StartProcess":      reflect.ValueOf(os.StartProcess),
-		"Stat":              reflect.ValueOf(os.Stat),
-		"Stderr":            reflect.ValueOf(os.Stderr),
-		"Stdin":             reflect.ValueOf(os.Stdin),
-		"Stdout":            reflect.ValueOf(os.Stdout),
-		"Symlink":           reflect.ValueOf(os.Symlink),
-		"TempDir":           reflect.ValueOf(os.TempDir),
-		"Truncate":          reflect.ValueOf(os.Truncate),
+		"Args":                reflect.ValueOf(os.Args),
+		"Chdir":               reflect.ValueOf(os.Chdir),
+		"Chmod":               reflect.ValueOf(os.Chmod),
+		"Chown":               reflect.ValueOf(os.Chown),
+		"Chtimes":             reflect.ValueOf(os.Chtimes),
+		"Clearenv":            reflect.ValueOf(os.Clearenv),
+		"Create":              reflect.ValueOf(os.Create),
+		"CreateTemp":          reflect.ValueOf(os.CreateTemp),
+		"DevNull":             reflect.ValueOf(os.DevNull),
+		"DirFS":               reflect.ValueOf(os.DirFS),
+		"Environ":             reflect.ValueOf(os.Environ),
+		"ErrClosed":           reflect.ValueOf(os.ErrClosed),
+		"ErrDeadlineExceeded": reflect.ValueOf(os.ErrDeadlineExceeded),
+		"ErrExist":            reflect.ValueOf(os.ErrExist),
+		"ErrInvalid":          reflect.ValueOf(os.ErrInvalid),
+		"ErrNoDeadline":       reflect.ValueOf(os.ErrNoDeadline),
+		"ErrNotExist":         reflect.ValueOf(os.ErrNotExist),
+		"ErrPermission":       reflect.ValueOf(os.ErrPermission),
+		"ErrProcessDone":      reflect.ValueOf(os.ErrProcessDone),
+		"Executable":          reflect.ValueOf(os.Executable),
+		"Exit":                reflect.ValueOf(os.Exit),
+		"Expand":              reflect.ValueOf(os.Expand),
+		"ExpandEnv":           reflect.ValueOf(os.ExpandEnv),
+		"FindProcess":         reflect.ValueOf(os.FindProcess),
+		"Getegid":             reflect.ValueOf(os.Getegid),
+		"Getenv":              reflect.ValueOf(os.Getenv),
+		"Geteuid":             reflect.ValueOf(os.Geteuid),
+		"Getgid":              reflect.ValueOf(os.Getgid),
+		"Getgroups":           reflect.ValueOf(os.Getgroups),
+		"Getpagesize":         reflect.ValueOf(os.Getpagesize),
+		"Getpid":              reflect.ValueOf(os.Getpid),
+		"Getuid":              reflect.ValueOf(os.Getuid),
+		"Getwd":               reflect.ValueOf(os.Getwd),
+		"Hostname":            reflect.ValueOf(os.Hostname),
+		"Interrupt":           reflect.ValueOf(os.Interrupt),
+		"IsExist":             reflect.ValueOf(os.IsExist),
+		"IsNotExist":          reflect.ValueOf(os.IsNotExist),
+		"IsPathSeparator":     reflect.ValueOf(os.IsPathSeparator),
+		"IsPermission":        reflect.ValueOf(os.IsPermission),
+		"IsTimeout":           reflect.ValueOf(os.IsTimeout),
+		"Kill":                reflect.ValueOf(os.Kill),
+		"Lchown":              reflect.ValueOf(os.Lchown),
+		"Link":                reflect.ValueOf(os.Link),
+		"LookupEnv":           reflect.ValueOf(os.LookupEnv),
+		"Lstat":               reflect.ValueOf(os.Lstat),
+		"Mkdir":               reflect.ValueOf(os.Mkdir),
+		"MkdirAll":            reflect.ValueOf(os.MkdirAll),
+		"MkdirTemp":           reflect.ValueOf(os.MkdirTemp),
+		"ModeAppend":          reflect.ValueOf(os.ModeAppend),
+		"ModeCharDevice":      reflect.ValueOf(os.ModeCharDevice),
+		"ModeDevice":          reflect.ValueOf(os.ModeDevice),
+		"ModeDir":             reflect.ValueOf(os.ModeDir),
+		"ModeExclusive":       reflect.ValueOf(os.ModeExclusive),
+		"ModeIrregular":       reflect.ValueOf(os.ModeIrregular),
+		"ModeNamedPipe":       reflect.ValueOf(os.ModeNamedPipe),
+		"ModePerm":            reflect.ValueOf(os.ModePerm),
+		"ModeSetgid":          reflect.ValueOf(os.ModeSetgid),
+		"ModeSetuid":          reflect.ValueOf(os.ModeSetuid),
+		"ModeSocket":          reflect.ValueOf(os.ModeSocket),
+		"ModeSticky":          reflect.ValueOf(os.ModeSticky),
+		"ModeSymlink":         reflect.ValueOf(os.ModeSymlink),
+		"ModeTemporary":       reflect.ValueOf(os.ModeTemporary),
+		"ModeType":            reflect.ValueOf(os.ModeType),
+		"NewFile":             reflect.ValueOf(os.NewFile),
+		"NewSyscallError":     reflect.ValueOf(os.NewSyscallError),
+		"O_APPEND":            reflect.ValueOf(os.O_APPEND),
+		"O_CREATE":            reflect.ValueOf(os.O_CREATE),
+		"O_EXCL":              reflect.ValueOf(os.O_EXCL),
+		"O_RDONLY":            reflect.ValueOf(os.O_RDONLY),
+		"O_RDWR":              reflect.ValueOf(os.O_RDWR),
+		"O_SYNC":              reflect.ValueOf(os.O_SYNC),
+		"O_TRUNC":             reflect.ValueOf(os.O_TRUNC),
+		"O_WRONLY":            reflect.ValueOf(os.O_WRONLY),
+		"Open":                reflect.ValueOf(os.Open),
+		"OpenFile":            reflect.ValueOf(os.OpenFile),
+		"PathListSeparator":   reflect.ValueOf(os.PathListSeparator),
+		"PathSeparator":       reflect.ValueOf(os.PathSeparator),
+		"Pipe":                reflect.ValueOf(os.Pipe),
+		"ReadDir":             reflect.ValueOf(os.ReadDir),
+		"ReadFile":            reflect.ValueOf(os.ReadFile),
+		"Readlink":            reflect.ValueOf(os.Readlink),
+		"Remove":              reflect.ValueOf(os.Remove),
+		"RemoveAll":           reflect.ValueOf(os.RemoveAll),
+		"Rename":              reflect.ValueOf(os.Rename),
+		"SEEK_CUR":            reflect.ValueOf(os.SEEK_CUR),
+		"SEEK_END":            reflect.ValueOf(os.SEEK_END),
+		"SEEK_SET":            reflect.ValueOf(os.SEEK_SET),
+		"SameFile":            reflect.ValueOf(os.SameFile),
+		"Setenv":              reflect.ValueOf(os.Setenv),
+		"StartProcess":        reflect.ValueOf(os.StartProcess),
+		"Stat":                reflect.ValueOf(os.Stat),
+		"Stderr":              reflect.ValueOf(os.Stderr),
+		"Stdin":               reflect.ValueOf(os.Stdin),
+		"Stdout":              reflect.ValueOf(os.Stdout),
+		"Symlink":             reflect.ValueOf(os.Symlink),
+		"TempDir":             reflect.ValueOf(os.TempDir),
+		"Truncate":            reflect.ValueOf(os.Truncate),
+		"Unsetenv":            reflect.ValueOf(os.Unsetenv),
+		"UserCacheDir":        reflect.ValueOf(os.UserCacheDir),
+		"UserConfigDir":       reflect.ValueOf(os.UserConfigDir),
+		"UserHomeDir":         reflect.ValueOf(os.UserHomeDir),
+		"WriteFile":           reflect.ValueOf(os.WriteFile),
 	}
-	var signal os.Signal
 	env.PackageTypes["os"] = map[string]reflect.Type{
-		"Signal": reflect.TypeOf(&signal).Elem(),
+		"DirEntry":     reflect.TypeOf((*os.DirEntry)(nil)).Elem(),
+		"File":         reflect.TypeOf(os.File{}),
+		"FileInfo":     reflect.TypeOf((*os.FileInfo)(nil)).Elem(),
+		"FileMode":     reflect.TypeOf((*os.FileMode)(nil)).Elem(),
+		"LinkError":    reflect.TypeOf(os.LinkError{}),
+		"PathError":    reflect.TypeOf(os.PathError{}),
+		"ProcAttr":     reflect.TypeOf(os.ProcAttr{}),
+		"Process":      reflect.TypeOf(os.Process{}),
+		"ProcessState": reflect.TypeOf(os.ProcessState{}),
+		"Signal":       reflect.TypeOf((*os.Signal)(nil)).Elem(),
+		"SyscallError": reflect.TypeOf(os.SyscallError{}),
+	}
+	env.PackageDocs["os"] = map[string]string{
+		"Args":                    "Args hold the command-line arguments, starting with the program name.",
+		"Chdir":                   "Chdir changes the current working directory to the named directory.",
+		"Chmod":                   "Chmod changes the mode of the named file to mode.",
+		"Chown":                   "Chown changes the numeric uid and gid of the named file.",
+		"Chtimes":                 "Chtimes changes the access and modification times of the named file, similar to the Unix utime() or utimes() functions.",
+		"Clearenv":                "Clearenv deletes all environment variables.",
+		"Create":                  "Create creates or truncates the named file.",
+		"CreateTemp":              "CreateTemp creates a new temporary file in the directory dir, opens the file for reading and writing, and returns the resulting file.",
+		"DevNull":                 "DevNull is the name of the operating system's “null device.” On Unix-like systems, it is \"/dev/null\"; on Windows, \"NUL\".",
+		"DirEntry":                "A DirEntry is an entry read from a directory (using the [ReadDir] function or a [File.ReadDir] method).",
+		"DirFS":                   "DirFS returns a file system (an fs.FS) for the tree of files rooted at the directory dir.",
+		"Environ":                 "Environ returns a copy of strings representing the environment, in the form \"key=value\".",
+		"ErrClosed":               "Portable analogs of some common system call errors.",
+		"ErrDeadlineExceeded":     "Portable analogs of some common system call errors.",
+		"ErrExist":                "Portable analogs of some common system call errors.",
+		"ErrInvalid":              "ErrInvalid indicates an invalid argument.",
+		"ErrNoDeadline":           "Portable analogs of some common system call errors.",
+		"ErrNotExist":             "Portable analogs of some common system call errors.",
+		"ErrPermission":           "Portable analogs of some common system call errors.",
+		"ErrProcessDone":          "ErrProcessDone indicates a [Process] has finished.",
+		"Executable":              "Executable returns the path name for the executable that started the current process.",
+		"Exit":                    "Exit causes the current program to exit with the given status code.",
+		"Expand":                  "Expand replaces ${var} or $var in the string based on the mapping function.",
+		"ExpandEnv":               "ExpandEnv replaces ${var} or $var in the string according to the values of the current environment variables.",
+		"File":                    "File represents an open file descriptor.",
+		"File.Chdir":              "Chdir changes the current working directory to the file, which must be a directory.",
+		"File.Chmod":              "Chmod changes the mode of the file to mode.",
+		"File.Chown":              "Chown changes the numeric uid and gid of the named file.",
+		"File.Close":              "Close closes the [File], rendering it unusable for I/O. On files that support [File.SetDeadline], any pending I/O operations will be canceled and return immediately with an [ErrClosed] error.",
+		"File.Fd":                 "Fd returns the system file descriptor or handle referencing the open file.",
+		"File.Name":               "Name returns the name of the file as presented to Open.",
+		"File.Read":               "Read reads up to len(b) bytes from the File and stores them in b.",
+		"File.ReadAt":             "ReadAt reads len(b) bytes from the File starting at byte offset off.",
+		"File.ReadDir":            "ReadDir reads the contents of the directory associated with the file f and returns a slice of [DirEntry] values in directory order.",
+		"File.ReadFrom":           "ReadFrom implements io.ReaderFrom.",
+		"File.Readdir":            "Readdir reads the contents of the directory associated with file and returns a slice of up to n [FileInfo] values, as would be returned by [Lstat], in directory order.",
+		"File.Readdirnames":       "Readdirnames reads the contents of the directory associated with file and returns a slice of up to n names of files in the directory, in directory order.",
+		"File.Seek":               "Seek sets the offset for the next Read or Write on file to offset, interpreted according to whence: 0 means relative to the origin of the file, 1 means relative to the current offset, and 2 means relative to the end.",
+		"File.SetDeadline":        "SetDeadline sets the read and write deadlines for a File.",
+		"File.SetReadDeadline":    "SetReadDeadline sets the deadline for future Read calls and any currently-blocked Read call.",
+		"File.SetWriteDeadline":   "SetWriteDeadline sets the deadline for any future Write calls and any currently-blocked Write call.",
+		"File.Stat":               "Stat returns the [FileInfo] structure describing file.",
+		"File.Sync":               "Sync commits the current contents of the file to stable storage.",
+		"File.SyscallConn":        "SyscallConn returns a raw file.",
+		"File.Truncate":           "Truncate changes the size of the file.",
+		"File.Write":              "Write writes len(b) bytes from b to the File.",
+		"File.WriteAt":            "WriteAt writes len(b) bytes to the File starting at byte offset off.",
+		"File.WriteString":        "WriteString is like Write, but writes the contents of string s rather than a slice of bytes.",
+		"FileInfo":                "A FileInfo describes a file and is returned by [Stat] and [Lstat].",
+		"FileMode":                "A FileMode represents a file's mode and permission bits.",
+		"FindProcess":             "FindProcess looks for a running process by its pid.",
+		"Getegid":                 "Getegid returns the numeric effective group id of the caller.",
+		"Getenv":                  "Getenv retrieves the value of the environment variable named by the key.",
+		"Geteuid":                 "Geteuid returns the numeric effective user id of the caller.",
+		"Getgid":                  "Getgid returns the numeric group id of the caller.",
+		"Getgroups":               "Getgroups returns a list of the numeric ids of groups that the caller belongs to.",
+		"Getpagesize":             "Getpagesize returns the underlying system's memory page size.",
+		"Getpid":                  "Getpid returns the process id of the caller.",
+		"Getuid":                  "Getuid returns the numeric user id of the caller.",
+		"Getwd":                   "Getwd returns an absolute path name corresponding to the current directory.",
+		"Hostname":                "Hostname returns the host name reported by the kernel.",
+		"Interrupt":               "The only signal values guaranteed to be present in the os package on all systems are os.Interrupt (send the process an interrupt) and os.Kill (force the process to exit).",
+		"IsExist":                 "IsExist returns a boolean indicating whether its argument is known to report that a file or directory already exists.",
+		"IsNotExist":              "IsNotExist returns a boolean indicating whether its argument is known to report that a file or directory does not exist.",
+		"IsPathSeparator":         "IsPathSeparator reports whether c is a directory separator character.",
+		"IsPermission":            "IsPermission returns a boolean indicating whether its argument is known to report that permission is denied.",
+		"IsTimeout":               "IsTimeout returns a boolean indicating whether its argument is known to report that a timeout occurred.",
+		"Kill":                    "The only signal values guaranteed to be present in the os package on all systems are os.Interrupt (send the process an interrupt) and os.Kill (force the process to exit).",
+		"Lchown":                  "Lchown changes the numeric uid and gid of the named file.",
+		"Link":                    "Link creates newname as a hard link to the oldname file.",
+		"LinkError":               "LinkError records an error during a link or symlink or rename system call and the paths that caused it.",
+		"LookupEnv":               "LookupEnv retrieves the value of the environment variable named by the key.",
+		"Lstat":                   "Lstat returns a [FileInfo] describing the named file.",
+		"Mkdir":                   "Mkdir creates a new directory with the specified name and permission bits (before umask).",
+		"MkdirAll":                "MkdirAll creates a directory named path, along with any necessary parents, and returns nil, or else returns an error.",
+		"MkdirTemp":               "MkdirTemp creates a new temporary directory in the directory dir and returns the pathname of the new directory.",
+		"ModeAppend":              "The defined file mode bits are the most significant bits of the [FileMode].",
+		"ModeCharDevice":          "The defined file mode bits are the most significant bits of the [FileMode].",
+		"ModeDevice":              "The defined file mode bits are the most significant bits of the [FileMode].",
+		"ModeDir":                 "The single letters are the abbreviations used by the String method's formatting.",
+		"ModeExclusive":           "The defined file mode bits are the most significant bits of the [FileMode].",
+		"ModeIrregular":           "The defined file mode bits are the most significant bits of the [FileMode].",
+		"ModeNamedPipe":           "The defined file mode bits are the most significant bits of the [FileMode].",
+		"ModePerm":                "The defined file mode bits are the most significant bits of the [FileMode].",
+		"ModeSetgid":              "The defined file mode bits are the most significant bits of the [FileMode].",
+		"ModeSetuid":              "The defined file mode bits are the most significant bits of the [FileMode].",
+		"ModeSocket":              "The defined file mode bits are the most significant bits of the [FileMode].",
+		"ModeSticky":              "The defined file mode bits are the most significant bits of the [FileMode].",
+		"ModeSymlink":             "The defined file mode bits are the most significant bits of the [FileMode].",
+		"ModeTemporary":           "The defined file mode bits are the most significant bits of the [FileMode].",
+		"ModeType":                "Mask for the type bits.",
+		"NewFile":                 "NewFile returns a new [File] with the given file descriptor and name.",
+		"NewSyscallError":         "NewSyscallError returns, as an error, a new [SyscallError] with the given system call name and error details.",
+		"O_APPEND":                "The remaining values may be or'ed in to control behavior.",
+		"O_CREATE":                "Flags to OpenFile wrapping those of the underlying system.",
+		"O_EXCL":                  "Flags to OpenFile wrapping those of the underlying system.",
+		"O_RDONLY":                "Exactly one of O_RDONLY, O_WRONLY, or O_RDWR must be specified.",
+		"O_RDWR":                  "Flags to OpenFile wrapping those of the underlying system.",
+		"O_SYNC":                  "Flags to OpenFile wrapping those of the underlying system.",
+		"O_TRUNC":                 "Flags to OpenFile wrapping those of the underlying system.",
+		"O_WRONLY":                "Flags to OpenFile wrapping those of the underlying system.",
+		"Open":                    "Open opens the named file for reading.",
+		"OpenFile":                "OpenFile is the generalized open call; most users will use Open or Create instead.",
+		"PathError":               "PathError records an error and the operation and file path that caused it.",
+		"PathListSeparator":       "OS-specific path list separator",
+		"PathSeparator":           "OS-specific path separator",
+		"Pipe":                    "Pipe returns a connected pair of Files; reads from r return bytes written to w.",
+		"ProcAttr":                "ProcAttr holds the attributes that will be applied to a new process started by StartProcess.",
+		"Process":                 "Process stores the information about a process created by [StartProcess].",
+		"Process.Kill":            "Kill causes the [Process] to exit immediately.",
+		"Process.Release":         "Release releases any resources associated with the [Process] p, rendering it unusable in the future.",
+		"Process.Signal":          "Signal sends a signal to the [Process].",
+		"Process.Wait":            "Wait waits for the [Process] to exit, and then returns a ProcessState describing its status and an error, if any.",
+		"ProcessState":            "ProcessState stores information about a process, as reported by Wait.",
+		"ProcessState.ExitCode":   "ExitCode returns the exit code of the exited process, or -1 if the process hasn't exited or was terminated by a signal.",
+		"ProcessState.Exited":     "Exited reports whether the program has exited.",
+		"ProcessState.Pid":        "Pid returns the process id of the exited process.",
+		"ProcessState.Success":    "Success reports whether the program exited successfully, such as with exit status 0 on Unix.",
+		"ProcessState.Sys":        "Sys returns system-dependent exit information about the process.",
+		"ProcessState.SysUsage":   "SysUsage returns system-dependent resource usage information about the exited process.",
+		"ProcessState.SystemTime": "SystemTime returns the system CPU time of the exited process and its children.",
+		"ProcessState.UserTime":   "UserTime returns the user CPU time of the exited process and its children.",
+		"ReadDir":                 "ReadDir reads the named directory, returning all its directory entries sorted by filename.",
+		"ReadFile":                "ReadFile reads the named file and returns the contents.",
+		"Readlink":                "Readlink returns the destination of the named symbolic link.",
+		"Remove":                  "Remove removes the named file or (empty) directory.",
+		"RemoveAll":               "RemoveAll removes path and any children it contains.",
+		"Rename":                  "Rename renames (moves) oldpath to newpath.",
+		"SEEK_CUR":                "Seek whence values.",
+		"SEEK_END":                "Seek whence values.",
+		"SEEK_SET":                "Seek whence values.",
+		"SameFile":                "SameFile reports whether fi1 and fi2 describe the same file.",
+		"Setenv":                  "Setenv sets the value of the environment variable named by the key.",
+		"Signal":                  "A Signal represents an operating system signal.",
+		"StartProcess":            "StartProcess starts a new process with the program, arguments and attributes specified by name, argv and attr.",
+		"Stat":                    "Stat returns a [FileInfo] describing the named file.",
+		"Stderr":                  "Stdin, Stdout, and Stderr are open Files pointing to the standard input, standard output, and standard error file descriptors.",
+		"Stdin":                   "Stdin, Stdout, and Stderr are open Files pointing to the standard input, standard output, and standard error file descriptors.",
+		"Stdout":                  "Stdin, Stdout, and Stderr are open Files pointing to the standard input, standard output, and standard error file descriptors.",
+		"Symlink":                 "Symlink creates newname as a symbolic link to oldname.",
+		"SyscallError":            "SyscallError records an error from a specific system call.",
+		"SyscallError.Timeout":    "Timeout reports whether this error represents a timeout.",
+		"TempDir":                 "TempDir returns the default directory to use for temporary files.",
+		"Truncate":                "Truncate changes the size of the named file.",
+		"Unsetenv":                "Unsetenv unsets a single environment variable.",
+		"UserCacheDir":            "UserCacheDir returns the default root directory to use for user-specific cached data.",
+		"UserConfigDir":           "UserConfigDir returns the default root directory to use for user-specific configuration data.",
+		"UserHomeDir":             "UserHomeDir returns the current user's home directory.",
+		"WriteFile":               "WriteFile writes data to the named file, creating it if necessary.",
 	}
-	osNotAppEngine()
 }
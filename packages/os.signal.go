@@ -1,3 +1,5 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
 package packages
 
 import (
@@ -9,7 +11,19 @@ import (
 
 func init() {
 	env.Packages["os/signal"] = map[string]reflect.Value{
-		"Notify": reflect.ValueOf(signal.Notify),
-		"Stop":   reflect.ValueOf(signal.Stop),
+		"Ignore":        reflect.ValueOf(signal.Ignore),
+		"Ignored":       reflect.ValueOf(signal.Ignored),
+		"Notify":        reflect.ValueOf(signal.Notify),
+		"NotifyContext": reflect.ValueOf(signal.NotifyContext),
+		"Reset":         reflect.ValueOf(signal.Reset),
+		"Stop":          reflect.ValueOf(signal.Stop),
+	}
+	env.PackageDocs["os/signal"] = map[string]string{
+		"Ignore":        "Ignore causes the provided signals to be ignored.",
+		"Ignored":       "Ignored reports whether sig is currently ignored.",
+		"Notify":        "Notify causes package signal to relay incoming signals to c.",
+		"NotifyContext": "NotifyContext returns a copy of the parent context that is marked done (its Done channel is closed) when one of the listed signals arrives, when the returned stop function is called, or when the parent context's Done channel is closed, whichever happens first.",
+		"Reset":         "Reset undoes the effect of any prior calls to [Notify] for the provided signals.",
+		"Stop":          "Stop causes package signal to stop relaying incoming signals to c.",
 	}
 }
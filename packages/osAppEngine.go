@@ -1,5 +0,0 @@
-// +build appengine
-
-package packages
-
-func osNotAppEngine() {}
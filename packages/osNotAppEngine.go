@@ -1,3 +1,4 @@
+//go:build !appengine
 // +build !appengine
 
 package packages
@@ -9,6 +10,8 @@ import (
 	"github.com/mattn/anko/env"
 )
 
-func osNotAppEngine() {
+// init runs after the init of the generated os.go, which excludes Getppid as App Engine does not have it.
+func init() {
 	env.Packages["os"]["Getppid"] = reflect.ValueOf(os.Getppid)
+	env.PackageDocs["os"]["Getppid"] = "Getppid returns the process id of the caller's parent."
 }
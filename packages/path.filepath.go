@@ -1,3 +1,5 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
 package packages
 
 import (
@@ -9,22 +11,55 @@ import (
 
 func init() {
 	env.Packages["path/filepath"] = map[string]reflect.Value{
-		"Abs":          reflect.ValueOf(filepath.Abs),
-		"Base":         reflect.ValueOf(filepath.Base),
-		"Clean":        reflect.ValueOf(filepath.Clean),
-		"Dir":          reflect.ValueOf(filepath.Dir),
-		"EvalSymlinks": reflect.ValueOf(filepath.EvalSymlinks),
-		"Ext":          reflect.ValueOf(filepath.Ext),
-		"FromSlash":    reflect.ValueOf(filepath.FromSlash),
-		"Glob":         reflect.ValueOf(filepath.Glob),
-		"HasPrefix":    reflect.ValueOf(filepath.HasPrefix),
-		"IsAbs":        reflect.ValueOf(filepath.IsAbs),
-		"Join":         reflect.ValueOf(filepath.Join),
-		"Match":        reflect.ValueOf(filepath.Match),
-		"Rel":          reflect.ValueOf(filepath.Rel),
-		"Split":        reflect.ValueOf(filepath.Split),
-		"SplitList":    reflect.ValueOf(filepath.SplitList),
-		"ToSlash":      reflect.ValueOf(filepath.ToSlash),
-		"VolumeName":   reflect.ValueOf(filepath.VolumeName),
+		"Abs":           reflect.ValueOf(filepath.Abs),
+		"Base":          reflect.ValueOf(filepath.Base),
+		"Clean":         reflect.ValueOf(filepath.Clean),
+		"Dir":           reflect.ValueOf(filepath.Dir),
+		"ErrBadPattern": reflect.ValueOf(filepath.ErrBadPattern),
+		"EvalSymlinks":  reflect.ValueOf(filepath.EvalSymlinks),
+		"Ext":           reflect.ValueOf(filepath.Ext),
+		"FromSlash":     reflect.ValueOf(filepath.FromSlash),
+		"Glob":          reflect.ValueOf(filepath.Glob),
+		"HasPrefix":     reflect.ValueOf(filepath.HasPrefix),
+		"IsAbs":         reflect.ValueOf(filepath.IsAbs),
+		"Join":          reflect.ValueOf(filepath.Join),
+		"ListSeparator": reflect.ValueOf(filepath.ListSeparator),
+		"Match":         reflect.ValueOf(filepath.Match),
+		"Rel":           reflect.ValueOf(filepath.Rel),
+		"Separator":     reflect.ValueOf(filepath.Separator),
+		"SkipDir":       reflect.ValueOf(filepath.SkipDir),
+		"Split":         reflect.ValueOf(filepath.Split),
+		"SplitList":     reflect.ValueOf(filepath.SplitList),
+		"ToSlash":       reflect.ValueOf(filepath.ToSlash),
+		"VolumeName":    reflect.ValueOf(filepath.VolumeName),
+		"Walk":          reflect.ValueOf(filepath.Walk),
+		"WalkDir":       reflect.ValueOf(filepath.WalkDir),
+	}
+	env.PackageTypes["path/filepath"] = map[string]reflect.Type{
+		"WalkFunc": reflect.TypeOf((*filepath.WalkFunc)(nil)).Elem(),
+	}
+	env.PackageDocs["path/filepath"] = map[string]string{
+		"Abs":           "Abs returns an absolute representation of path.",
+		"Base":          "Base returns the last element of path.",
+		"Clean":         "Clean returns the shortest path name equivalent to path by purely lexical processing.",
+		"Dir":           "Dir returns all but the last element of path, typically the path's directory.",
+		"ErrBadPattern": "ErrBadPattern indicates a pattern was malformed.",
+		"EvalSymlinks":  "EvalSymlinks returns the path name after the evaluation of any symbolic links.",
+		"Ext":           "Ext returns the file name extension used by path.",
+		"FromSlash":     "FromSlash returns the result of replacing each slash ('/') character in path with a separator character.",
+		"Glob":          "Glob returns the names of all files matching pattern or nil if there is no matching file.",
+		"HasPrefix":     "HasPrefix exists for historical compatibility and should not be used.",
+		"IsAbs":         "IsAbs reports whether the path is absolute.",
+		"Join":          "Join joins any number of path elements into a single path, separating them with an OS specific [Separator].",
+		"Match":         "Match reports whether name matches the shell file name pattern.",
+		"Rel":           "Rel returns a relative path that is lexically equivalent to targPath when joined to basePath with an intervening separator.",
+		"SkipDir":       "SkipDir is used as a return value from [WalkFunc] to indicate that the directory named in the call is to be skipped.",
+		"Split":         "Split splits path immediately following the final [Separator], separating it into a directory and file name component.",
+		"SplitList":     "SplitList splits a list of paths joined by the OS-specific [ListSeparator], usually found in PATH or GOPATH environment variables.",
+		"ToSlash":       "ToSlash returns the result of replacing each separator character in path with a slash ('/') character.",
+		"VolumeName":    "VolumeName returns leading volume name.",
+		"Walk":          "Walk walks the file tree rooted at root, calling fn for each file or directory in the tree, including root.",
+		"WalkDir":       "WalkDir walks the file tree rooted at root, calling fn for each file or directory in the tree, including root.",
+		"WalkFunc":      "WalkFunc is the type of the function called by [Walk] to visit each file or directory.",
 	}
 }
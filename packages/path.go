@@ -1,3 +1,5 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
 package packages
 
 import (
@@ -19,4 +21,15 @@ func init() {
 		"Match":         reflect.ValueOf(path.Match),
 		"Split":         reflect.ValueOf(path.Split),
 	}
+	env.PackageDocs["path"] = map[string]string{
+		"Base":          "Base returns the last element of path.",
+		"Clean":         "Clean returns the shortest path name equivalent to path by purely lexical processing.",
+		"Dir":           "Dir returns all but the last element of path, typically the path's directory.",
+		"ErrBadPattern": "ErrBadPattern indicates a pattern was malformed.",
+		"Ext":           "Ext returns the file name extension used by path.",
+		"IsAbs":         "IsAbs reports whether the path is absolute.",
+		"Join":          "Join joins any number of path elements into a single path, separating them with slashes.",
+		"Match":         "Match reports whether name matches the shell pattern.",
+		"Split":         "Split splits path immediately following the final slash, separating it into a directory and file name component.",
+	}
 }
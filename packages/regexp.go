@@ -1,3 +1,5 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
 package packages
 
 import (
@@ -9,13 +11,64 @@ import (
 
 func init() {
 	env.Packages["regexp"] = map[string]reflect.Value{
+		"Compile":          reflect.ValueOf(regexp.Compile),
+		"CompilePOSIX":     reflect.ValueOf(regexp.CompilePOSIX),
 		"Match":            reflect.ValueOf(regexp.Match),
 		"MatchReader":      reflect.ValueOf(regexp.MatchReader),
 		"MatchString":      reflect.ValueOf(regexp.MatchString),
-		"QuoteMeta":        reflect.ValueOf(regexp.QuoteMeta),
-		"Compile":          reflect.ValueOf(regexp.Compile),
-		"CompilePOSIX":     reflect.ValueOf(regexp.CompilePOSIX),
 		"MustCompile":      reflect.ValueOf(regexp.MustCompile),
 		"MustCompilePOSIX": reflect.ValueOf(regexp.MustCompilePOSIX),
+		"QuoteMeta":        reflect.ValueOf(regexp.QuoteMeta),
+	}
+	env.PackageTypes["regexp"] = map[string]reflect.Type{
+		"Regexp": reflect.TypeOf(regexp.Regexp{}),
+	}
+	env.PackageDocs["regexp"] = map[string]string{
+		"Compile":                           "Compile parses a regular expression and returns, if successful, a [Regexp] object that can be used to match against text.",
+		"CompilePOSIX":                      "CompilePOSIX is like [Compile] but restricts the regular expression to POSIX ERE (egrep) syntax and changes the match semantics to leftmost-longest.",
+		"Match":                             "Match reports whether the byte slice b contains any match of the regular expression pattern.",
+		"MatchReader":                       "MatchReader reports whether the text returned by the io.RuneReader contains any match of the regular expression pattern.",
+		"MatchString":                       "MatchString reports whether the string s contains any match of the regular expression pattern.",
+		"MustCompile":                       "MustCompile is like [Compile] but panics if the expression cannot be parsed.",
+		"MustCompilePOSIX":                  "MustCompilePOSIX is like [CompilePOSIX] but panics if the expression cannot be parsed.",
+		"QuoteMeta":                         "QuoteMeta returns a string that escapes all regular expression metacharacters inside the argument text; the returned string is a regular expression matching the literal text.",
+		"Regexp":                            "Regexp is the representation of a compiled regular expression.",
+		"Regexp.Copy":                       "Copy returns a new [Regexp] object copied from re.",
+		"Regexp.Expand":                     "Expand appends template to dst and returns the result; during the append, Expand replaces variables in the template with corresponding matches drawn from src.",
+		"Regexp.ExpandString":               "ExpandString is like [Regexp.Expand] but the template and source are strings.",
+		"Regexp.Find":                       "Find returns the text of the leftmost match for re in b.",
+		"Regexp.FindAll":                    "FindAll returns all the matches for re in b.",
+		"Regexp.FindAllIndex":               "FindAllIndex returns the locations of all matches for re in b.",
+		"Regexp.FindAllString":              "FindAllString returns all the matches for re in s.",
+		"Regexp.FindAllStringIndex":         "FindAllStringIndex returns the locations of all matches for re in s.",
+		"Regexp.FindAllStringSubmatch":      "FindAllStringSubmatch returns the locations of all matches for re in s, including submatch locations.",
+		"Regexp.FindAllStringSubmatchIndex": "FindAllStringSubmatchIndex returns the locations of all matches for re in s, including submatch locations.",
+		"Regexp.FindAllSubmatch":            "FindAllSubmatch returns the locations of all matches for re in b, including submatch locations.",
+		"Regexp.FindAllSubmatchIndex":       "FindAllSubmatchIndex returns the locations of all matches for re in b, including submatch locations.",
+		"Regexp.FindIndex":                  "FindIndex returns the location of the leftmost match for re in b.",
+		"Regexp.FindReaderIndex":            "FindReaderIndex returns the location of the leftmost match for re in r.",
+		"Regexp.FindReaderSubmatchIndex":    "FindReaderSubmatchIndex returns the first match for re in r, including submatches.",
+		"Regexp.FindString":                 "FindString returns the text of the leftmost match for re in s.",
+		"Regexp.FindStringIndex":            "FindStringIndex returns the location of the leftmost match for re in s.",
+		"Regexp.FindStringSubmatch":         "FindStringSubmatch returns the first match for re in s, including submatches.",
+		"Regexp.FindStringSubmatchIndex":    "FindStringSubmatchIndex returns the first match for re in s, including submatches.",
+		"Regexp.FindSubmatch":               "FindSubmatch returns the first match for re in b, including submatches.",
+		"Regexp.FindSubmatchIndex":          "FindSubmatchIndex returns the first match for re in b, including submatches.",
+		"Regexp.LiteralPrefix":              "LiteralPrefix returns a literal string that must begin any match of the regular expression re.",
+		"Regexp.Longest":                    "Longest makes future searches prefer the leftmost-longest match.",
+		"Regexp.Match":                      "Match reports whether the byte slice b contains any match of the regular expression re.",
+		"Regexp.MatchReader":                "MatchReader reports whether the text returned by the io.RuneReader contains any match of the regular expression re.",
+		"Regexp.MatchString":                "MatchString reports whether the string s contains any match of the regular expression re.",
+		"Regexp.NumSubexp":                  "NumSubexp returns the number of parenthesized subexpressions in this [Regexp].",
+		"Regexp.ReplaceAll":                 "ReplaceAll returns a copy of src, replacing matches of the [Regexp] with the replacement text repl.",
+		"Regexp.ReplaceAllFunc":             "ReplaceAllFunc returns a copy of src in which all matches of the [Regexp] have been replaced by the return value of function repl applied to the matched byte slice.",
+		"Regexp.ReplaceAllLiteral":          "ReplaceAllLiteral returns a copy of src, replacing matches of the [Regexp] with the replacement bytes repl.",
+		"Regexp.ReplaceAllLiteralString":    "ReplaceAllLiteralString returns a copy of src, replacing matches of the [Regexp] with the replacement string repl.",
+		"Regexp.ReplaceAllString":           "ReplaceAllString returns a copy of src, replacing matches of the [Regexp] with the replacement string repl.",
+		"Regexp.ReplaceAllStringFunc":       "ReplaceAllStringFunc returns a copy of src in which all matches of the [Regexp] have been replaced by the return value of function repl applied to the matched substring.",
+		"Regexp.Split":                      "Split slices s into substrings separated by the expression and returns a slice of the substrings between those expression matches.",
+		"Regexp.String":                     "String returns the source text used to compile the regular expression.",
+		"Regexp.SubexpIndex":                "SubexpIndex returns the index of the first subexpression with the given name, or -1 if there is no subexpression with that name.",
+		"Regexp.SubexpNames":                "SubexpNames returns the names of the parenthesized subexpressions in this [Regexp].",
 	}
 }
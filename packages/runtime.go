@@ -1,3 +1,5 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
 package packages
 
 import (
@@ -9,11 +11,109 @@ import (
 
 func init() {
 	env.Packages["runtime"] = map[string]reflect.Value{
-		"GC":         reflect.ValueOf(runtime.GC),
-		"GOARCH":     reflect.ValueOf(runtime.GOARCH),
-		"GOMAXPROCS": reflect.ValueOf(runtime.GOMAXPROCS),
-		"GOOS":       reflect.ValueOf(runtime.GOOS),
-		"GOROOT":     reflect.ValueOf(runtime.GOROOT),
-		"Version":    reflect.ValueOf(runtime.Version),
+		"BlockProfile":            reflect.ValueOf(runtime.BlockProfile),
+		"Breakpoint":              reflect.ValueOf(runtime.Breakpoint),
+		"CPUProfile":              reflect.ValueOf(runtime.CPUProfile),
+		"Caller":                  reflect.ValueOf(runtime.Caller),
+		"Callers":                 reflect.ValueOf(runtime.Callers),
+		"CallersFrames":           reflect.ValueOf(runtime.CallersFrames),
+		"Compiler":                reflect.ValueOf(runtime.Compiler),
+		"FuncForPC":               reflect.ValueOf(runtime.FuncForPC),
+		"GC":                      reflect.ValueOf(runtime.GC),
+		"GOARCH":                  reflect.ValueOf(runtime.GOARCH),
+		"GOMAXPROCS":              reflect.ValueOf(runtime.GOMAXPROCS),
+		"GOOS":                    reflect.ValueOf(runtime.GOOS),
+		"GOROOT":                  reflect.ValueOf(runtime.GOROOT),
+		"Goexit":                  reflect.ValueOf(runtime.Goexit),
+		"GoroutineProfile":        reflect.ValueOf(runtime.GoroutineProfile),
+		"Gosched":                 reflect.ValueOf(runtime.Gosched),
+		"KeepAlive":               reflect.ValueOf(runtime.KeepAlive),
+		"LockOSThread":            reflect.ValueOf(runtime.LockOSThread),
+		"MemProfile":              reflect.ValueOf(runtime.MemProfile),
+		"MemProfileRate":          reflect.ValueOf(runtime.MemProfileRate),
+		"MutexProfile":            reflect.ValueOf(runtime.MutexProfile),
+		"NumCPU":                  reflect.ValueOf(runtime.NumCPU),
+		"NumCgoCall":              reflect.ValueOf(runtime.NumCgoCall),
+		"NumGoroutine":            reflect.ValueOf(runtime.NumGoroutine),
+		"ReadMemStats":            reflect.ValueOf(runtime.ReadMemStats),
+		"ReadTrace":               reflect.ValueOf(runtime.ReadTrace),
+		"SetBlockProfileRate":     reflect.ValueOf(runtime.SetBlockProfileRate),
+		"SetCPUProfileRate":       reflect.ValueOf(runtime.SetCPUProfileRate),
+		"SetCgoTraceback":         reflect.ValueOf(runtime.SetCgoTraceback),
+		"SetFinalizer":            reflect.ValueOf(runtime.SetFinalizer),
+		"SetMutexProfileFraction": reflect.ValueOf(runtime.SetMutexProfileFraction),
+		"Stack":                   reflect.ValueOf(runtime.Stack),
+		"StartTrace":              reflect.ValueOf(runtime.StartTrace),
+		"StopTrace":               reflect.ValueOf(runtime.StopTrace),
+		"ThreadCreateProfile":     reflect.ValueOf(runtime.ThreadCreateProfile),
+		"UnlockOSThread":          reflect.ValueOf(runtime.UnlockOSThread),
+		"Version":                 reflect.ValueOf(runtime.Version),
+	}
+	env.PackageTypes["runtime"] = map[string]reflect.Type{
+		"BlockProfileRecord": reflect.TypeOf(runtime.BlockProfileRecord{}),
+		"Error":              reflect.TypeOf((*runtime.Error)(nil)).Elem(),
+		"Frame":              reflect.TypeOf(runtime.Frame{}),
+		"Frames":             reflect.TypeOf(runtime.Frames{}),
+		"Func":               reflect.TypeOf(runtime.Func{}),
+		"MemProfileRecord":   reflect.TypeOf(runtime.MemProfileRecord{}),
+		"MemStats":           reflect.TypeOf(runtime.MemStats{}),
+		"StackRecord":        reflect.TypeOf(runtime.StackRecord{}),
+		"TypeAssertionError": reflect.TypeOf(runtime.TypeAssertionError{}),
+	}
+	env.PackageDocs["runtime"] = map[string]string{
+		"BlockProfile":                  "BlockProfile returns n, the number of records in the current blocking profile.",
+		"BlockProfileRecord":            "BlockProfileRecord describes blocking events originated at a particular call sequence (stack trace).",
+		"Breakpoint":                    "Breakpoint executes a breakpoint trap.",
+		"CPUProfile":                    "CPUProfile panics.",
+		"Caller":                        "Caller reports file and line number information about function invocations on the calling goroutine's stack.",
+		"Callers":                       "Callers fills the slice pc with the return program counters of function invocations on the calling goroutine's stack.",
+		"CallersFrames":                 "CallersFrames takes a slice of PC values returned by [Callers] and prepares to return function/file/line information.",
+		"Compiler":                      "Compiler is the name of the compiler toolchain that built the running binary.",
+		"Error":                         "Error identifies a runtime error used in panic.",
+		"Frame":                         "Frame is the information returned by [Frames] for each call frame.",
+		"Frames":                        "Frames may be used to get function/file/line information for a slice of PC values returned by [Callers].",
+		"Frames.Next":                   "Next returns a [Frame] representing the next call frame in the slice of PC values.",
+		"Func":                          "A Func represents a Go function in the running binary.",
+		"Func.Entry":                    "Entry returns the entry address of the function.",
+		"Func.FileLine":                 "FileLine returns the file name and line number of the source code corresponding to the program counter pc.",
+		"Func.Name":                     "Name returns the name of the function.",
+		"FuncForPC":                     "FuncForPC returns a *[Func] describing the function that contains the given program counter address, or else nil.",
+		"GC":                            "GC runs a garbage collection and blocks the caller until the garbage collection is complete.",
+		"GOARCH":                        "GOARCH is the running program's architecture target: one of 386, amd64, arm, s390x, and so on.",
+		"GOMAXPROCS":                    "GOMAXPROCS sets the maximum number of CPUs that can be executing simultaneously and returns the previous setting.",
+		"GOOS":                          "GOOS is the running program's operating system target: one of darwin, freebsd, linux, and so on.",
+		"GOROOT":                        "GOROOT returns the root of the Go tree.",
+		"Goexit":                        "Goexit terminates the goroutine that calls it.",
+		"GoroutineProfile":              "GoroutineProfile returns n, the number of records in the active goroutine stack profile.",
+		"Gosched":                       "Gosched yields the processor, allowing other goroutines to run.",
+		"KeepAlive":                     "KeepAlive marks its argument as currently reachable.",
+		"LockOSThread":                  "LockOSThread wires the calling goroutine to its current operating system thread.",
+		"MemProfile":                    "MemProfile returns a profile of memory allocated and freed per allocation site.",
+		"MemProfileRate":                "MemProfileRate controls the fraction of memory allocations that are recorded and reported in the memory profile.",
+		"MemProfileRecord":              "A MemProfileRecord describes the live objects allocated by a particular call sequence (stack trace).",
+		"MemProfileRecord.InUseBytes":   "InUseBytes returns the number of bytes in use (AllocBytes - FreeBytes).",
+		"MemProfileRecord.InUseObjects": "InUseObjects returns the number of objects in use (AllocObjects - FreeObjects).",
+		"MemProfileRecord.Stack":        "Stack returns the stack trace associated with the record, a prefix of r.Stack0.",
+		"MemStats":                      "A MemStats records statistics about the memory allocator.",
+		"MutexProfile":                  "MutexProfile returns n, the number of records in the current mutex profile.",
+		"NumCPU":                        "NumCPU returns the number of logical CPUs usable by the current process.",
+		"NumCgoCall":                    "NumCgoCall returns the number of cgo calls made by the current process.",
+		"NumGoroutine":                  "NumGoroutine returns the number of goroutines that currently exist.",
+		"ReadMemStats":                  "ReadMemStats populates m with memory allocator statistics.",
+		"ReadTrace":                     "ReadTrace returns the next chunk of binary tracing data, blocking until data is available.",
+		"SetBlockProfileRate":           "SetBlockProfileRate controls the fraction of goroutine blocking events that are reported in the blocking profile.",
+		"SetCPUProfileRate":             "SetCPUProfileRate sets the CPU profiling rate to hz samples per second.",
+		"SetCgoTraceback":               "SetCgoTraceback records three C functions to use to gather traceback information from C code and to convert that traceback information into symbolic information.",
+		"SetFinalizer":                  "SetFinalizer sets the finalizer associated with obj to the provided finalizer function.",
+		"SetMutexProfileFraction":       "SetMutexProfileFraction controls the fraction of mutex contention events that are reported in the mutex profile.",
+		"Stack":                         "Stack formats a stack trace of the calling goroutine into buf and returns the number of bytes written to buf.",
+		"StackRecord":                   "A StackRecord describes a single execution stack.",
+		"StackRecord.Stack":             "Stack returns the stack trace associated with the record, a prefix of r.Stack0.",
+		"StartTrace":                    "StartTrace enables tracing for the current process.",
+		"StopTrace":                     "StopTrace stops tracing, if it was previously enabled.",
+		"ThreadCreateProfile":           "ThreadCreateProfile returns n, the number of records in the thread creation profile.",
+		"TypeAssertionError":            "A TypeAssertionError explains a failed type assertion.",
+		"UnlockOSThread":                "UnlockOSThread undoes an earlier call to LockOSThread.",
+		"Version":                       "Version returns the Go tree's version string.",
 	}
 }
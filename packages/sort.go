@@ -1,3 +1,5 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
 package packages
 
 import (
@@ -7,17 +9,6 @@ import (
 	"github.com/mattn/anko/env"
 )
 
-// SortFuncsStruct provides functions to be used with Sort
-type SortFuncsStruct struct {
-	LenFunc  func() int
-	LessFunc func(i, j int) bool
-	SwapFunc func(i, j int)
-}
-
-func (s SortFuncsStruct) Len() int           { return s.LenFunc() }
-func (s SortFuncsStruct) Less(i, j int) bool { return s.LessFunc(i, j) }
-func (s SortFuncsStruct) Swap(i, j int)      { s.SwapFunc(i, j) }
-
 func init() {
 	env.Packages["sort"] = map[string]reflect.Value{
 		"Float64s":          reflect.ValueOf(sort.Float64s),
@@ -25,20 +16,53 @@ func init() {
 		"Ints":              reflect.ValueOf(sort.Ints),
 		"IntsAreSorted":     reflect.ValueOf(sort.IntsAreSorted),
 		"IsSorted":          reflect.ValueOf(sort.IsSorted),
+		"Reverse":           reflect.ValueOf(sort.Reverse),
 		"Search":            reflect.ValueOf(sort.Search),
 		"SearchFloat64s":    reflect.ValueOf(sort.SearchFloat64s),
 		"SearchInts":        reflect.ValueOf(sort.SearchInts),
 		"SearchStrings":     reflect.ValueOf(sort.SearchStrings),
+		"Slice":             reflect.ValueOf(sort.Slice),
+		"SliceIsSorted":     reflect.ValueOf(sort.SliceIsSorted),
+		"SliceStable":       reflect.ValueOf(sort.SliceStable),
 		"Sort":              reflect.ValueOf(sort.Sort),
 		"Stable":            reflect.ValueOf(sort.Stable),
 		"Strings":           reflect.ValueOf(sort.Strings),
 		"StringsAreSorted":  reflect.ValueOf(sort.StringsAreSorted),
 	}
 	env.PackageTypes["sort"] = map[string]reflect.Type{
-		"Float64Slice":    reflect.TypeOf(sort.Float64Slice{}),
-		"IntSlice":        reflect.TypeOf(sort.IntSlice{}),
-		"StringSlice":     reflect.TypeOf(sort.StringSlice{}),
-		"SortFuncsStruct": reflect.TypeOf(&SortFuncsStruct{}),
+		"Float64Slice": reflect.TypeOf((*sort.Float64Slice)(nil)).Elem(),
+		"IntSlice":     reflect.TypeOf((*sort.IntSlice)(nil)).Elem(),
+		"Interface":    reflect.TypeOf((*sort.Interface)(nil)).Elem(),
+		"StringSlice":  reflect.TypeOf((*sort.StringSlice)(nil)).Elem(),
+	}
+	env.PackageDocs["sort"] = map[string]string{
+		"Float64Slice":        "Float64Slice implements Interface for a []float64, sorting in increasing order, with not-a-number (NaN) values ordered before other values.",
+		"Float64Slice.Less":   "Less reports whether x[i] should be ordered before x[j], as required by the sort Interface.",
+		"Float64Slice.Search": "Search returns the result of applying [SearchFloat64s] to the receiver and x.",
+		"Float64Slice.Sort":   "Sort is a convenience method: x.Sort() calls Sort(x).",
+		"Float64s":            "Float64s sorts a slice of float64s in increasing order.",
+		"Float64sAreSorted":   "Float64sAreSorted reports whether the slice x is sorted in increasing order, with not-a-number (NaN) values before any other values.",
+		"IntSlice":            "IntSlice attaches the methods of Interface to []int, sorting in increasing order.",
+		"IntSlice.Search":     "Search returns the result of applying [SearchInts] to the receiver and x.",
+		"IntSlice.Sort":       "Sort is a convenience method: x.Sort() calls Sort(x).",
+		"Interface":           "An implementation of Interface can be sorted by the routines in this package.",
+		"Ints":                "Ints sorts a slice of ints in increasing order.",
+		"IntsAreSorted":       "IntsAreSorted reports whether the slice x is sorted in increasing order.",
+		"IsSorted":            "IsSorted reports whether data is sorted.",
+		"Reverse":             "Reverse returns the reverse order for data.",
+		"Search":              "Search uses binary search to find and return the smallest index i in [0, n) at which f(i) is true, assuming that on the range [0, n), f(i) == true implies f(i+1) == true.",
+		"SearchFloat64s":      "SearchFloat64s searches for x in a sorted slice of float64s and returns the index as specified by [Search].",
+		"SearchInts":          "SearchInts searches for x in a sorted slice of ints and returns the index as specified by [Search].",
+		"SearchStrings":       "SearchStrings searches for x in a sorted slice of strings and returns the index as specified by Search.",
+		"Slice":               "Slice sorts the slice x given the provided less function.",
+		"SliceIsSorted":       "SliceIsSorted reports whether the slice x is sorted according to the provided less function.",
+		"SliceStable":         "SliceStable sorts the slice x using the provided less function, keeping equal elements in their original order.",
+		"Sort":                "Sort sorts data in ascending order as determined by the Less method.",
+		"Stable":              "Stable sorts data in ascending order as determined by the Less method, while keeping the original order of equal elements.",
+		"StringSlice":         "StringSlice attaches the methods of Interface to []string, sorting in increasing order.",
+		"StringSlice.Search":  "Search returns the result of applying [SearchStrings] to the receiver and x.",
+		"StringSlice.Sort":    "Sort is a convenience method: x.Sort() calls Sort(x).",
+		"Strings":             "Strings sorts a slice of strings in increasing order.",
+		"StringsAreSorted":    "StringsAreSorted reports whether the slice x is sorted in increasing order.",
 	}
-	sortGo18()
 }
@@ -1,3 +1,5 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
 package packages
 
 import (
@@ -9,15 +11,83 @@ import (
 
 func init() {
 	env.Packages["strconv"] = map[string]reflect.Value{
-		"FormatBool":  reflect.ValueOf(strconv.FormatBool),
-		"FormatFloat": reflect.ValueOf(strconv.FormatFloat),
-		"FormatInt":   reflect.ValueOf(strconv.FormatInt),
-		"FormatUint":  reflect.ValueOf(strconv.FormatUint),
-		"ParseBool":   reflect.ValueOf(strconv.ParseBool),
-		"ParseFloat":  reflect.ValueOf(strconv.ParseFloat),
-		"ParseInt":    reflect.ValueOf(strconv.ParseInt),
-		"ParseUint":   reflect.ValueOf(strconv.ParseUint),
-		"Atoi":        reflect.ValueOf(strconv.Atoi),
-		"Itoa":        reflect.ValueOf(strconv.Itoa),
+		"AppendBool":               reflect.ValueOf(strconv.AppendBool),
+		"AppendFloat":              reflect.ValueOf(strconv.AppendFloat),
+		"AppendInt":                reflect.ValueOf(strconv.AppendInt),
+		"AppendQuote":              reflect.ValueOf(strconv.AppendQuote),
+		"AppendQuoteRune":          reflect.ValueOf(strconv.AppendQuoteRune),
+		"AppendQuoteRuneToASCII":   reflect.ValueOf(strconv.AppendQuoteRuneToASCII),
+		"AppendQuoteRuneToGraphic": reflect.ValueOf(strconv.AppendQuoteRuneToGraphic),
+		"AppendQuoteToASCII":       reflect.ValueOf(strconv.AppendQuoteToASCII),
+		"AppendQuoteToGraphic":     reflect.ValueOf(strconv.AppendQuoteToGraphic),
+		"AppendUint":               reflect.ValueOf(strconv.AppendUint),
+		"Atoi":                     reflect.ValueOf(strconv.Atoi),
+		"CanBackquote":             reflect.ValueOf(strconv.CanBackquote),
+		"ErrRange":                 reflect.ValueOf(strconv.ErrRange),
+		"ErrSyntax":                reflect.ValueOf(strconv.ErrSyntax),
+		"FormatBool":               reflect.ValueOf(strconv.FormatBool),
+		"FormatComplex":            reflect.ValueOf(strconv.FormatComplex),
+		"FormatFloat":              reflect.ValueOf(strconv.FormatFloat),
+		"FormatInt":                reflect.ValueOf(strconv.FormatInt),
+		"FormatUint":               reflect.ValueOf(strconv.FormatUint),
+		"IntSize":                  reflect.ValueOf(strconv.IntSize),
+		"IsGraphic":                reflect.ValueOf(strconv.IsGraphic),
+		"IsPrint":                  reflect.ValueOf(strconv.IsPrint),
+		"Itoa":                     reflect.ValueOf(strconv.Itoa),
+		"ParseBool":                reflect.ValueOf(strconv.ParseBool),
+		"ParseComplex":             reflect.ValueOf(strconv.ParseComplex),
+		"ParseFloat":               reflect.ValueOf(strconv.ParseFloat),
+		"ParseInt":                 reflect.ValueOf(strconv.ParseInt),
+		"ParseUint":                reflect.ValueOf(strconv.ParseUint),
+		"Quote":                    reflect.ValueOf(strconv.Quote),
+		"QuoteRune":                reflect.ValueOf(strconv.QuoteRune),
+		"QuoteRuneToASCII":         reflect.ValueOf(strconv.QuoteRuneToASCII),
+		"QuoteRuneToGraphic":       reflect.ValueOf(strconv.QuoteRuneToGraphic),
+		"QuoteToASCII":             reflect.ValueOf(strconv.QuoteToASCII),
+		"QuoteToGraphic":           reflect.ValueOf(strconv.QuoteToGraphic),
+		"Unquote":                  reflect.ValueOf(strconv.Unquote),
+		"UnquoteChar":              reflect.ValueOf(strconv.UnquoteChar),
+	}
+	env.PackageTypes["strconv"] = map[string]reflect.Type{
+		"NumError": reflect.TypeOf(strconv.NumError{}),
+	}
+	env.PackageDocs["strconv"] = map[string]string{
+		"AppendBool":               "AppendBool appends \"true\" or \"false\", according to the value of b, to dst and returns the extended buffer.",
+		"AppendFloat":              "AppendFloat appends the string form of the floating-point number f, as generated by [FormatFloat], to dst and returns the extended buffer.",
+		"AppendInt":                "AppendInt appends the string form of the integer i, as generated by [FormatInt], to dst and returns the extended buffer.",
+		"AppendQuote":              "AppendQuote appends a double-quoted Go string literal representing s, as generated by [Quote], to dst and returns the extended buffer.",
+		"AppendQuoteRune":          "AppendQuoteRune appends a single-quoted Go character literal representing the rune, as generated by [QuoteRune], to dst and returns the extended buffer.",
+		"AppendQuoteRuneToASCII":   "AppendQuoteRuneToASCII appends a single-quoted Go character literal representing the rune, as generated by [QuoteRuneToASCII], to dst and returns the extended buffer.",
+		"AppendQuoteRuneToGraphic": "AppendQuoteRuneToGraphic appends a single-quoted Go character literal representing the rune, as generated by [QuoteRuneToGraphic], to dst and returns the extended buffer.",
+		"AppendQuoteToASCII":       "AppendQuoteToASCII appends a double-quoted Go string literal representing s, as generated by [QuoteToASCII], to dst and returns the extended buffer.",
+		"AppendQuoteToGraphic":     "AppendQuoteToGraphic appends a double-quoted Go string literal representing s, as generated by [QuoteToGraphic], to dst and returns the extended buffer.",
+		"AppendUint":               "AppendUint appends the string form of the unsigned integer i, as generated by [FormatUint], to dst and returns the extended buffer.",
+		"Atoi":                     "Atoi is equivalent to ParseInt(s, 10, 0), converted to type int.",
+		"CanBackquote":             "CanBackquote reports whether the string s can be represented unchanged as a single-line backquoted string without control characters other than tab.",
+		"ErrRange":                 "ErrRange indicates that a value is out of range for the target type.",
+		"ErrSyntax":                "ErrSyntax indicates that a value does not have the right syntax for the target type.",
+		"FormatBool":               "FormatBool returns \"true\" or \"false\" according to the value of b.",
+		"FormatComplex":            "FormatComplex converts the complex number c to a string of the form (a+bi) where a and b are the real and imaginary parts, formatted according to the format fmt and precision prec.",
+		"FormatFloat":              "FormatFloat converts the floating-point number f to a string, according to the format fmt and precision prec.",
+		"FormatInt":                "FormatInt returns the string representation of i in the given base, for 2 <= base <= 36.",
+		"FormatUint":               "FormatUint returns the string representation of i in the given base, for 2 <= base <= 36.",
+		"IntSize":                  "IntSize is the size in bits of an int or uint value.",
+		"IsGraphic":                "IsGraphic reports whether the rune is defined as a Graphic by Unicode.",
+		"IsPrint":                  "IsPrint reports whether the rune is defined as printable by Go, with the same definition as unicode.IsPrint: letters, numbers, punctuation, symbols and ASCII space.",
+		"Itoa":                     "Itoa is equivalent to [FormatInt](int64(i), 10).",
+		"NumError":                 "A NumError records a failed conversion.",
+		"ParseBool":                "ParseBool returns the boolean value represented by the string.",
+		"ParseComplex":             "ParseComplex converts the string s to a complex number with the precision specified by bitSize: 64 for complex64, or 128 for complex128.",
+		"ParseFloat":               "ParseFloat converts the string s to a floating-point number with the precision specified by bitSize: 32 for float32, or 64 for float64.",
+		"ParseInt":                 "ParseInt interprets a string s in the given base (0, 2 to 36) and bit size (0 to 64) and returns the corresponding value i.",
+		"ParseUint":                "ParseUint is like [ParseInt] but for unsigned numbers.",
+		"Quote":                    "Quote returns a double-quoted Go string literal representing s.",
+		"QuoteRune":                "QuoteRune returns a single-quoted Go character literal representing the rune.",
+		"QuoteRuneToASCII":         "QuoteRuneToASCII returns a single-quoted Go character literal representing the rune.",
+		"QuoteRuneToGraphic":       "QuoteRuneToGraphic returns a single-quoted Go character literal representing the rune.",
+		"QuoteToASCII":             "QuoteToASCII returns a double-quoted Go string literal representing s.",
+		"QuoteToGraphic":           "QuoteToGraphic returns a double-quoted Go string literal representing s.",
+		"Unquote":                  "Unquote interprets s as a single-quoted, double-quoted, or backquoted Go string literal, returning the string value that s quotes.",
+		"UnquoteChar":              "UnquoteChar decodes the first character or byte in the escaped string or character literal represented by the string s.",
 	}
 }
@@ -1,3 +1,5 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
 package packages
 
 import (
@@ -9,6 +11,7 @@ import (
 
 func init() {
 	env.Packages["strings"] = map[string]reflect.Value{
+		"Compare":        reflect.ValueOf(strings.Compare),
 		"Contains":       reflect.ValueOf(strings.Contains),
 		"ContainsAny":    reflect.ValueOf(strings.ContainsAny),
 		"ContainsRune":   reflect.ValueOf(strings.ContainsRune),
@@ -26,12 +29,14 @@ func init() {
 		"Join":           reflect.ValueOf(strings.Join),
 		"LastIndex":      reflect.ValueOf(strings.LastIndex),
 		"LastIndexAny":   reflect.ValueOf(strings.LastIndexAny),
+		"LastIndexByte":  reflect.ValueOf(strings.LastIndexByte),
 		"LastIndexFunc":  reflect.ValueOf(strings.LastIndexFunc),
 		"Map":            reflect.ValueOf(strings.Map),
 		"NewReader":      reflect.ValueOf(strings.NewReader),
 		"NewReplacer":    reflect.ValueOf(strings.NewReplacer),
 		"Repeat":         reflect.ValueOf(strings.Repeat),
 		"Replace":        reflect.ValueOf(strings.Replace),
+		"ReplaceAll":     reflect.ValueOf(strings.ReplaceAll),
 		"Split":          reflect.ValueOf(strings.Split),
 		"SplitAfter":     reflect.ValueOf(strings.SplitAfter),
 		"SplitAfterN":    reflect.ValueOf(strings.SplitAfterN),
@@ -43,6 +48,7 @@ func init() {
 		"ToTitleSpecial": reflect.ValueOf(strings.ToTitleSpecial),
 		"ToUpper":        reflect.ValueOf(strings.ToUpper),
 		"ToUpperSpecial": reflect.ValueOf(strings.ToUpperSpecial),
+		"ToValidUTF8":    reflect.ValueOf(strings.ToValidUTF8),
 		"Trim":           reflect.ValueOf(strings.Trim),
 		"TrimFunc":       reflect.ValueOf(strings.TrimFunc),
 		"TrimLeft":       reflect.ValueOf(strings.TrimLeft),
@@ -53,5 +59,83 @@ func init() {
 		"TrimSpace":      reflect.ValueOf(strings.TrimSpace),
 		"TrimSuffix":     reflect.ValueOf(strings.TrimSuffix),
 	}
-	stringsGo110()
+	env.PackageTypes["strings"] = map[string]reflect.Type{
+		"Builder":  reflect.TypeOf(strings.Builder{}),
+		"Reader":   reflect.TypeOf(strings.Reader{}),
+		"Replacer": reflect.TypeOf(strings.Replacer{}),
+	}
+	env.PackageDocs["strings"] = map[string]string{
+		"Builder":              "A Builder is used to efficiently build a string using [Builder.Write] methods.",
+		"Builder.Cap":          "Cap returns the capacity of the builder's underlying byte slice.",
+		"Builder.Grow":         "Grow grows b's capacity, if necessary, to guarantee space for another n bytes.",
+		"Builder.Len":          "Len returns the number of accumulated bytes; b.Len() == len(b.String()).",
+		"Builder.Reset":        "Reset resets the [Builder] to be empty.",
+		"Builder.String":       "String returns the accumulated string.",
+		"Builder.Write":        "Write appends the contents of p to b's buffer.",
+		"Builder.WriteByte":    "WriteByte appends the byte c to b's buffer.",
+		"Builder.WriteRune":    "WriteRune appends the UTF-8 encoding of Unicode code point r to b's buffer.",
+		"Builder.WriteString":  "WriteString appends the contents of s to b's buffer.",
+		"Compare":              "Compare returns an integer comparing two strings lexicographically.",
+		"Contains":             "Contains reports whether substr is within s.",
+		"ContainsAny":          "ContainsAny reports whether any Unicode code points in chars are within s.",
+		"ContainsRune":         "ContainsRune reports whether the Unicode code point r is within s.",
+		"Count":                "Count counts the number of non-overlapping instances of substr in s.",
+		"EqualFold":            "EqualFold reports whether s and t, interpreted as UTF-8 strings, are equal under simple Unicode case-folding, which is a more general form of case-insensitivity.",
+		"Fields":               "Fields splits the string s around each instance of one or more consecutive white space characters, as defined by unicode.IsSpace, returning a slice of substrings of s or an empty slice if s contains only white space.",
+		"FieldsFunc":           "FieldsFunc splits the string s at each run of Unicode code points c satisfying f(c) and returns an array of slices of s.",
+		"HasPrefix":            "HasPrefix reports whether the string s begins with prefix.",
+		"HasSuffix":            "HasSuffix reports whether the string s ends with suffix.",
+		"Index":                "Index returns the index of the first instance of substr in s, or -1 if substr is not present in s.",
+		"IndexAny":             "IndexAny returns the index of the first instance of any Unicode code point from chars in s, or -1 if no Unicode code point from chars is present in s.",
+		"IndexByte":            "IndexByte returns the index of the first instance of c in s, or -1 if c is not present in s.",
+		"IndexFunc":            "IndexFunc returns the index into s of the first Unicode code point satisfying f(c), or -1 if none do.",
+		"IndexRune":            "IndexRune returns the index of the first instance of the Unicode code point r, or -1 if rune is not present in s.",
+		"Join":                 "Join concatenates the elements of its first argument to create a single string.",
+		"LastIndex":            "LastIndex returns the index of the last instance of substr in s, or -1 if substr is not present in s.",
+		"LastIndexAny":         "LastIndexAny returns the index of the last instance of any Unicode code point from chars in s, or -1 if no Unicode code point from chars is present in s.",
+		"LastIndexByte":        "LastIndexByte returns the index of the last instance of c in s, or -1 if c is not present in s.",
+		"LastIndexFunc":        "LastIndexFunc returns the index into s of the last Unicode code point satisfying f(c), or -1 if none do.",
+		"Map":                  "Map returns a copy of the string s with all its characters modified according to the mapping function.",
+		"NewReader":            "NewReader returns a new [Reader] reading from s.",
+		"NewReplacer":          "NewReplacer returns a new [Replacer] from a list of old, new string pairs.",
+		"Reader":               "A Reader implements the io.Reader, io.ReaderAt, io.ByteReader, io.ByteScanner, io.RuneReader, io.RuneScanner, io.Seeker, and io.WriterTo interfaces by reading from a string.",
+		"Reader.Len":           "Len returns the number of bytes of the unread portion of the string.",
+		"Reader.Read":          "Read implements the io.Reader interface.",
+		"Reader.ReadAt":        "ReadAt implements the io.ReaderAt interface.",
+		"Reader.ReadByte":      "ReadByte implements the io.ByteReader interface.",
+		"Reader.ReadRune":      "ReadRune implements the io.RuneReader interface.",
+		"Reader.Reset":         "Reset resets the [Reader] to be reading from s.",
+		"Reader.Seek":          "Seek implements the io.Seeker interface.",
+		"Reader.Size":          "Size returns the original length of the underlying string.",
+		"Reader.UnreadByte":    "UnreadByte implements the io.ByteScanner interface.",
+		"Reader.UnreadRune":    "UnreadRune implements the io.RuneScanner interface.",
+		"Reader.WriteTo":       "WriteTo implements the io.WriterTo interface.",
+		"Repeat":               "Repeat returns a new string consisting of count copies of the string s.",
+		"Replace":              "Replace returns a copy of the string s with the first n non-overlapping instances of old replaced by new.",
+		"ReplaceAll":           "ReplaceAll returns a copy of the string s with all non-overlapping instances of old replaced by new.",
+		"Replacer":             "Replacer replaces a list of strings with replacements.",
+		"Replacer.Replace":     "Replace returns a copy of s with all replacements performed.",
+		"Replacer.WriteString": "WriteString writes s to w with all replacements performed.",
+		"Split":                "Split slices s into all substrings separated by sep and returns a slice of the substrings between those separators.",
+		"SplitAfter":           "SplitAfter slices s into all substrings after each instance of sep and returns a slice of those substrings.",
+		"SplitAfterN":          "SplitAfterN slices s into substrings after each instance of sep and returns a slice of those substrings.",
+		"SplitN":               "SplitN slices s into substrings separated by sep and returns a slice of the substrings between those separators.",
+		"Title":                "Title returns a copy of the string s with all Unicode letters that begin words mapped to their Unicode title case.",
+		"ToLower":              "ToLower returns s with all Unicode letters mapped to their lower case.",
+		"ToLowerSpecial":       "ToLowerSpecial returns a copy of the string s with all Unicode letters mapped to their lower case using the case mapping specified by c.",
+		"ToTitle":              "ToTitle returns a copy of the string s with all Unicode letters mapped to their Unicode title case.",
+		"ToTitleSpecial":       "ToTitleSpecial returns a copy of the string s with all Unicode letters mapped to their Unicode title case, giving priority to the special casing rules.",
+		"ToUpper":              "ToUpper returns s with all Unicode letters mapped to their upper case.",
+		"ToUpperSpecial":       "ToUpperSpecial returns a copy of the string s with all Unicode letters mapped to their upper case using the case mapping specified by c.",
+		"ToValidUTF8":          "ToValidUTF8 returns a copy of the string s with each run of invalid UTF-8 byte sequences replaced by the replacement string, which may be empty.",
+		"Trim":                 "Trim returns a slice of the string s with all leading and trailing Unicode code points contained in cutset removed.",
+		"TrimFunc":             "TrimFunc returns a slice of the string s with all leading and trailing Unicode code points c satisfying f(c) removed.",
+		"TrimLeft":             "TrimLeft returns a slice of the string s with all leading Unicode code points contained in cutset removed.",
+		"TrimLeftFunc":         "TrimLeftFunc returns a slice of the string s with all leading Unicode code points c satisfying f(c) removed.",
+		"TrimPrefix":           "TrimPrefix returns s without the provided leading prefix string.",
+		"TrimRight":            "TrimRight returns a slice of the string s, with all trailing Unicode code points contained in cutset removed.",
+		"TrimRightFunc":        "TrimRightFunc returns a slice of the string s with all trailing Unicode code points c satisfying f(c) removed.",
+		"TrimSpace":            "TrimSpace returns a slice (substring) of the string s, with all leading and trailing white space removed, as defined by Unicode.",
+		"TrimSuffix":           "TrimSuffix returns s without the provided trailing suffix string.",
+	}
 }
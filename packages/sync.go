@@ -1,3 +1,5 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
 package packages
 
 import (
@@ -13,11 +15,45 @@ func init() {
 	}
 	env.PackageTypes["sync"] = map[string]reflect.Type{
 		"Cond":      reflect.TypeOf(sync.Cond{}),
+		"Locker":    reflect.TypeOf((*sync.Locker)(nil)).Elem(),
+		"Map":       reflect.TypeOf(sync.Map{}),
 		"Mutex":     reflect.TypeOf(sync.Mutex{}),
 		"Once":      reflect.TypeOf(sync.Once{}),
 		"Pool":      reflect.TypeOf(sync.Pool{}),
 		"RWMutex":   reflect.TypeOf(sync.RWMutex{}),
 		"WaitGroup": reflect.TypeOf(sync.WaitGroup{}),
 	}
-	syncGo19()
+	env.PackageDocs["sync"] = map[string]string{
+		"Cond":              "Cond implements a condition variable, a rendezvous point for goroutines waiting for or announcing the occurrence of an event.",
+		"Cond.Broadcast":    "Broadcast wakes all goroutines waiting on c.",
+		"Cond.Signal":       "Signal wakes one goroutine waiting on c, if there is any.",
+		"Cond.Wait":         "Wait atomically unlocks c.L and suspends execution of the calling goroutine.",
+		"Locker":            "A Locker represents an object that can be locked and unlocked.",
+		"Map":               "Map is like a Go map[any]any but is safe for concurrent use by multiple goroutines without additional locking or coordination.",
+		"Map.Delete":        "Delete deletes the value for a key.",
+		"Map.Load":          "Load returns the value stored in the map for a key, or nil if no value is present.",
+		"Map.LoadAndDelete": "LoadAndDelete deletes the value for a key, returning the previous value if any.",
+		"Map.LoadOrStore":   "LoadOrStore returns the existing value for the key if present.",
+		"Map.Range":         "Range calls f sequentially for each key and value present in the map.",
+		"Map.Store":         "Store sets the value for a key.",
+		"Mutex":             "A Mutex is a mutual exclusion lock.",
+		"Mutex.Lock":        "Lock locks m.",
+		"Mutex.Unlock":      "Unlock unlocks m.",
+		"NewCond":           "NewCond returns a new Cond with Locker l.",
+		"Once":              "Once is an object that will perform exactly one action.",
+		"Once.Do":           "Do calls the function f if and only if Do is being called for the first time for this instance of [Once].",
+		"Pool":              "A Pool is a set of temporary objects that may be individually saved and retrieved.",
+		"Pool.Get":          "Get selects an arbitrary item from the [Pool], removes it from the Pool, and returns it to the caller.",
+		"Pool.Put":          "Put adds x to the pool.",
+		"RWMutex":           "A RWMutex is a reader/writer mutual exclusion lock.",
+		"RWMutex.Lock":      "Lock locks rw for writing.",
+		"RWMutex.RLock":     "RLock locks rw for reading.",
+		"RWMutex.RLocker":   "RLocker returns a [Locker] interface that implements the [Locker.Lock] and [Locker.Unlock] methods by calling rw.RLock and rw.RUnlock.",
+		"RWMutex.RUnlock":   "RUnlock undoes a single [RWMutex.RLock] call; it does not affect other simultaneous readers.",
+		"RWMutex.Unlock":    "Unlock unlocks rw for writing.",
+		"WaitGroup":         "A WaitGroup is a counting semaphore typically used to wait for a group of goroutines or tasks to finish.",
+		"WaitGroup.Add":     "Add adds delta, which may be negative, to the [WaitGroup] task counter.",
+		"WaitGroup.Done":    "Done decrements the [WaitGroup] task counter by one.",
+		"WaitGroup.Wait":    "Wait blocks until the [WaitGroup] task counter is zero.",
+	}
 }
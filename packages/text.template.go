@@ -0,0 +1,67 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
+package packages
+
+import (
+	"reflect"
+	"text/template"
+
+	"github.com/mattn/anko/env"
+)
+
+func init() {
+	env.Packages["text/template"] = map[string]reflect.Value{
+		"HTMLEscape":       reflect.ValueOf(template.HTMLEscape),
+		"HTMLEscapeString": reflect.ValueOf(template.HTMLEscapeString),
+		"HTMLEscaper":      reflect.ValueOf(template.HTMLEscaper),
+		"IsTrue":           reflect.ValueOf(template.IsTrue),
+		"JSEscape":         reflect.ValueOf(template.JSEscape),
+		"JSEscapeString":   reflect.ValueOf(template.JSEscapeString),
+		"JSEscaper":        reflect.ValueOf(template.JSEscaper),
+		"Must":             reflect.ValueOf(template.Must),
+		"New":              reflect.ValueOf(template.New),
+		"ParseFS":          reflect.ValueOf(template.ParseFS),
+		"ParseFiles":       reflect.ValueOf(template.ParseFiles),
+		"ParseGlob":        reflect.ValueOf(template.ParseGlob),
+		"URLQueryEscaper":  reflect.ValueOf(template.URLQueryEscaper),
+	}
+	env.PackageTypes["text/template"] = map[string]reflect.Type{
+		"ExecError": reflect.TypeOf(template.ExecError{}),
+		"FuncMap":   reflect.TypeOf((*template.FuncMap)(nil)).Elem(),
+		"Template":  reflect.TypeOf(template.Template{}),
+	}
+	env.PackageDocs["text/template"] = map[string]string{
+		"ExecError":                 "ExecError is the custom error type returned when Execute has an error evaluating its template.",
+		"FuncMap":                   "FuncMap is the type of the map defining the mapping from names to functions.",
+		"HTMLEscape":                "HTMLEscape writes to w the escaped HTML equivalent of the plain text data b.",
+		"HTMLEscapeString":          "HTMLEscapeString returns the escaped HTML equivalent of the plain text data s.",
+		"HTMLEscaper":               "HTMLEscaper returns the escaped HTML equivalent of the textual representation of its arguments.",
+		"IsTrue":                    "IsTrue reports whether the value is 'true', in the sense of not the zero of its type, and whether the value has a meaningful truth value.",
+		"JSEscape":                  "JSEscape writes to w the escaped JavaScript equivalent of the plain text data b.",
+		"JSEscapeString":            "JSEscapeString returns the escaped JavaScript equivalent of the plain text data s.",
+		"JSEscaper":                 "JSEscaper returns the escaped JavaScript equivalent of the textual representation of its arguments.",
+		"Must":                      "Must is a helper that wraps a call to a function returning ([*Template], error) and panics if the error is non-nil.",
+		"New":                       "New allocates a new, undefined template with the given name.",
+		"ParseFS":                   "ParseFS is like [Template.ParseFiles] or [Template.ParseGlob] but reads from the file system fsys instead of the host operating system's file system.",
+		"ParseFiles":                "ParseFiles creates a new [Template] and parses the template definitions from the named files.",
+		"ParseGlob":                 "ParseGlob creates a new [Template] and parses the template definitions from the files identified by the pattern.",
+		"Template":                  "Template is the representation of a parsed template.",
+		"Template.AddParseTree":     "AddParseTree associates the argument parse tree with the template t, giving it the specified name.",
+		"Template.Clone":            "Clone returns a duplicate of the template, including all associated templates.",
+		"Template.DefinedTemplates": "DefinedTemplates returns a string listing the defined templates, prefixed by the string \"; defined templates are: \".",
+		"Template.Delims":           "Delims sets the action delimiters to the specified strings, to be used in subsequent calls to [Template.Parse], [Template.ParseFiles], or [Template.ParseGlob].",
+		"Template.Execute":          "Execute applies a parsed template to the specified data object, and writes the output to wr.",
+		"Template.ExecuteTemplate":  "ExecuteTemplate applies the template associated with t that has the given name to the specified data object and writes the output to wr.",
+		"Template.Funcs":            "Funcs adds the elements of the argument map to the template's function map.",
+		"Template.Lookup":           "Lookup returns the template with the given name that is associated with t.",
+		"Template.Name":             "Name returns the name of the template.",
+		"Template.New":              "New allocates a new, undefined template associated with the given one and with the same delimiters.",
+		"Template.Option":           "Option sets options for the template.",
+		"Template.Parse":            "Parse parses text as a template body for t.",
+		"Template.ParseFS":          "ParseFS is like [Template.ParseFiles] or [Template.ParseGlob] but reads from the file system fsys instead of the host operating system's file system.",
+		"Template.ParseFiles":       "ParseFiles parses the named files and associates the resulting templates with t.",
+		"Template.ParseGlob":        "ParseGlob parses the template definitions in the files identified by the pattern and associates the resulting templates with t.",
+		"Template.Templates":        "Templates returns a slice of defined templates associated with t.",
+		"URLQueryEscaper":           "URLQueryEscaper returns the escaped value of the textual representation of its arguments in a form suitable for embedding in a URL query.",
+	}
+}
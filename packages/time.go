@@ -1,3 +1,5 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
 package packages
 
 import (
@@ -9,67 +11,180 @@ import (
 
 func init() {
 	env.Packages["time"] = map[string]reflect.Value{
-		"ANSIC":           reflect.ValueOf(time.ANSIC),
-		"After":           reflect.ValueOf(time.After),
-		"AfterFunc":       reflect.ValueOf(time.AfterFunc),
-		"April":           reflect.ValueOf(time.April),
-		"August":          reflect.ValueOf(time.August),
-		"Date":            reflect.ValueOf(time.Date),
-		"December":        reflect.ValueOf(time.December),
-		"February":        reflect.ValueOf(time.February),
-		"FixedZone":       reflect.ValueOf(time.FixedZone),
-		"Friday":          reflect.ValueOf(time.Friday),
-		"Hour":            reflect.ValueOf(time.Hour),
-		"January":         reflect.ValueOf(time.January),
-		"July":            reflect.ValueOf(time.July),
-		"June":            reflect.ValueOf(time.June),
-		"Kitchen":         reflect.ValueOf(time.Kitchen),
-		"LoadLocation":    reflect.ValueOf(time.LoadLocation),
-		"March":           reflect.ValueOf(time.March),
-		"May":             reflect.ValueOf(time.May),
-		"Microsecond":     reflect.ValueOf(time.Microsecond),
-		"Millisecond":     reflect.ValueOf(time.Millisecond),
-		"Minute":          reflect.ValueOf(time.Minute),
-		"Monday":          reflect.ValueOf(time.Monday),
-		"Nanosecond":      reflect.ValueOf(time.Nanosecond),
-		"NewTicker":       reflect.ValueOf(time.NewTicker),
-		"NewTimer":        reflect.ValueOf(time.NewTimer),
-		"November":        reflect.ValueOf(time.November),
-		"Now":             reflect.ValueOf(time.Now),
-		"October":         reflect.ValueOf(time.October),
-		"Parse":           reflect.ValueOf(time.Parse),
-		"ParseDuration":   reflect.ValueOf(time.ParseDuration),
-		"ParseInLocation": reflect.ValueOf(time.ParseInLocation),
-		"RFC1123":         reflect.ValueOf(time.RFC1123),
-		"RFC1123Z":        reflect.ValueOf(time.RFC1123Z),
-		"RFC3339":         reflect.ValueOf(time.RFC3339),
-		"RFC3339Nano":     reflect.ValueOf(time.RFC3339Nano),
-		"RFC822":          reflect.ValueOf(time.RFC822),
-		"RFC822Z":         reflect.ValueOf(time.RFC822Z),
-		"RFC850":          reflect.ValueOf(time.RFC850),
-		"RubyDate":        reflect.ValueOf(time.RubyDate),
-		"Saturday":        reflect.ValueOf(time.Saturday),
-		"Second":          reflect.ValueOf(time.Second),
-		"September":       reflect.ValueOf(time.September),
-		"Since":           reflect.ValueOf(time.Since),
-		"Sleep":           reflect.ValueOf(time.Sleep),
-		"Stamp":           reflect.ValueOf(time.Stamp),
-		"StampMicro":      reflect.ValueOf(time.StampMicro),
-		"StampMilli":      reflect.ValueOf(time.StampMilli),
-		"StampNano":       reflect.ValueOf(time.StampNano),
-		"Sunday":          reflect.ValueOf(time.Sunday),
-		"Thursday":        reflect.ValueOf(time.Thursday),
-		"Tick":            reflect.ValueOf(time.Tick),
-		"Tuesday":         reflect.ValueOf(time.Tuesday),
-		"Unix":            reflect.ValueOf(time.Unix),
-		"UnixDate":        reflect.ValueOf(time.UnixDate),
-		"Wednesday":       reflect.ValueOf(time.Wednesday),
+		"ANSIC":                  reflect.ValueOf(time.ANSIC),
+		"After":                  reflect.ValueOf(time.After),
+		"AfterFunc":              reflect.ValueOf(time.AfterFunc),
+		"April":                  reflect.ValueOf(time.April),
+		"August":                 reflect.ValueOf(time.August),
+		"Date":                   reflect.ValueOf(time.Date),
+		"December":               reflect.ValueOf(time.December),
+		"February":               reflect.ValueOf(time.February),
+		"FixedZone":              reflect.ValueOf(time.FixedZone),
+		"Friday":                 reflect.ValueOf(time.Friday),
+		"Hour":                   reflect.ValueOf(time.Hour),
+		"January":                reflect.ValueOf(time.January),
+		"July":                   reflect.ValueOf(time.July),
+		"June":                   reflect.ValueOf(time.June),
+		"Kitchen":                reflect.ValueOf(time.Kitchen),
+		"LoadLocation":           reflect.ValueOf(time.LoadLocation),
+		"LoadLocationFromTZData": reflect.ValueOf(time.LoadLocationFromTZData),
+		"Local":                  reflect.ValueOf(time.Local),
+		"March":                  reflect.ValueOf(time.March),
+		"May":                    reflect.ValueOf(time.May),
+		"Microsecond":            reflect.ValueOf(time.Microsecond),
+		"Millisecond":            reflect.ValueOf(time.Millisecond),
+		"Minute":                 reflect.ValueOf(time.Minute),
+		"Monday":                 reflect.ValueOf(time.Monday),
+		"Nanosecond":             reflect.ValueOf(time.Nanosecond),
+		"NewTicker":              reflect.ValueOf(time.NewTicker),
+		"NewTimer":               reflect.ValueOf(time.NewTimer),
+		"November":               reflect.ValueOf(time.November),
+		"Now":                    reflect.ValueOf(time.Now),
+		"October":                reflect.ValueOf(time.October),
+		"Parse":                  reflect.ValueOf(time.Parse),
+		"ParseDuration":          reflect.ValueOf(time.ParseDuration),
+		"ParseInLocation":        reflect.ValueOf(time.ParseInLocation),
+		"RFC1123":                reflect.ValueOf(time.RFC1123),
+		"RFC1123Z":               reflect.ValueOf(time.RFC1123Z),
+		"RFC3339":                reflect.ValueOf(time.RFC3339),
+		"RFC3339Nano":            reflect.ValueOf(time.RFC3339Nano),
+		"RFC822":                 reflect.ValueOf(time.RFC822),
+		"RFC822Z":                reflect.ValueOf(time.RFC822Z),
+		"RFC850":                 reflect.ValueOf(time.RFC850),
+		"RubyDate":               reflect.ValueOf(time.RubyDate),
+		"Saturday":               reflect.ValueOf(time.Saturday),
+		"Second":                 reflect.ValueOf(time.Second),
+		"September":              reflect.ValueOf(time.September),
+		"Since":                  reflect.ValueOf(time.Since),
+		"Sleep":                  reflect.ValueOf(time.Sleep),
+		"Stamp":                  reflect.ValueOf(time.Stamp),
+		"StampMicro":             reflect.ValueOf(time.StampMicro),
+		"StampMilli":             reflect.ValueOf(time.StampMilli),
+		"StampNano":              reflect.ValueOf(time.StampNano),
+		"Sunday":                 reflect.ValueOf(time.Sunday),
+		"Thursday":               reflect.ValueOf(time.Thursday),
+		"Tick":                   reflect.ValueOf(time.Tick),
+		"Tuesday":                reflect.ValueOf(time.Tuesday),
+		"UTC":                    reflect.ValueOf(time.UTC),
+		"Unix":                   reflect.ValueOf(time.Unix),
+		"UnixDate":               reflect.ValueOf(time.UnixDate),
+		"Until":                  reflect.ValueOf(time.Until),
+		"Wednesday":              reflect.ValueOf(time.Wednesday),
 	}
 	env.PackageTypes["time"] = map[string]reflect.Type{
-		"Duration": reflect.TypeOf(time.Duration(0)),
-		"Ticker":   reflect.TypeOf(time.Ticker{}),
-		"Time":     reflect.TypeOf(time.Time{}),
+		"Duration":   reflect.TypeOf((*time.Duration)(nil)).Elem(),
+		"Location":   reflect.TypeOf(time.Location{}),
+		"Month":      reflect.TypeOf((*time.Month)(nil)).Elem(),
+		"ParseError": reflect.TypeOf(time.ParseError{}),
+		"Ticker":     reflect.TypeOf(time.Ticker{}),
+		"Time":       reflect.TypeOf(time.Time{}),
+		"Timer":      reflect.TypeOf(time.Timer{}),
+		"Weekday":    reflect.TypeOf((*time.Weekday)(nil)).Elem(),
+	}
+	env.PackageDocs["time"] = map[string]string{
+		"ANSIC":                  "These are predefined layouts for use in [Time.Format] and time.Parse.",
+		"After":                  "After waits for the duration to elapse and then sends the current time on the returned channel.",
+		"AfterFunc":              "AfterFunc waits for the duration to elapse and then calls f in its own goroutine.",
+		"Date":                   "Date returns the Time corresponding to",
+		"Duration":               "A Duration represents the elapsed time between two instants as an int64 nanosecond count.",
+		"Duration.Hours":         "Hours returns the duration as a floating point number of hours.",
+		"Duration.Microseconds":  "Microseconds returns the duration as an integer microsecond count.",
+		"Duration.Milliseconds":  "Milliseconds returns the duration as an integer millisecond count.",
+		"Duration.Minutes":       "Minutes returns the duration as a floating point number of minutes.",
+		"Duration.Nanoseconds":   "Nanoseconds returns the duration as an integer nanosecond count.",
+		"Duration.Round":         "Round returns the result of rounding d to the nearest multiple of m.",
+		"Duration.Seconds":       "Seconds returns the duration as a floating point number of seconds.",
+		"Duration.String":        "String returns a string representing the duration in the form \"72h3m0.5s\".",
+		"Duration.Truncate":      "Truncate returns the result of rounding d toward zero to a multiple of m.",
+		"FixedZone":              "FixedZone returns a [Location] that always uses the given zone name and offset (seconds east of UTC).",
+		"Hour":                   "Common durations.",
+		"Kitchen":                "These are predefined layouts for use in [Time.Format] and time.Parse.",
+		"LoadLocation":           "LoadLocation returns a [Location] with the given name.",
+		"LoadLocationFromTZData": "LoadLocationFromTZData returns a new [Location] with the given name initialized from the IANA Time Zone database-formatted data.",
+		"Local":                  "Local represents the system's local time zone.",
+		"Location":               "A Location maps time instants to the zone in use at that time.",
+		"Location.String":        "String returns a descriptive name for the time zone information, corresponding to the name argument to [LoadLocation] or [FixedZone].",
+		"Microsecond":            "Common durations.",
+		"Millisecond":            "Common durations.",
+		"Minute":                 "Common durations.",
+		"Month":                  "A Month specifies a month of the year (January = 1, ...).",
+		"Month.String":           "String returns the English name of the month (\"January\", \"February\", ...).",
+		"Nanosecond":             "Common durations.",
+		"NewTicker":              "NewTicker returns a new [Ticker] containing a channel that will send the current time on the channel after each tick.",
+		"NewTimer":               "NewTimer creates a new Timer that will send the current time on its channel after at least duration d.",
+		"Now":                    "Now returns the current local time.",
+		"Parse":                  "Parse parses a formatted string and returns the time value it represents.",
+		"ParseDuration":          "ParseDuration parses a duration string.",
+		"ParseError":             "ParseError describes a problem parsing a time string.",
+		"ParseError.Error":       "Error returns the string representation of a ParseError.",
+		"ParseInLocation":        "ParseInLocation is like Parse but differs in two important ways.",
+		"RFC1123":                "These are predefined layouts for use in [Time.Format] and time.Parse.",
+		"RFC1123Z":               "These are predefined layouts for use in [Time.Format] and time.Parse.",
+		"RFC3339":                "These are predefined layouts for use in [Time.Format] and time.Parse.",
+		"RFC3339Nano":            "These are predefined layouts for use in [Time.Format] and time.Parse.",
+		"RFC822":                 "These are predefined layouts for use in [Time.Format] and time.Parse.",
+		"RFC822Z":                "These are predefined layouts for use in [Time.Format] and time.Parse.",
+		"RFC850":                 "These are predefined layouts for use in [Time.Format] and time.Parse.",
+		"RubyDate":               "These are predefined layouts for use in [Time.Format] and time.Parse.",
+		"Second":                 "Common durations.",
+		"Since":                  "Since returns the time elapsed since t.",
+		"Sleep":                  "Sleep pauses the current goroutine for at least the duration d.",
+		"Stamp":                  "Handy time stamps.",
+		"StampMicro":             "These are predefined layouts for use in [Time.Format] and time.Parse.",
+		"StampMilli":             "These are predefined layouts for use in [Time.Format] and time.Parse.",
+		"StampNano":              "These are predefined layouts for use in [Time.Format] and time.Parse.",
+		"Tick":                   "Tick is a convenience wrapper for [NewTicker] providing access to the ticking channel only.",
+		"Ticker":                 "A Ticker holds a channel that delivers “ticks” of a clock at intervals.",
+		"Ticker.Reset":           "Reset stops a ticker and resets its period to the specified duration.",
+		"Ticker.Stop":            "Stop turns off a ticker.",
+		"Time":                   "A Time represents an instant in time with nanosecond precision.",
+		"Time.Add":               "Add returns the time t+d.",
+		"Time.AddDate":           "AddDate returns the time corresponding to adding the given number of years, months, and days to t.",
+		"Time.After":             "After reports whether the time instant t is after u.",
+		"Time.AppendFormat":      "AppendFormat is like [Time.Format] but appends the textual representation to b and returns the extended buffer.",
+		"Time.Before":            "Before reports whether the time instant t is before u.",
+		"Time.Clock":             "Clock returns the hour, minute, and second within the day specified by t.",
+		"Time.Date":              "Date returns the year, month, and day in which t occurs.",
+		"Time.Day":               "Day returns the day of the month specified by t.",
+		"Time.Equal":             "Equal reports whether t and u represent the same time instant.",
+		"Time.Format":            "Format returns a textual representation of the time value formatted according to the layout defined by the argument.",
+		"Time.GobDecode":         "GobDecode implements the gob.GobDecoder interface.",
+		"Time.GobEncode":         "GobEncode implements the gob.GobEncoder interface.",
+		"Time.Hour":              "Hour returns the hour within the day specified by t, in the range [0, 23].",
+		"Time.ISOWeek":           "ISOWeek returns the ISO 8601 year and week number in which t occurs.",
+		"Time.In":                "In returns a copy of t representing the same time instant, but with the copy's location information set to loc for display purposes.",
+		"Time.IsZero":            "IsZero reports whether t represents the zero time instant, January 1, year 1, 00:00:00 UTC.",
+		"Time.Local":             "Local returns t with the location set to local time.",
+		"Time.Location":          "Location returns the time zone information associated with t.",
+		"Time.MarshalBinary":     "MarshalBinary implements the encoding.BinaryMarshaler interface.",
+		"Time.MarshalJSON":       "MarshalJSON implements the encoding/json.Marshaler interface.",
+		"Time.MarshalText":       "MarshalText implements the encoding.TextMarshaler interface.",
+		"Time.Minute":            "Minute returns the minute offset within the hour specified by t, in the range [0, 59].",
+		"Time.Month":             "Month returns the month of the year specified by t.",
+		"Time.Nanosecond":        "Nanosecond returns the nanosecond offset within the second specified by t, in the range [0, 999999999].",
+		"Time.Round":             "Round returns the result of rounding t to the nearest multiple of d (since the zero time).",
+		"Time.Second":            "Second returns the second offset within the minute specified by t, in the range [0, 59].",
+		"Time.String":            "String returns the time formatted using the format string",
+		"Time.Sub":               "Sub returns the duration t-u.",
+		"Time.Truncate":          "Truncate returns the result of rounding t down to a multiple of d (since the zero time).",
+		"Time.UTC":               "UTC returns t with the location set to UTC.",
+		"Time.Unix":              "Unix returns t as a Unix time, the number of seconds elapsed since January 1, 1970 UTC.",
+		"Time.UnixNano":          "UnixNano returns t as a Unix time, the number of nanoseconds elapsed since January 1, 1970 UTC.",
+		"Time.UnmarshalBinary":   "UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.",
+		"Time.UnmarshalJSON":     "UnmarshalJSON implements the encoding/json.Unmarshaler interface.",
+		"Time.UnmarshalText":     "UnmarshalText implements the encoding.TextUnmarshaler interface.",
+		"Time.Weekday":           "Weekday returns the day of the week specified by t.",
+		"Time.Year":              "Year returns the year in which t occurs.",
+		"Time.YearDay":           "YearDay returns the day of the year specified by t, in the range [1,365] for non-leap years, and [1,366] in leap years.",
+		"Time.Zone":              "Zone computes the time zone in effect at time t, returning the abbreviated name of the zone (such as \"CET\") and its offset in seconds east of UTC.",
+		"Timer":                  "The Timer type represents a single event.",
+		"Timer.Reset":            "Reset changes the timer to expire after duration d.",
+		"Timer.Stop":             "Stop prevents the [Timer] from firing.",
+		"UTC":                    "UTC represents Universal Coordinated Time (UTC).",
+		"Unix":                   "Unix returns the local Time corresponding to the given Unix time, sec seconds and nsec nanoseconds since January 1, 1970 UTC.",
+		"UnixDate":               "These are predefined layouts for use in [Time.Format] and time.Parse.",
+		"Until":                  "Until returns the duration until t.",
+		"Weekday":                "A Weekday specifies a day of the week (Sunday = 0, ...).",
+		"Weekday.String":         "String returns the English name of the day (\"Sunday\", \"Monday\", ...).",
 	}
-	timeGo18()
-	timeGo110()
 }
@@ -1,5 +0,0 @@
-// +build !go1.10
-
-package packages
-
-func timeGo110() {}
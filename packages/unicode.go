@@ -0,0 +1,588 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
+package packages
+
+import (
+	"reflect"
+	"unicode"
+
+	"github.com/mattn/anko/env"
+)
+
+func init() {
+	env.Packages["unicode"] = map[string]reflect.Value{
+		"ASCII_Hex_Digit":                    reflect.ValueOf(unicode.ASCII_Hex_Digit),
+		"Adlam":                              reflect.ValueOf(unicode.Adlam),
+		"Ahom":                               reflect.ValueOf(unicode.Ahom),
+		"Anatolian_Hieroglyphs":              reflect.ValueOf(unicode.Anatolian_Hieroglyphs),
+		"Arabic":                             reflect.ValueOf(unicode.Arabic),
+		"Armenian":                           reflect.ValueOf(unicode.Armenian),
+		"Avestan":                            reflect.ValueOf(unicode.Avestan),
+		"AzeriCase":                          reflect.ValueOf(unicode.AzeriCase),
+		"Balinese":                           reflect.ValueOf(unicode.Balinese),
+		"Bamum":                              reflect.ValueOf(unicode.Bamum),
+		"Bassa_Vah":                          reflect.ValueOf(unicode.Bassa_Vah),
+		"Batak":                              reflect.ValueOf(unicode.Batak),
+		"Bengali":                            reflect.ValueOf(unicode.Bengali),
+		"Bhaiksuki":                          reflect.ValueOf(unicode.Bhaiksuki),
+		"Bidi_Control":                       reflect.ValueOf(unicode.Bidi_Control),
+		"Bopomofo":                           reflect.ValueOf(unicode.Bopomofo),
+		"Brahmi":                             reflect.ValueOf(unicode.Brahmi),
+		"Braille":                            reflect.ValueOf(unicode.Braille),
+		"Buginese":                           reflect.ValueOf(unicode.Buginese),
+		"Buhid":                              reflect.ValueOf(unicode.Buhid),
+		"C":                                  reflect.ValueOf(unicode.C),
+		"Canadian_Aboriginal":                reflect.ValueOf(unicode.Canadian_Aboriginal),
+		"Carian":                             reflect.ValueOf(unicode.Carian),
+		"CaseRanges":                         reflect.ValueOf(unicode.CaseRanges),
+		"Categories":                         reflect.ValueOf(unicode.Categories),
+		"Caucasian_Albanian":                 reflect.ValueOf(unicode.Caucasian_Albanian),
+		"Cc":                                 reflect.ValueOf(unicode.Cc),
+		"Cf":                                 reflect.ValueOf(unicode.Cf),
+		"Chakma":                             reflect.ValueOf(unicode.Chakma),
+		"Cham":                               reflect.ValueOf(unicode.Cham),
+		"Cherokee":                           reflect.ValueOf(unicode.Cherokee),
+		"Chorasmian":                         reflect.ValueOf(unicode.Chorasmian),
+		"Co":                                 reflect.ValueOf(unicode.Co),
+		"Common":                             reflect.ValueOf(unicode.Common),
+		"Coptic":                             reflect.ValueOf(unicode.Coptic),
+		"Cs":                                 reflect.ValueOf(unicode.Cs),
+		"Cuneiform":                          reflect.ValueOf(unicode.Cuneiform),
+		"Cypriot":                            reflect.ValueOf(unicode.Cypriot),
+		"Cyrillic":                           reflect.ValueOf(unicode.Cyrillic),
+		"Dash":                               reflect.ValueOf(unicode.Dash),
+		"Deprecated":                         reflect.ValueOf(unicode.Deprecated),
+		"Deseret":                            reflect.ValueOf(unicode.Deseret),
+		"Devanagari":                         reflect.ValueOf(unicode.Devanagari),
+		"Diacritic":                          reflect.ValueOf(unicode.Diacritic),
+		"Digit":                              reflect.ValueOf(unicode.Digit),
+		"Dives_Akuru":                        reflect.ValueOf(unicode.Dives_Akuru),
+		"Dogra":                              reflect.ValueOf(unicode.Dogra),
+		"Duployan":                           reflect.ValueOf(unicode.Duployan),
+		"Egyptian_Hieroglyphs":               reflect.ValueOf(unicode.Egyptian_Hieroglyphs),
+		"Elbasan":                            reflect.ValueOf(unicode.Elbasan),
+		"Elymaic":                            reflect.ValueOf(unicode.Elymaic),
+		"Ethiopic":                           reflect.ValueOf(unicode.Ethiopic),
+		"Extender":                           reflect.ValueOf(unicode.Extender),
+		"FoldCategory":                       reflect.ValueOf(unicode.FoldCategory),
+		"FoldScript":                         reflect.ValueOf(unicode.FoldScript),
+		"Georgian":                           reflect.ValueOf(unicode.Georgian),
+		"Glagolitic":                         reflect.ValueOf(unicode.Glagolitic),
+		"Gothic":                             reflect.ValueOf(unicode.Gothic),
+		"Grantha":                            reflect.ValueOf(unicode.Grantha),
+		"GraphicRanges":                      reflect.ValueOf(unicode.GraphicRanges),
+		"Greek":                              reflect.ValueOf(unicode.Greek),
+		"Gujarati":                           reflect.ValueOf(unicode.Gujarati),
+		"Gunjala_Gondi":                      reflect.ValueOf(unicode.Gunjala_Gondi),
+		"Gurmukhi":                           reflect.ValueOf(unicode.Gurmukhi),
+		"Han":                                reflect.ValueOf(unicode.Han),
+		"Hangul":                             reflect.ValueOf(unicode.Hangul),
+		"Hanifi_Rohingya":                    reflect.ValueOf(unicode.Hanifi_Rohingya),
+		"Hanunoo":                            reflect.ValueOf(unicode.Hanunoo),
+		"Hatran":                             reflect.ValueOf(unicode.Hatran),
+		"Hebrew":                             reflect.ValueOf(unicode.Hebrew),
+		"Hex_Digit":                          reflect.ValueOf(unicode.Hex_Digit),
+		"Hiragana":                           reflect.ValueOf(unicode.Hiragana),
+		"Hyphen":                             reflect.ValueOf(unicode.Hyphen),
+		"IDS_Binary_Operator":                reflect.ValueOf(unicode.IDS_Binary_Operator),
+		"IDS_Trinary_Operator":               reflect.ValueOf(unicode.IDS_Trinary_Operator),
+		"Ideographic":                        reflect.ValueOf(unicode.Ideographic),
+		"Imperial_Aramaic":                   reflect.ValueOf(unicode.Imperial_Aramaic),
+		"In":                                 reflect.ValueOf(unicode.In),
+		"Inherited":                          reflect.ValueOf(unicode.Inherited),
+		"Inscriptional_Pahlavi":              reflect.ValueOf(unicode.Inscriptional_Pahlavi),
+		"Inscriptional_Parthian":             reflect.ValueOf(unicode.Inscriptional_Parthian),
+		"Is":                                 reflect.ValueOf(unicode.Is),
+		"IsControl":                          reflect.ValueOf(unicode.IsControl),
+		"IsDigit":                            reflect.ValueOf(unicode.IsDigit),
+		"IsGraphic":                          reflect.ValueOf(unicode.IsGraphic),
+		"IsLetter":                           reflect.ValueOf(unicode.IsLetter),
+		"IsLower":                            reflect.ValueOf(unicode.IsLower),
+		"IsMark":                             reflect.ValueOf(unicode.IsMark),
+		"IsNumber":                           reflect.ValueOf(unicode.IsNumber),
+		"IsOneOf":                            reflect.ValueOf(unicode.IsOneOf),
+		"IsPrint":                            reflect.ValueOf(unicode.IsPrint),
+		"IsPunct":                            reflect.ValueOf(unicode.IsPunct),
+		"IsSpace":                            reflect.ValueOf(unicode.IsSpace),
+		"IsSymbol":                           reflect.ValueOf(unicode.IsSymbol),
+		"IsTitle":                            reflect.ValueOf(unicode.IsTitle),
+		"IsUpper":                            reflect.ValueOf(unicode.IsUpper),
+		"Javanese":                           reflect.ValueOf(unicode.Javanese),
+		"Join_Control":                       reflect.ValueOf(unicode.Join_Control),
+		"Kaithi":                             reflect.ValueOf(unicode.Kaithi),
+		"Kannada":                            reflect.ValueOf(unicode.Kannada),
+		"Katakana":                           reflect.ValueOf(unicode.Katakana),
+		"Kayah_Li":                           reflect.ValueOf(unicode.Kayah_Li),
+		"Kharoshthi":                         reflect.ValueOf(unicode.Kharoshthi),
+		"Khitan_Small_Script":                reflect.ValueOf(unicode.Khitan_Small_Script),
+		"Khmer":                              reflect.ValueOf(unicode.Khmer),
+		"Khojki":                             reflect.ValueOf(unicode.Khojki),
+		"Khudawadi":                          reflect.ValueOf(unicode.Khudawadi),
+		"L":                                  reflect.ValueOf(unicode.L),
+		"Lao":                                reflect.ValueOf(unicode.Lao),
+		"Latin":                              reflect.ValueOf(unicode.Latin),
+		"Lepcha":                             reflect.ValueOf(unicode.Lepcha),
+		"Letter":                             reflect.ValueOf(unicode.Letter),
+		"Limbu":                              reflect.ValueOf(unicode.Limbu),
+		"Linear_A":                           reflect.ValueOf(unicode.Linear_A),
+		"Linear_B":                           reflect.ValueOf(unicode.Linear_B),
+		"Lisu":                               reflect.ValueOf(unicode.Lisu),
+		"Ll":                                 reflect.ValueOf(unicode.Ll),
+		"Lm":                                 reflect.ValueOf(unicode.Lm),
+		"Lo":                                 reflect.ValueOf(unicode.Lo),
+		"Logical_Order_Exception":            reflect.ValueOf(unicode.Logical_Order_Exception),
+		"Lower":                              reflect.ValueOf(unicode.Lower),
+		"LowerCase":                          reflect.ValueOf(unicode.LowerCase),
+		"Lt":                                 reflect.ValueOf(unicode.Lt),
+		"Lu":                                 reflect.ValueOf(unicode.Lu),
+		"Lycian":                             reflect.ValueOf(unicode.Lycian),
+		"Lydian":                             reflect.ValueOf(unicode.Lydian),
+		"M":                                  reflect.ValueOf(unicode.M),
+		"Mahajani":                           reflect.ValueOf(unicode.Mahajani),
+		"Makasar":                            reflect.ValueOf(unicode.Makasar),
+		"Malayalam":                          reflect.ValueOf(unicode.Malayalam),
+		"Mandaic":                            reflect.ValueOf(unicode.Mandaic),
+		"Manichaean":                         reflect.ValueOf(unicode.Manichaean),
+		"Marchen":                            reflect.ValueOf(unicode.Marchen),
+		"Mark":                               reflect.ValueOf(unicode.Mark),
+		"Masaram_Gondi":                      reflect.ValueOf(unicode.Masaram_Gondi),
+		"MaxASCII":                           reflect.ValueOf(unicode.MaxASCII),
+		"MaxCase":                            reflect.ValueOf(unicode.MaxCase),
+		"MaxLatin1":                          reflect.ValueOf(unicode.MaxLatin1),
+		"MaxRune":                            reflect.ValueOf(unicode.MaxRune),
+		"Mc":                                 reflect.ValueOf(unicode.Mc),
+		"Me":                                 reflect.ValueOf(unicode.Me),
+		"Medefaidrin":                        reflect.ValueOf(unicode.Medefaidrin),
+		"Meetei_Mayek":                       reflect.ValueOf(unicode.Meetei_Mayek),
+		"Mende_Kikakui":                      reflect.ValueOf(unicode.Mende_Kikakui),
+		"Meroitic_Cursive":                   reflect.ValueOf(unicode.Meroitic_Cursive),
+		"Meroitic_Hieroglyphs":               reflect.ValueOf(unicode.Meroitic_Hieroglyphs),
+		"Miao":                               reflect.ValueOf(unicode.Miao),
+		"Mn":                                 reflect.ValueOf(unicode.Mn),
+		"Modi":                               reflect.ValueOf(unicode.Modi),
+		"Mongolian":                          reflect.ValueOf(unicode.Mongolian),
+		"Mro":                                reflect.ValueOf(unicode.Mro),
+		"Multani":                            reflect.ValueOf(unicode.Multani),
+		"Myanmar":                            reflect.ValueOf(unicode.Myanmar),
+		"N":                                  reflect.ValueOf(unicode.N),
+		"Nabataean":                          reflect.ValueOf(unicode.Nabataean),
+		"Nandinagari":                        reflect.ValueOf(unicode.Nandinagari),
+		"Nd":                                 reflect.ValueOf(unicode.Nd),
+		"New_Tai_Lue":                        reflect.ValueOf(unicode.New_Tai_Lue),
+		"Newa":                               reflect.ValueOf(unicode.Newa),
+		"Nko":                                reflect.ValueOf(unicode.Nko),
+		"Nl":                                 reflect.ValueOf(unicode.Nl),
+		"No":                                 reflect.ValueOf(unicode.No),
+		"Noncharacter_Code_Point":            reflect.ValueOf(unicode.Noncharacter_Code_Point),
+		"Number":                             reflect.ValueOf(unicode.Number),
+		"Nushu":                              reflect.ValueOf(unicode.Nushu),
+		"Nyiakeng_Puachue_Hmong":             reflect.ValueOf(unicode.Nyiakeng_Puachue_Hmong),
+		"Ogham":                              reflect.ValueOf(unicode.Ogham),
+		"Ol_Chiki":                           reflect.ValueOf(unicode.Ol_Chiki),
+		"Old_Hungarian":                      reflect.ValueOf(unicode.Old_Hungarian),
+		"Old_Italic":                         reflect.ValueOf(unicode.Old_Italic),
+		"Old_North_Arabian":                  reflect.ValueOf(unicode.Old_North_Arabian),
+		"Old_Permic":                         reflect.ValueOf(unicode.Old_Permic),
+		"Old_Persian":                        reflect.ValueOf(unicode.Old_Persian),
+		"Old_Sogdian":                        reflect.ValueOf(unicode.Old_Sogdian),
+		"Old_South_Arabian":                  reflect.ValueOf(unicode.Old_South_Arabian),
+		"Old_Turkic":                         reflect.ValueOf(unicode.Old_Turkic),
+		"Oriya":                              reflect.ValueOf(unicode.Oriya),
+		"Osage":                              reflect.ValueOf(unicode.Osage),
+		"Osmanya":                            reflect.ValueOf(unicode.Osmanya),
+		"Other":                              reflect.ValueOf(unicode.Other),
+		"Other_Alphabetic":                   reflect.ValueOf(unicode.Other_Alphabetic),
+		"Other_Default_Ignorable_Code_Point": reflect.ValueOf(unicode.Other_Default_Ignorable_Code_Point),
+		"Other_Grapheme_Extend":              reflect.ValueOf(unicode.Other_Grapheme_Extend),
+		"Other_ID_Continue":                  reflect.ValueOf(unicode.Other_ID_Continue),
+		"Other_ID_Start":                     reflect.ValueOf(unicode.Other_ID_Start),
+		"Other_Lowercase":                    reflect.ValueOf(unicode.Other_Lowercase),
+		"Other_Math":                         reflect.ValueOf(unicode.Other_Math),
+		"Other_Uppercase":                    reflect.ValueOf(unicode.Other_Uppercase),
+		"P":                                  reflect.ValueOf(unicode.P),
+		"Pahawh_Hmong":                       reflect.ValueOf(unicode.Pahawh_Hmong),
+		"Palmyrene":                          reflect.ValueOf(unicode.Palmyrene),
+		"Pattern_Syntax":                     reflect.ValueOf(unicode.Pattern_Syntax),
+		"Pattern_White_Space":                reflect.ValueOf(unicode.Pattern_White_Space),
+		"Pau_Cin_Hau":                        reflect.ValueOf(unicode.Pau_Cin_Hau),
+		"Pc":                                 reflect.ValueOf(unicode.Pc),
+		"Pd":                                 reflect.ValueOf(unicode.Pd),
+		"Pe":                                 reflect.ValueOf(unicode.Pe),
+		"Pf":                                 reflect.ValueOf(unicode.Pf),
+		"Phags_Pa":                           reflect.ValueOf(unicode.Phags_Pa),
+		"Phoenician":                         reflect.ValueOf(unicode.Phoenician),
+		"Pi":                                 reflect.ValueOf(unicode.Pi),
+		"Po":                                 reflect.ValueOf(unicode.Po),
+		"Prepended_Concatenation_Mark":       reflect.ValueOf(unicode.Prepended_Concatenation_Mark),
+		"PrintRanges":                        reflect.ValueOf(unicode.PrintRanges),
+		"Properties":                         reflect.ValueOf(unicode.Properties),
+		"Ps":                                 reflect.ValueOf(unicode.Ps),
+		"Psalter_Pahlavi":                    reflect.ValueOf(unicode.Psalter_Pahlavi),
+		"Punct":                              reflect.ValueOf(unicode.Punct),
+		"Quotation_Mark":                     reflect.ValueOf(unicode.Quotation_Mark),
+		"Radical":                            reflect.ValueOf(unicode.Radical),
+		"Regional_Indicator":                 reflect.ValueOf(unicode.Regional_Indicator),
+		"Rejang":                             reflect.ValueOf(unicode.Rejang),
+		"ReplacementChar":                    reflect.ValueOf(unicode.ReplacementChar),
+		"Runic":                              reflect.ValueOf(unicode.Runic),
+		"S":                                  reflect.ValueOf(unicode.S),
+		"STerm":                              reflect.ValueOf(unicode.STerm),
+		"Samaritan":                          reflect.ValueOf(unicode.Samaritan),
+		"Saurashtra":                         reflect.ValueOf(unicode.Saurashtra),
+		"Sc":                                 reflect.ValueOf(unicode.Sc),
+		"Scripts":                            reflect.ValueOf(unicode.Scripts),
+		"Sentence_Terminal":                  reflect.ValueOf(unicode.Sentence_Terminal),
+		"Sharada":                            reflect.ValueOf(unicode.Sharada),
+		"Shavian":                            reflect.ValueOf(unicode.Shavian),
+		"Siddham":                            reflect.ValueOf(unicode.Siddham),
+		"SignWriting":                        reflect.ValueOf(unicode.SignWriting),
+		"SimpleFold":                         reflect.ValueOf(unicode.SimpleFold),
+		"Sinhala":                            reflect.ValueOf(unicode.Sinhala),
+		"Sk":                                 reflect.ValueOf(unicode.Sk),
+		"Sm":                                 reflect.ValueOf(unicode.Sm),
+		"So":                                 reflect.ValueOf(unicode.So),
+		"Soft_Dotted":                        reflect.ValueOf(unicode.Soft_Dotted),
+		"Sogdian":                            reflect.ValueOf(unicode.Sogdian),
+		"Sora_Sompeng":                       reflect.ValueOf(unicode.Sora_Sompeng),
+		"Soyombo":                            reflect.ValueOf(unicode.Soyombo),
+		"Space":                              reflect.ValueOf(unicode.Space),
+		"Sundanese":                          reflect.ValueOf(unicode.Sundanese),
+		"Syloti_Nagri":                       reflect.ValueOf(unicode.Syloti_Nagri),
+		"Symbol":                             reflect.ValueOf(unicode.Symbol),
+		"Syriac":                             reflect.ValueOf(unicode.Syriac),
+		"Tagalog":                            reflect.ValueOf(unicode.Tagalog),
+		"Tagbanwa":                           reflect.ValueOf(unicode.Tagbanwa),
+		"Tai_Le":                             reflect.ValueOf(unicode.Tai_Le),
+		"Tai_Tham":                           reflect.ValueOf(unicode.Tai_Tham),
+		"Tai_Viet":                           reflect.ValueOf(unicode.Tai_Viet),
+		"Takri":                              reflect.ValueOf(unicode.Takri),
+		"Tamil":                              reflect.ValueOf(unicode.Tamil),
+		"Tangut":                             reflect.ValueOf(unicode.Tangut),
+		"Telugu":                             reflect.ValueOf(unicode.Telugu),
+		"Terminal_Punctuation":               reflect.ValueOf(unicode.Terminal_Punctuation),
+		"Thaana":                             reflect.ValueOf(unicode.Thaana),
+		"Thai":                               reflect.ValueOf(unicode.Thai),
+		"Tibetan":                            reflect.ValueOf(unicode.Tibetan),
+		"Tifinagh":                           reflect.ValueOf(unicode.Tifinagh),
+		"Tirhuta":                            reflect.ValueOf(unicode.Tirhuta),
+		"Title":                              reflect.ValueOf(unicode.Title),
+		"TitleCase":                          reflect.ValueOf(unicode.TitleCase),
+		"To":                                 reflect.ValueOf(unicode.To),
+		"ToLower":                            reflect.ValueOf(unicode.ToLower),
+		"ToTitle":                            reflect.ValueOf(unicode.ToTitle),
+		"ToUpper":                            reflect.ValueOf(unicode.ToUpper),
+		"TurkishCase":                        reflect.ValueOf(unicode.TurkishCase),
+		"Ugaritic":                           reflect.ValueOf(unicode.Ugaritic),
+		"Unified_Ideograph":                  reflect.ValueOf(unicode.Unified_Ideograph),
+		"Upper":                              reflect.ValueOf(unicode.Upper),
+		"UpperCase":                          reflect.ValueOf(unicode.UpperCase),
+		"UpperLower":                         reflect.ValueOf(unicode.UpperLower),
+		"Vai":                                reflect.ValueOf(unicode.Vai),
+		"Variation_Selector":                 reflect.ValueOf(unicode.Variation_Selector),
+		"Version":                            reflect.ValueOf(unicode.Version),
+		"Wancho":                             reflect.ValueOf(unicode.Wancho),
+		"Warang_Citi":                        reflect.ValueOf(unicode.Warang_Citi),
+		"White_Space":                        reflect.ValueOf(unicode.White_Space),
+		"Yezidi":                             reflect.ValueOf(unicode.Yezidi),
+		"Yi":                                 reflect.ValueOf(unicode.Yi),
+		"Z":                                  reflect.ValueOf(unicode.Z),
+		"Zanabazar_Square":                   reflect.ValueOf(unicode.Zanabazar_Square),
+		"Zl":                                 reflect.ValueOf(unicode.Zl),
+		"Zp":                                 reflect.ValueOf(unicode.Zp),
+		"Zs":                                 reflect.ValueOf(unicode.Zs),
+	}
+	env.PackageTypes["unicode"] = map[string]reflect.Type{
+		"CaseRange":   reflect.TypeOf(unicode.CaseRange{}),
+		"Range16":     reflect.TypeOf(unicode.Range16{}),
+		"Range32":     reflect.TypeOf(unicode.Range32{}),
+		"RangeTable":  reflect.TypeOf(unicode.RangeTable{}),
+		"SpecialCase": reflect.TypeOf((*unicode.SpecialCase)(nil)).Elem(),
+	}
+	env.PackageDocs["unicode"] = map[string]string{
+		"ASCII_Hex_Digit":                    "These variables have type *RangeTable.",
+		"Adlam":                              "These variables have type *RangeTable.",
+		"Ahom":                               "These variables have type *RangeTable.",
+		"Anatolian_Hieroglyphs":              "These variables have type *RangeTable.",
+		"Arabic":                             "These variables have type *RangeTable.",
+		"Armenian":                           "These variables have type *RangeTable.",
+		"Avestan":                            "These variables have type *RangeTable.",
+		"Balinese":                           "These variables have type *RangeTable.",
+		"Bamum":                              "These variables have type *RangeTable.",
+		"Bassa_Vah":                          "These variables have type *RangeTable.",
+		"Batak":                              "These variables have type *RangeTable.",
+		"Bengali":                            "These variables have type *RangeTable.",
+		"Bhaiksuki":                          "These variables have type *RangeTable.",
+		"Bidi_Control":                       "These variables have type *RangeTable.",
+		"Bopomofo":                           "These variables have type *RangeTable.",
+		"Brahmi":                             "These variables have type *RangeTable.",
+		"Braille":                            "These variables have type *RangeTable.",
+		"Buginese":                           "These variables have type *RangeTable.",
+		"Buhid":                              "These variables have type *RangeTable.",
+		"C":                                  "These variables have type *RangeTable.",
+		"Canadian_Aboriginal":                "These variables have type *RangeTable.",
+		"Carian":                             "These variables have type *RangeTable.",
+		"CaseRange":                          "CaseRange represents a range of Unicode code points for simple (one code point to one code point) case conversion.",
+		"CaseRanges":                         "CaseRanges is the table describing case mappings for all letters with non-self mappings.",
+		"Categories":                         "Categories is the set of Unicode category tables.",
+		"Caucasian_Albanian":                 "These variables have type *RangeTable.",
+		"Cc":                                 "These variables have type *RangeTable.",
+		"Cf":                                 "These variables have type *RangeTable.",
+		"Chakma":                             "These variables have type *RangeTable.",
+		"Cham":                               "These variables have type *RangeTable.",
+		"Cherokee":                           "These variables have type *RangeTable.",
+		"Chorasmian":                         "These variables have type *RangeTable.",
+		"Co":                                 "These variables have type *RangeTable.",
+		"Common":                             "These variables have type *RangeTable.",
+		"Coptic":                             "These variables have type *RangeTable.",
+		"Cs":                                 "These variables have type *RangeTable.",
+		"Cuneiform":                          "These variables have type *RangeTable.",
+		"Cypriot":                            "These variables have type *RangeTable.",
+		"Cyrillic":                           "These variables have type *RangeTable.",
+		"Dash":                               "These variables have type *RangeTable.",
+		"Deprecated":                         "These variables have type *RangeTable.",
+		"Deseret":                            "These variables have type *RangeTable.",
+		"Devanagari":                         "These variables have type *RangeTable.",
+		"Diacritic":                          "These variables have type *RangeTable.",
+		"Digit":                              "These variables have type *RangeTable.",
+		"Dives_Akuru":                        "These variables have type *RangeTable.",
+		"Dogra":                              "These variables have type *RangeTable.",
+		"Duployan":                           "These variables have type *RangeTable.",
+		"Egyptian_Hieroglyphs":               "These variables have type *RangeTable.",
+		"Elbasan":                            "These variables have type *RangeTable.",
+		"Elymaic":                            "These variables have type *RangeTable.",
+		"Ethiopic":                           "These variables have type *RangeTable.",
+		"Extender":                           "These variables have type *RangeTable.",
+		"FoldCategory":                       "FoldCategory maps a category name to a table of code points outside the category that are equivalent under simple case folding to code points inside the category.",
+		"FoldScript":                         "FoldScript maps a script name to a table of code points outside the script that are equivalent under simple case folding to code points inside the script.",
+		"Georgian":                           "These variables have type *RangeTable.",
+		"Glagolitic":                         "These variables have type *RangeTable.",
+		"Gothic":                             "These variables have type *RangeTable.",
+		"Grantha":                            "These variables have type *RangeTable.",
+		"GraphicRanges":                      "GraphicRanges defines the set of graphic characters according to Unicode.",
+		"Greek":                              "These variables have type *RangeTable.",
+		"Gujarati":                           "These variables have type *RangeTable.",
+		"Gunjala_Gondi":                      "These variables have type *RangeTable.",
+		"Gurmukhi":                           "These variables have type *RangeTable.",
+		"Han":                                "These variables have type *RangeTable.",
+		"Hangul":                             "These variables have type *RangeTable.",
+		"Hanifi_Rohingya":                    "These variables have type *RangeTable.",
+		"Hanunoo":                            "These variables have type *RangeTable.",
+		"Hatran":                             "These variables have type *RangeTable.",
+		"Hebrew":                             "These variables have type *RangeTable.",
+		"Hex_Digit":                          "These variables have type *RangeTable.",
+		"Hiragana":                           "These variables have type *RangeTable.",
+		"Hyphen":                             "These variables have type *RangeTable.",
+		"IDS_Binary_Operator":                "These variables have type *RangeTable.",
+		"IDS_Trinary_Operator":               "These variables have type *RangeTable.",
+		"Ideographic":                        "These variables have type *RangeTable.",
+		"Imperial_Aramaic":                   "These variables have type *RangeTable.",
+		"In":                                 "In reports whether the rune is a member of one of the ranges.",
+		"Inherited":                          "These variables have type *RangeTable.",
+		"Inscriptional_Pahlavi":              "These variables have type *RangeTable.",
+		"Inscriptional_Parthian":             "These variables have type *RangeTable.",
+		"Is":                                 "Is reports whether the rune is in the specified table of ranges.",
+		"IsControl":                          "IsControl reports whether the rune is a control character.",
+		"IsDigit":                            "IsDigit reports whether the rune is a decimal digit.",
+		"IsGraphic":                          "IsGraphic reports whether the rune is defined as a Graphic by Unicode.",
+		"IsLetter":                           "IsLetter reports whether the rune is a letter (category [L]).",
+		"IsLower":                            "IsLower reports whether the rune is a lower case letter.",
+		"IsMark":                             "IsMark reports whether the rune is a mark character (category [M]).",
+		"IsNumber":                           "IsNumber reports whether the rune is a number (category [N]).",
+		"IsOneOf":                            "IsOneOf reports whether the rune is a member of one of the ranges.",
+		"IsPrint":                            "IsPrint reports whether the rune is defined as printable by Go.",
+		"IsPunct":                            "IsPunct reports whether the rune is a Unicode punctuation character (category [P]).",
+		"IsSpace":                            "IsSpace reports whether the rune is a space character as defined by Unicode's White Space property; in the Latin-1 space this is",
+		"IsSymbol":                           "IsSymbol reports whether the rune is a symbolic character.",
+		"IsTitle":                            "IsTitle reports whether the rune is a title case letter.",
+		"IsUpper":                            "IsUpper reports whether the rune is an upper case letter.",
+		"Javanese":                           "These variables have type *RangeTable.",
+		"Join_Control":                       "These variables have type *RangeTable.",
+		"Kaithi":                             "These variables have type *RangeTable.",
+		"Kannada":                            "These variables have type *RangeTable.",
+		"Katakana":                           "These variables have type *RangeTable.",
+		"Kayah_Li":                           "These variables have type *RangeTable.",
+		"Kharoshthi":                         "These variables have type *RangeTable.",
+		"Khitan_Small_Script":                "These variables have type *RangeTable.",
+		"Khmer":                              "These variables have type *RangeTable.",
+		"Khojki":                             "These variables have type *RangeTable.",
+		"Khudawadi":                          "These variables have type *RangeTable.",
+		"L":                                  "These variables have type *RangeTable.",
+		"Lao":                                "These variables have type *RangeTable.",
+		"Latin":                              "These variables have type *RangeTable.",
+		"Lepcha":                             "These variables have type *RangeTable.",
+		"Letter":                             "These variables have type *RangeTable.",
+		"Limbu":                              "These variables have type *RangeTable.",
+		"Linear_A":                           "These variables have type *RangeTable.",
+		"Linear_B":                           "These variables have type *RangeTable.",
+		"Lisu":                               "These variables have type *RangeTable.",
+		"Ll":                                 "These variables have type *RangeTable.",
+		"Lm":                                 "These variables have type *RangeTable.",
+		"Lo":                                 "These variables have type *RangeTable.",
+		"Logical_Order_Exception":            "These variables have type *RangeTable.",
+		"Lower":                              "These variables have type *RangeTable.",
+		"LowerCase":                          "Indices into the Delta arrays inside CaseRanges for case mapping.",
+		"Lt":                                 "These variables have type *RangeTable.",
+		"Lu":                                 "These variables have type *RangeTable.",
+		"Lycian":                             "These variables have type *RangeTable.",
+		"Lydian":                             "These variables have type *RangeTable.",
+		"M":                                  "These variables have type *RangeTable.",
+		"Mahajani":                           "These variables have type *RangeTable.",
+		"Makasar":                            "These variables have type *RangeTable.",
+		"Malayalam":                          "These variables have type *RangeTable.",
+		"Mandaic":                            "These variables have type *RangeTable.",
+		"Manichaean":                         "These variables have type *RangeTable.",
+		"Marchen":                            "These variables have type *RangeTable.",
+		"Mark":                               "These variables have type *RangeTable.",
+		"Masaram_Gondi":                      "These variables have type *RangeTable.",
+		"MaxASCII":                           "maximum ASCII value.",
+		"MaxCase":                            "Indices into the Delta arrays inside CaseRanges for case mapping.",
+		"MaxLatin1":                          "maximum Latin-1 value.",
+		"MaxRune":                            "Maximum valid Unicode code point.",
+		"Mc":                                 "These variables have type *RangeTable.",
+		"Me":                                 "These variables have type *RangeTable.",
+		"Medefaidrin":                        "These variables have type *RangeTable.",
+		"Meetei_Mayek":                       "These variables have type *RangeTable.",
+		"Mende_Kikakui":                      "These variables have type *RangeTable.",
+		"Meroitic_Cursive":                   "These variables have type *RangeTable.",
+		"Meroitic_Hieroglyphs":               "These variables have type *RangeTable.",
+		"Miao":                               "These variables have type *RangeTable.",
+		"Mn":                                 "These variables have type *RangeTable.",
+		"Modi":                               "These variables have type *RangeTable.",
+		"Mongolian":                          "These variables have type *RangeTable.",
+		"Mro":                                "These variables have type *RangeTable.",
+		"Multani":                            "These variables have type *RangeTable.",
+		"Myanmar":                            "These variables have type *RangeTable.",
+		"N":                                  "These variables have type *RangeTable.",
+		"Nabataean":                          "These variables have type *RangeTable.",
+		"Nandinagari":                        "These variables have type *RangeTable.",
+		"Nd":                                 "These variables have type *RangeTable.",
+		"New_Tai_Lue":                        "These variables have type *RangeTable.",
+		"Newa":                               "These variables have type *RangeTable.",
+		"Nko":                                "These variables have type *RangeTable.",
+		"Nl":                                 "These variables have type *RangeTable.",
+		"No":                                 "These variables have type *RangeTable.",
+		"Noncharacter_Code_Point":            "These variables have type *RangeTable.",
+		"Number":                             "These variables have type *RangeTable.",
+		"Nushu":                              "These variables have type *RangeTable.",
+		"Nyiakeng_Puachue_Hmong":             "These variables have type *RangeTable.",
+		"Ogham":                              "These variables have type *RangeTable.",
+		"Ol_Chiki":                           "These variables have type *RangeTable.",
+		"Old_Hungarian":                      "These variables have type *RangeTable.",
+		"Old_Italic":                         "These variables have type *RangeTable.",
+		"Old_North_Arabian":                  "These variables have type *RangeTable.",
+		"Old_Permic":                         "These variables have type *RangeTable.",
+		"Old_Persian":                        "These variables have type *RangeTable.",
+		"Old_Sogdian":                        "These variables have type *RangeTable.",
+		"Old_South_Arabian":                  "These variables have type *RangeTable.",
+		"Old_Turkic":                         "These variables have type *RangeTable.",
+		"Oriya":                              "These variables have type *RangeTable.",
+		"Osage":                              "These variables have type *RangeTable.",
+		"Osmanya":                            "These variables have type *RangeTable.",
+		"Other":                              "These variables have type *RangeTable.",
+		"Other_Alphabetic":                   "These variables have type *RangeTable.",
+		"Other_Default_Ignorable_Code_Point": "These variables have type *RangeTable.",
+		"Other_Grapheme_Extend":              "These variables have type *RangeTable.",
+		"Other_ID_Continue":                  "These variables have type *RangeTable.",
+		"Other_ID_Start":                     "These variables have type *RangeTable.",
+		"Other_Lowercase":                    "These variables have type *RangeTable.",
+		"Other_Math":                         "These variables have type *RangeTable.",
+		"Other_Uppercase":                    "These variables have type *RangeTable.",
+		"P":                                  "These variables have type *RangeTable.",
+		"Pahawh_Hmong":                       "These variables have type *RangeTable.",
+		"Palmyrene":                          "These variables have type *RangeTable.",
+		"Pattern_Syntax":                     "These variables have type *RangeTable.",
+		"Pattern_White_Space":                "These variables have type *RangeTable.",
+		"Pau_Cin_Hau":                        "These variables have type *RangeTable.",
+		"Pc":                                 "These variables have type *RangeTable.",
+		"Pd":                                 "These variables have type *RangeTable.",
+		"Pe":                                 "These variables have type *RangeTable.",
+		"Pf":                                 "These variables have type *RangeTable.",
+		"Phags_Pa":                           "These variables have type *RangeTable.",
+		"Phoenician":                         "These variables have type *RangeTable.",
+		"Pi":                                 "These variables have type *RangeTable.",
+		"Po":                                 "These variables have type *RangeTable.",
+		"Prepended_Concatenation_Mark":       "These variables have type *RangeTable.",
+		"PrintRanges":                        "PrintRanges defines the set of printable characters according to Go.",
+		"Properties":                         "Properties is the set of Unicode property tables.",
+		"Ps":                                 "These variables have type *RangeTable.",
+		"Psalter_Pahlavi":                    "These variables have type *RangeTable.",
+		"Punct":                              "These variables have type *RangeTable.",
+		"Quotation_Mark":                     "These variables have type *RangeTable.",
+		"Radical":                            "These variables have type *RangeTable.",
+		"Range16":                            "Range16 represents of a range of 16-bit Unicode code points.",
+		"Range32":                            "Range32 represents of a range of Unicode code points and is used when one or more of the values will not fit in 16 bits.",
+		"RangeTable":                         "RangeTable defines a set of Unicode code points by listing the ranges of code points within the set.",
+		"Regional_Indicator":                 "These variables have type *RangeTable.",
+		"Rejang":                             "These variables have type *RangeTable.",
+		"ReplacementChar":                    "Represents invalid code points.",
+		"Runic":                              "These variables have type *RangeTable.",
+		"S":                                  "These variables have type *RangeTable.",
+		"STerm":                              "These variables have type *RangeTable.",
+		"Samaritan":                          "These variables have type *RangeTable.",
+		"Saurashtra":                         "These variables have type *RangeTable.",
+		"Sc":                                 "These variables have type *RangeTable.",
+		"Scripts":                            "Scripts is the set of Unicode script tables.",
+		"Sentence_Terminal":                  "These variables have type *RangeTable.",
+		"Sharada":                            "These variables have type *RangeTable.",
+		"Shavian":                            "These variables have type *RangeTable.",
+		"Siddham":                            "These variables have type *RangeTable.",
+		"SignWriting":                        "These variables have type *RangeTable.",
+		"SimpleFold":                         "SimpleFold iterates over Unicode code points equivalent under the Unicode-defined simple case folding.",
+		"Sinhala":                            "These variables have type *RangeTable.",
+		"Sk":                                 "These variables have type *RangeTable.",
+		"Sm":                                 "These variables have type *RangeTable.",
+		"So":                                 "These variables have type *RangeTable.",
+		"Soft_Dotted":                        "These variables have type *RangeTable.",
+		"Sogdian":                            "These variables have type *RangeTable.",
+		"Sora_Sompeng":                       "These variables have type *RangeTable.",
+		"Soyombo":                            "These variables have type *RangeTable.",
+		"Space":                              "These variables have type *RangeTable.",
+		"SpecialCase":                        "SpecialCase represents language-specific case mappings such as Turkish.",
+		"SpecialCase.ToLower":                "ToLower maps the rune to lower case giving priority to the special mapping.",
+		"SpecialCase.ToTitle":                "ToTitle maps the rune to title case giving priority to the special mapping.",
+		"SpecialCase.ToUpper":                "ToUpper maps the rune to upper case giving priority to the special mapping.",
+		"Sundanese":                          "These variables have type *RangeTable.",
+		"Syloti_Nagri":                       "These variables have type *RangeTable.",
+		"Symbol":                             "These variables have type *RangeTable.",
+		"Syriac":                             "These variables have type *RangeTable.",
+		"Tagalog":                            "These variables have type *RangeTable.",
+		"Tagbanwa":                           "These variables have type *RangeTable.",
+		"Tai_Le":                             "These variables have type *RangeTable.",
+		"Tai_Tham":                           "These variables have type *RangeTable.",
+		"Tai_Viet":                           "These variables have type *RangeTable.",
+		"Takri":                              "These variables have type *RangeTable.",
+		"Tamil":                              "These variables have type *RangeTable.",
+		"Tangut":                             "These variables have type *RangeTable.",
+		"Telugu":                             "These variables have type *RangeTable.",
+		"Terminal_Punctuation":               "These variables have type *RangeTable.",
+		"Thaana":                             "These variables have type *RangeTable.",
+		"Thai":                               "These variables have type *RangeTable.",
+		"Tibetan":                            "These variables have type *RangeTable.",
+		"Tifinagh":                           "These variables have type *RangeTable.",
+		"Tirhuta":                            "These variables have type *RangeTable.",
+		"Title":                              "These variables have type *RangeTable.",
+		"TitleCase":                          "Indices into the Delta arrays inside CaseRanges for case mapping.",
+		"To":                                 "To maps the rune to the specified case: [UpperCase], [LowerCase], or [TitleCase].",
+		"ToLower":                            "ToLower maps the rune to lower case.",
+		"ToTitle":                            "ToTitle maps the rune to title case.",
+		"ToUpper":                            "ToUpper maps the rune to upper case.",
+		"Ugaritic":                           "These variables have type *RangeTable.",
+		"Unified_Ideograph":                  "These variables have type *RangeTable.",
+		"Upper":                              "These variables have type *RangeTable.",
+		"UpperCase":                          "Indices into the Delta arrays inside CaseRanges for case mapping.",
+		"UpperLower":                         "If the Delta field of a [CaseRange] is UpperLower, it means this CaseRange represents a sequence of the form (say) [Upper] [Lower] [Upper] [Lower].",
+		"Vai":                                "These variables have type *RangeTable.",
+		"Variation_Selector":                 "These variables have type *RangeTable.",
+		"Version":                            "Version is the Unicode edition from which the tables are derived.",
+		"Wancho":                             "These variables have type *RangeTable.",
+		"Warang_Citi":                        "These variables have type *RangeTable.",
+		"White_Space":                        "These variables have type *RangeTable.",
+		"Yezidi":                             "These variables have type *RangeTable.",
+		"Yi":                                 "These variables have type *RangeTable.",
+		"Z":                                  "These variables have type *RangeTable.",
+		"Zanabazar_Square":                   "These variables have type *RangeTable.",
+		"Zl":                                 "These variables have type *RangeTable.",
+		"Zp":                                 "These variables have type *RangeTable.",
+		"Zs":                                 "These variables have type *RangeTable.",
+	}
+}
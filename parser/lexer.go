@@ -28,8 +28,14 @@ type Error struct {
 	Pos      ast.Position
 	Filename string
 	Fatal    bool
+	// Incomplete is true if the source ended before the statement, raw string or comment was complete,
+	// so more source could make it parse.
+	Incomplete bool
 }
 
+// errRawStringEOF is returned when the source ends in a raw string or a comment.
+var errRawStringEOF = errors.New("unexpected EOF")
+
 // Error returns the parse error message.
 func (e *Error) Error() string {
 	return e.Message
@@ -292,6 +298,9 @@ retry:
 			case '=':
 				tok = OREQ
 				lit = "|="
+			case '>':
+				tok = PIPE
+				lit = "|>"
 			default:
 				s.back()
 				tok = int(ch)
@@ -507,7 +516,7 @@ func (s *Scanner) scanRawString(l rune) (string, error) {
 	for {
 		s.next()
 		if s.peek() == EOF {
-			return "", errors.New("unexpected EOF")
+			return "", errRawStringEOF
 		}
 		if s.peek() == l {
 			s.next()
@@ -621,20 +630,27 @@ func (s *Scanner) scanEscape() (tok int, val interface{}) {
 // Lexer provides interface to parse codes.
 type Lexer struct {
 	s    *Scanner
+	tok  int
 	lit  string
 	pos  ast.Position
 	e    error
 	stmt ast.Stmt
+	// number is the literal of the last number, negateNumber makes it negative
+	number    *ast.LiteralExpr
+	numberTok ast.Token
+	// numberOverflow is true if the last number only fits in an int64 when negative
+	numberOverflow bool
 }
 
 // Lex scans the token and literals.
 func (l *Lexer) Lex(lval *yySymType) int {
 	tok, lit, pos, err := l.s.Scan()
 	if err != nil {
-		l.e = &Error{Message: err.Error(), Pos: pos, Fatal: true}
+		l.e = &Error{Message: err.Error(), Pos: pos, Fatal: true, Incomplete: err == errRawStringEOF}
 	}
 	lval.tok = ast.Token{Tok: tok, Lit: lit}
 	lval.tok.SetPosition(pos)
+	l.tok = tok
 	l.lit = lit
 	l.pos = pos
 	return tok
@@ -642,7 +658,55 @@ func (l *Lexer) Lex(lval *yySymType) int {
 
 // Error sets parse error.
 func (l *Lexer) Error(msg string) {
-	l.e = &Error{Message: msg, Pos: l.pos, Fatal: false}
+	incomplete := l.tok == EOF
+	if e, ok := l.e.(*Error); ok && e.Incomplete {
+		// the scan error that caused the syntax error was at the end of the source
+		incomplete = true
+	}
+	l.e = &Error{Message: msg, Pos: l.pos, Fatal: false, Incomplete: incomplete}
+}
+
+// numberExpr returns the literal of the number token.
+// A number that only fits in an int64 when negative, like 9223372036854775808,
+// is an error if it is not negated before the next number or the end of the source.
+func (l *Lexer) numberExpr(tok ast.Token) ast.Expr {
+	l.checkNumberOverflow()
+	num, err := toNumber(tok.Lit)
+	if err != nil {
+		if _, negErr := toNumber("-" + tok.Lit); negErr == nil {
+			l.numberOverflow = true
+		} else {
+			l.Error("invalid number: " + tok.Lit)
+		}
+	}
+	l.number = &ast.LiteralExpr{Literal: num}
+	l.number.SetPosition(tok.Position())
+	l.numberTok = tok
+	return l.number
+}
+
+// negateNumber returns the negative literal of the last number if expr is its literal, otherwise nil.
+func (l *Lexer) negateNumber(expr ast.Expr) ast.Expr {
+	if l.number == nil || expr != ast.Expr(l.number) {
+		return nil
+	}
+	num, err := toNumber("-" + l.numberTok.Lit)
+	if err != nil {
+		l.Error("invalid number: -" + l.numberTok.Lit)
+	}
+	literal := &ast.LiteralExpr{Literal: num}
+	literal.SetPosition(l.number.Position())
+	l.number = nil
+	l.numberOverflow = false
+	return literal
+}
+
+// checkNumberOverflow sets the error of the last number if it only fits in an int64 when negative.
+func (l *Lexer) checkNumberOverflow() {
+	if l.numberOverflow {
+		l.e = &Error{Message: "invalid number: " + l.numberTok.Lit, Pos: l.numberTok.Position()}
+		l.numberOverflow = false
+	}
 }
 
 // Parse provides way to parse the code using Scanner.
@@ -651,6 +715,7 @@ func Parse(s *Scanner) (ast.Stmt, error) {
 	if yyParse(&l) != 0 {
 		return nil, l.e
 	}
+	l.checkNumberOverflow()
 	return l.stmt, l.e
 }
 
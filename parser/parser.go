@@ -11,7 +11,7 @@ import (
 	"github.com/mattn/anko/ast"
 )
 
-//line parser.go.y:45
+//line parser.go.y:46
 type yySymType struct {
 	yys int
 	tok ast.Token
@@ -50,6 +50,7 @@ type yySymType struct {
 	op_comparison ast.Operator
 	op_add        ast.Operator
 	op_multiply   ast.Operator
+	op_pipe       ast.Operator
 }
 
 const IDENT = 57346
@@ -107,7 +108,8 @@ const DELETE = 57397
 const CLOSE = 57398
 const MAP = 57399
 const IMPORT = 57400
-const UNARY = 57401
+const PIPE = 57401
+const UNARY = 57402
 
 var yyToknames = [...]string{
 	"$end",
@@ -168,6 +170,7 @@ var yyToknames = [...]string{
 	"CLOSE",
 	"MAP",
 	"IMPORT",
+	"PIPE",
 	"'='",
 	"':'",
 	"'?'",
@@ -182,15 +185,15 @@ var yyToknames = [...]string{
 	"'%'",
 	"'&'",
 	"UNARY",
+	"'('",
+	"'['",
+	"'.'",
 	"'{'",
 	"'}'",
-	"'('",
 	"')'",
 	"','",
 	"';'",
-	"'['",
 	"']'",
-	"'.'",
 	"'!'",
 	"'\\n'",
 }
@@ -201,7 +204,7 @@ const yyEofCode = 1
 const yyErrCode = 2
 const yyInitialStackSize = 16
 
-//line parser.go.y:1090
+//line parser.go.y:1109
 
 //line yacctab:1
 var yyExca = [...]int{
@@ -210,542 +213,531 @@ var yyExca = [...]int{
 	-2, 0,
 	-1, 2,
 	52, 57,
-	59, 57,
-	77, 57,
-	78, 5,
+	60, 57,
+	80, 57,
+	81, 5,
 	-2, 1,
 	-1, 23,
-	77, 58,
+	80, 58,
 	-2, 26,
 	-1, 27,
 	16, 95,
 	-2, 57,
-	-1, 67,
+	-1, 68,
 	52, 57,
-	59, 57,
-	77, 57,
+	60, 57,
+	80, 57,
 	-2, 5,
-	-1, 120,
+	-1, 122,
 	16, 96,
-	77, 96,
+	80, 96,
 	-2, 112,
-	-1, 124,
+	-1, 126,
 	4, 107,
 	48, 107,
 	49, 107,
 	57, 107,
 	-2, 69,
-	-1, 267,
-	74, 181,
-	80, 181,
-	-2, 173,
-	-1, 288,
-	74, 181,
-	-2, 173,
-	-1, 292,
+	-1, 269,
+	78, 182,
+	82, 182,
+	-2, 174,
+	-1, 290,
+	78, 182,
+	-2, 174,
+	-1, 294,
 	1, 60,
 	8, 60,
 	45, 60,
 	46, 60,
 	52, 60,
-	59, 60,
 	60, 60,
-	74, 60,
-	76, 60,
-	77, 60,
+	61, 60,
 	78, 60,
+	79, 60,
 	80, 60,
-	83, 60,
+	81, 60,
+	82, 60,
+	84, 60,
 	-2, 110,
-	-1, 296,
+	-1, 298,
 	1, 17,
 	45, 17,
 	46, 17,
-	74, 17,
 	78, 17,
-	83, 17,
+	81, 17,
+	84, 17,
 	-2, 74,
-	-1, 298,
+	-1, 300,
 	1, 19,
 	45, 19,
 	46, 19,
-	74, 19,
 	78, 19,
-	83, 19,
+	81, 19,
+	84, 19,
 	-2, 76,
-	-1, 328,
-	74, 179,
-	80, 179,
-	-2, 174,
-	-1, 348,
+	-1, 330,
+	78, 180,
+	82, 180,
+	-2, 175,
+	-1, 350,
 	1, 16,
 	45, 16,
 	46, 16,
-	74, 16,
 	78, 16,
-	83, 16,
+	81, 16,
+	84, 16,
 	-2, 73,
-	-1, 349,
+	-1, 351,
 	1, 18,
 	45, 18,
 	46, 18,
-	74, 18,
 	78, 18,
-	83, 18,
+	81, 18,
+	84, 18,
 	-2, 75,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 3907
+const yyLast = 3784
 
 var yyAct = [...]int{
-	71, 268, 33, 23, 232, 320, 321, 36, 116, 323,
-	322, 5, 8, 288, 377, 72, 8, 267, 76, 8,
-	8, 124, 386, 8, 8, 127, 281, 114, 117, 121,
-	214, 214, 282, 283, 84, 135, 130, 214, 85, 214,
-	87, 1, 331, 214, 207, 286, 214, 8, 7, 329,
-	220, 137, 151, 327, 134, 69, 49, 145, 152, 153,
-	154, 155, 156, 213, 217, 202, 142, 373, 23, 149,
-	70, 214, 143, 404, 340, 349, 348, 334, 162, 163,
-	325, 166, 167, 168, 169, 297, 171, 173, 303, 175,
-	149, 210, 176, 177, 178, 179, 180, 181, 182, 183,
+	72, 36, 33, 23, 234, 118, 322, 323, 325, 324,
+	5, 8, 126, 8, 8, 73, 270, 290, 77, 379,
+	6, 8, 331, 8, 222, 216, 69, 116, 119, 123,
+	269, 333, 236, 216, 8, 137, 132, 150, 129, 288,
+	216, 49, 1, 284, 285, 149, 209, 8, 406, 151,
+	342, 329, 205, 128, 147, 71, 204, 152, 153, 154,
+	155, 156, 157, 128, 450, 151, 449, 151, 216, 23,
+	375, 283, 299, 351, 350, 336, 327, 305, 297, 163,
+	164, 276, 167, 168, 169, 170, 445, 172, 174, 440,
+	176, 267, 439, 177, 178, 179, 180, 181, 182, 183,
 	184, 185, 186, 187, 188, 189, 190, 191, 192, 193,
-	194, 195, 196, 197, 198, 136, 159, 69, 295, 206,
-	448, 326, 203, 148, 234, 160, 203, 211, 141, 274,
-	147, 209, 130, 130, 140, 130, 86, 139, 223, 225,
-	226, 170, 203, 130, 130, 233, 130, 265, 149, 236,
-	123, 138, 447, 298, 149, 215, 216, 200, 218, 144,
-	89, 90, 100, 101, 78, 245, 227, 228, 77, 231,
-	443, 248, 438, 252, 437, 69, 435, 428, 427, 6,
-	423, 422, 212, 128, 346, 68, 296, 149, 97, 98,
-	99, 102, 125, 222, 367, 84, 421, 275, 149, 85,
-	238, 87, 419, 255, 235, 86, 259, 246, 262, 410,
-	128, 409, 126, 164, 405, 264, 203, 240, 241, 266,
-	130, 122, 126, 211, 401, 278, 397, 132, 133, 89,
-	90, 233, 229, 395, 287, 285, 131, 444, 291, 249,
-	149, 253, 394, 272, 299, 292, 257, 129, 302, 393,
-	69, 390, 304, 347, 132, 133, 385, 356, 134, 343,
-	311, 315, 317, 131, 84, 308, 301, 293, 85, 254,
-	87, 239, 442, 165, 129, 408, 388, 372, 335, 9,
-	371, 324, 219, 158, 339, 134, 74, 341, 441, 130,
-	345, 290, 323, 322, 436, 201, 294, 79, 430, 333,
-	312, 310, 230, 10, 284, 271, 146, 174, 354, 237,
-	73, 4, 342, 2, 62, 67, 63, 66, 69, 363,
-	64, 65, 126, 47, 368, 366, 365, 330, 46, 126,
-	45, 119, 374, 44, 130, 43, 130, 30, 50, 381,
-	29, 384, 351, 332, 319, 387, 22, 157, 21, 20,
-	25, 355, 24, 3, 391, 357, 358, 375, 360, 378,
-	0, 0, 0, 256, 0, 0, 370, 0, 263, 0,
-	0, 0, 0, 270, 0, 0, 0, 69, 0, 273,
-	369, 412, 0, 126, 414, 0, 0, 0, 126, 389,
-	0, 0, 269, 126, 0, 0, 0, 0, 0, 126,
-	0, 396, 0, 398, 399, 0, 0, 0, 0, 402,
-	0, 0, 269, 406, 407, 0, 0, 233, 434, 0,
-	0, 433, 0, 0, 0, 0, 0, 237, 0, 0,
-	418, 0, 0, 130, 0, 440, 0, 0, 201, 0,
-	0, 0, 424, 0, 0, 425, 426, 328, 344, 0,
-	429, 0, 0, 0, 0, 0, 439, 0, 0, 0,
-	0, 0, 0, 0, 0, 269, 0, 0, 328, 120,
-	52, 53, 0, 0, 31, 0, 48, 0, 0, 364,
-	0, 0, 0, 0, 445, 0, 446, 0, 39, 54,
-	55, 56, 376, 0, 201, 0, 201, 0, 0, 126,
-	86, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	269, 0, 126, 0, 0, 40, 57, 0, 0, 37,
-	0, 0, 41, 38, 89, 90, 100, 101, 0, 0,
-	51, 0, 59, 61, 0, 0, 60, 411, 115, 0,
-	34, 0, 201, 118, 32, 0, 416, 58, 103, 104,
-	105, 0, 97, 98, 99, 102, 0, 126, 0, 84,
-	0, 0, 0, 85, 0, 87, 126, 0, 0, 86,
-	106, 107, 111, 109, 113, 112, 0, 0, 0, 0,
-	83, 0, 0, 0, 0, 91, 92, 94, 95, 96,
-	93, 0, 0, 89, 90, 100, 101, 0, 0, 0,
-	0, 0, 0, 0, 88, 81, 0, 0, 0, 0,
-	0, 0, 80, 269, 82, 108, 110, 103, 104, 105,
-	0, 97, 98, 99, 102, 0, 204, 0, 84, 0,
-	0, 0, 85, 0, 87, 35, 52, 53, 0, 0,
-	31, 13, 48, 14, 26, 0, 27, 0, 0, 0,
-	0, 0, 0, 0, 39, 54, 55, 56, 0, 15,
-	16, 0, 0, 0, 0, 0, 0, 0, 0, 11,
-	12, 0, 0, 0, 0, 28, 0, 0, 17, 0,
-	0, 40, 57, 0, 0, 37, 18, 19, 41, 38,
-	0, 0, 0, 0, 0, 0, 51, 0, 59, 61,
-	0, 0, 60, 0, 42, 0, 34, 0, 0, 0,
-	32, 0, 0, 58, 86, 106, 107, 111, 109, 113,
-	112, 0, 0, 0, 0, 83, 0, 0, 0, 0,
-	91, 92, 94, 95, 96, 93, 0, 0, 89, 90,
-	100, 101, 0, 0, 0, 0, 0, 0, 0, 88,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 82,
-	108, 110, 103, 104, 105, 0, 97, 98, 99, 102,
-	0, 0, 0, 84, 382, 383, 0, 85, 0, 87,
-	86, 106, 107, 111, 109, 113, 112, 0, 0, 0,
-	0, 83, 0, 0, 0, 0, 91, 92, 94, 95,
-	96, 93, 0, 0, 89, 90, 100, 101, 0, 0,
-	0, 0, 0, 0, 0, 88, 0, 0, 0, 0,
-	0, 0, 0, 0, 380, 82, 108, 110, 103, 104,
-	105, 0, 97, 98, 99, 102, 0, 0, 0, 84,
-	0, 0, 0, 85, 379, 87, 86, 106, 107, 111,
-	109, 113, 112, 0, 0, 0, 0, 83, 0, 0,
-	0, 0, 91, 92, 94, 95, 96, 93, 0, 0,
-	89, 90, 100, 101, 0, 0, 0, 0, 0, 0,
-	0, 88, 0, 0, 0, 0, 0, 0, 0, 0,
-	353, 82, 108, 110, 103, 104, 105, 0, 97, 98,
-	99, 102, 0, 0, 0, 84, 0, 0, 0, 85,
-	352, 87, 86, 106, 107, 111, 109, 113, 112, 0,
-	0, 0, 0, 83, 0, 0, 0, 0, 91, 92,
-	94, 95, 96, 93, 0, 0, 89, 90, 100, 101,
-	0, 0, 0, 0, 0, 0, 0, 88, 0, 0,
-	0, 0, 0, 0, 0, 0, 338, 82, 108, 110,
-	103, 104, 105, 0, 97, 98, 99, 102, 0, 0,
-	0, 84, 0, 0, 0, 85, 337, 87, 86, 106,
-	107, 111, 109, 113, 112, 0, 0, 0, 0, 83,
-	0, 0, 0, 0, 91, 92, 94, 95, 96, 93,
-	0, 0, 89, 90, 100, 101, 0, 0, 0, 0,
-	0, 0, 0, 88, 0, 0, 0, 0, 0, 0,
-	0, 0, 307, 82, 108, 110, 103, 104, 105, 0,
-	97, 98, 99, 102, 0, 0, 0, 84, 0, 0,
-	0, 85, 306, 87, 86, 106, 107, 111, 109, 113,
-	112, 0, 0, 0, 0, 83, 0, 0, 0, 0,
-	91, 92, 94, 95, 96, 93, 0, 0, 89, 90,
-	100, 101, 0, 0, 0, 0, 0, 0, 0, 88,
-	0, 0, 0, 0, 0, 0, 0, 0, 277, 82,
-	108, 110, 103, 104, 105, 0, 97, 98, 99, 102,
-	0, 0, 0, 84, 0, 0, 0, 85, 276, 87,
-	86, 106, 107, 111, 109, 113, 112, 0, 0, 0,
-	0, 83, 0, 0, 0, 0, 91, 92, 94, 95,
-	96, 93, 0, 0, 89, 90, 100, 101, 0, 0,
-	0, 0, 0, 0, 0, 88, 0, 0, 0, 0,
-	0, 0, 0, 0, 251, 82, 108, 110, 103, 104,
-	105, 0, 97, 98, 99, 102, 0, 0, 0, 84,
-	0, 0, 0, 85, 250, 87, 86, 106, 107, 111,
-	109, 113, 112, 0, 0, 0, 0, 83, 0, 0,
-	0, 0, 91, 92, 94, 95, 96, 93, 0, 0,
-	89, 90, 100, 101, 0, 0, 0, 0, 0, 0,
-	0, 88, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 82, 108, 110, 103, 104, 105, 0, 97, 98,
-	99, 102, 0, 0, 0, 84, 242, 243, 0, 85,
-	0, 87, 86, 106, 107, 111, 109, 113, 112, 0,
-	0, 0, 0, 83, 0, 0, 0, 0, 91, 92,
-	94, 95, 96, 93, 0, 0, 89, 90, 100, 101,
-	0, 0, 0, 0, 0, 0, 0, 88, 81, 0,
-	0, 0, 0, 0, 0, 80, 0, 82, 108, 110,
-	103, 104, 105, 0, 97, 98, 99, 102, 0, 0,
-	0, 84, 0, 0, 0, 85, 0, 87, 86, 106,
-	107, 111, 109, 113, 112, 0, 0, 0, 0, 83,
-	0, 0, 0, 0, 91, 92, 94, 95, 96, 93,
-	0, 0, 89, 90, 100, 101, 0, 0, 0, 0,
-	0, 0, 0, 88, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 82, 108, 110, 103, 104, 105, 0,
-	97, 98, 99, 102, 0, 0, 0, 84, 432, 0,
-	0, 85, 0, 87, 86, 106, 107, 111, 109, 113,
-	112, 0, 0, 0, 0, 83, 0, 0, 0, 0,
-	91, 92, 94, 95, 96, 93, 0, 0, 89, 90,
-	100, 101, 0, 0, 0, 0, 0, 0, 0, 88,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 82,
-	108, 110, 103, 104, 105, 0, 97, 98, 99, 102,
-	0, 0, 0, 84, 0, 0, 0, 85, 431, 87,
-	86, 106, 107, 111, 109, 113, 112, 0, 0, 0,
-	0, 83, 0, 0, 0, 0, 91, 92, 94, 95,
-	96, 93, 0, 0, 89, 90, 100, 101, 0, 0,
-	0, 0, 0, 0, 0, 88, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 82, 108, 110, 103, 104,
-	105, 0, 97, 98, 99, 102, 0, 0, 0, 84,
-	0, 0, 0, 85, 420, 87, 86, 106, 107, 111,
-	109, 113, 112, 0, 0, 0, 0, 83, 0, 0,
-	0, 0, 91, 92, 94, 95, 96, 93, 0, 0,
-	89, 90, 100, 101, 0, 0, 0, 0, 0, 0,
-	0, 88, 0, 0, 0, 0, 0, 0, 0, 0,
-	417, 82, 108, 110, 103, 104, 105, 0, 97, 98,
-	99, 102, 0, 0, 0, 84, 0, 0, 0, 85,
-	0, 87, 86, 106, 107, 111, 109, 113, 112, 0,
-	0, 0, 0, 83, 0, 0, 0, 0, 91, 92,
-	94, 95, 96, 93, 0, 0, 89, 90, 100, 101,
-	0, 0, 0, 0, 0, 0, 0, 88, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 82, 108, 110,
-	103, 104, 105, 0, 97, 98, 99, 102, 0, 0,
-	0, 84, 415, 0, 0, 85, 0, 87, 86, 106,
-	107, 111, 109, 113, 112, 0, 0, 0, 0, 83,
-	0, 0, 0, 0, 91, 92, 94, 95, 96, 93,
-	0, 0, 89, 90, 100, 101, 0, 0, 0, 0,
-	0, 0, 0, 88, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 82, 108, 110, 103, 104, 105, 0,
-	97, 98, 99, 102, 0, 0, 0, 84, 0, 0,
-	0, 85, 413, 87, 86, 106, 107, 111, 109, 113,
-	112, 0, 0, 0, 0, 83, 0, 0, 0, 0,
-	91, 92, 94, 95, 96, 93, 0, 0, 89, 90,
-	100, 101, 0, 0, 0, 0, 0, 0, 0, 88,
-	0, 0, 0, 0, 0, 0, 0, 0, 403, 82,
-	108, 110, 103, 104, 105, 0, 97, 98, 99, 102,
-	0, 0, 0, 84, 0, 0, 0, 85, 0, 87,
-	86, 106, 107, 111, 109, 113, 112, 0, 0, 0,
-	0, 83, 0, 0, 0, 0, 91, 92, 94, 95,
-	96, 93, 0, 0, 89, 90, 100, 101, 0, 0,
-	0, 0, 0, 0, 0, 88, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 82, 108, 110, 103, 104,
-	105, 0, 97, 98, 99, 102, 0, 400, 0, 84,
-	0, 0, 0, 85, 0, 87, 86, 106, 107, 111,
-	109, 113, 112, 0, 0, 0, 0, 83, 0, 0,
-	0, 0, 91, 92, 94, 95, 96, 93, 0, 0,
-	89, 90, 100, 101, 0, 0, 0, 0, 0, 0,
-	0, 88, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 82, 108, 110, 103, 104, 105, 0, 97, 98,
-	99, 102, 0, 0, 0, 84, 0, 0, 0, 85,
-	392, 87, 86, 106, 107, 111, 109, 113, 112, 0,
-	0, 0, 0, 83, 0, 0, 0, 0, 91, 92,
-	94, 95, 96, 93, 0, 0, 89, 90, 100, 101,
-	0, 0, 0, 0, 0, 0, 0, 88, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 82, 108, 110,
-	103, 104, 105, 0, 97, 98, 99, 102, 0, 361,
-	0, 84, 0, 0, 0, 85, 0, 87, 86, 106,
-	107, 111, 109, 113, 112, 0, 0, 0, 0, 83,
-	0, 0, 0, 0, 91, 92, 94, 95, 96, 93,
-	0, 0, 89, 90, 100, 101, 0, 0, 0, 0,
-	0, 0, 0, 88, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 82, 108, 110, 103, 104, 105, 0,
-	97, 98, 99, 102, 0, 359, 0, 84, 0, 0,
-	0, 85, 0, 87, 86, 106, 107, 111, 109, 113,
-	112, 0, 0, 0, 0, 83, 0, 0, 0, 0,
-	91, 92, 94, 95, 96, 93, 0, 0, 89, 90,
-	100, 101, 0, 0, 0, 0, 0, 0, 0, 88,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 82,
-	108, 110, 103, 104, 105, 0, 97, 98, 99, 102,
-	0, 0, 0, 84, 350, 0, 0, 85, 0, 87,
-	86, 106, 107, 111, 109, 113, 112, 0, 0, 0,
-	0, 83, 0, 0, 0, 0, 91, 92, 94, 95,
-	96, 93, 0, 0, 89, 90, 100, 101, 0, 0,
-	0, 0, 0, 0, 0, 88, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 82, 108, 110, 103, 104,
-	105, 0, 97, 98, 99, 102, 0, 0, 0, 84,
-	0, 0, 318, 85, 0, 87, 86, 106, 107, 111,
-	109, 113, 112, 0, 0, 0, 0, 83, 0, 0,
-	0, 0, 91, 92, 94, 95, 96, 93, 0, 0,
-	89, 90, 100, 101, 0, 0, 0, 0, 0, 0,
-	0, 88, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 82, 108, 110, 103, 104, 105, 0, 97, 98,
-	99, 102, 0, 313, 0, 84, 0, 0, 0, 85,
-	0, 87, 86, 106, 107, 111, 109, 113, 112, 0,
-	0, 0, 0, 83, 0, 0, 0, 0, 91, 92,
-	94, 95, 96, 93, 0, 0, 89, 90, 100, 101,
-	0, 0, 0, 0, 0, 0, 0, 88, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 82, 108, 110,
-	103, 104, 105, 0, 97, 98, 99, 102, 0, 309,
-	0, 84, 0, 0, 0, 85, 0, 87, 86, 106,
-	107, 111, 109, 113, 112, 0, 0, 0, 0, 83,
-	0, 0, 0, 0, 91, 92, 94, 95, 96, 93,
-	0, 0, 89, 90, 100, 101, 0, 0, 0, 0,
-	0, 0, 0, 88, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 82, 108, 110, 103, 104, 105, 0,
-	97, 98, 99, 102, 0, 300, 0, 84, 0, 0,
-	0, 85, 0, 87, 86, 106, 107, 111, 109, 113,
-	112, 0, 0, 0, 0, 83, 0, 0, 0, 0,
-	91, 92, 94, 95, 96, 93, 0, 0, 89, 90,
-	100, 101, 0, 0, 0, 0, 0, 0, 0, 88,
-	0, 0, 0, 0, 0, 0, 0, 0, 289, 82,
-	108, 110, 103, 104, 105, 0, 97, 98, 99, 102,
-	0, 0, 0, 84, 0, 0, 0, 85, 0, 87,
-	86, 106, 107, 111, 109, 113, 112, 0, 0, 0,
-	0, 83, 0, 0, 0, 0, 91, 92, 94, 95,
-	96, 93, 0, 0, 89, 90, 100, 101, 0, 0,
-	0, 0, 0, 0, 0, 88, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 82, 108, 110, 103, 104,
-	105, 0, 97, 98, 99, 102, 0, 0, 0, 84,
-	280, 0, 0, 85, 0, 87, 86, 106, 107, 111,
-	109, 113, 112, 0, 0, 0, 0, 83, 0, 0,
-	0, 0, 91, 92, 94, 95, 96, 93, 0, 0,
-	89, 90, 100, 101, 0, 0, 0, 0, 0, 0,
-	0, 88, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 82, 108, 110, 103, 104, 105, 0, 97, 98,
-	99, 102, 0, 0, 0, 84, 279, 0, 0, 85,
-	0, 87, 86, 106, 107, 111, 109, 113, 112, 0,
-	0, 0, 0, 83, 0, 0, 0, 0, 91, 92,
-	94, 95, 96, 93, 0, 0, 89, 90, 100, 101,
-	0, 0, 0, 0, 0, 0, 0, 88, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 82, 108, 110,
-	103, 104, 105, 0, 97, 98, 99, 102, 0, 0,
-	0, 84, 0, 0, 260, 85, 0, 87, 86, 106,
-	107, 111, 109, 113, 112, 0, 0, 0, 0, 83,
-	0, 0, 0, 0, 91, 92, 94, 95, 96, 93,
-	0, 0, 89, 90, 100, 101, 0, 0, 0, 0,
-	0, 0, 0, 88, 0, 0, 0, 0, 0, 0,
-	0, 0, 247, 82, 108, 110, 103, 104, 105, 0,
-	97, 98, 99, 102, 0, 0, 0, 84, 0, 0,
-	0, 85, 0, 87, 86, 106, 107, 111, 109, 113,
-	112, 0, 0, 0, 0, 83, 0, 0, 0, 0,
-	91, 92, 94, 95, 96, 93, 0, 0, 89, 90,
-	100, 101, 0, 0, 0, 0, 0, 0, 0, 88,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 82,
-	108, 110, 103, 104, 105, 0, 97, 98, 99, 102,
-	0, 0, 0, 84, 244, 0, 0, 85, 0, 87,
-	86, 106, 107, 111, 109, 113, 112, 0, 0, 0,
-	0, 83, 0, 0, 0, 0, 91, 92, 94, 95,
-	96, 93, 0, 0, 89, 90, 100, 101, 0, 0,
-	0, 0, 0, 0, 0, 88, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 82, 108, 110, 103, 104,
-	105, 0, 97, 98, 99, 102, 0, 0, 0, 84,
-	221, 0, 0, 85, 0, 87, 86, 106, 107, 111,
-	109, 113, 112, 0, 0, 0, 0, 83, 0, 0,
-	0, 0, 91, 92, 94, 95, 96, 93, 0, 0,
-	89, 90, 100, 101, 0, 0, 0, 0, 0, 0,
-	0, 88, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 82, 108, 110, 103, 104, 105, 0, 97, 98,
-	99, 102, 0, 208, 0, 84, 0, 0, 0, 85,
-	0, 87, 86, 106, 107, 111, 109, 113, 112, 0,
-	0, 0, 0, 83, 0, 0, 0, 0, 91, 92,
-	94, 95, 96, 93, 0, 0, 89, 90, 100, 101,
-	0, 0, 0, 0, 0, 0, 0, 88, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 82, 108, 110,
-	103, 104, 105, 0, 97, 98, 99, 102, 0, 199,
-	0, 84, 0, 0, 0, 85, 0, 87, 86, 106,
-	107, 111, 109, 113, 112, 0, 0, 0, 0, 83,
-	0, 0, 0, 0, 91, 92, 94, 95, 96, 93,
-	0, 0, 89, 90, 100, 101, 0, 0, 0, 0,
-	0, 0, 0, 88, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 82, 108, 110, 103, 104, 105, 0,
-	97, 98, 99, 102, 0, 0, 0, 84, 0, 0,
-	0, 85, 0, 87, 86, 106, 107, 111, 109, 113,
-	112, 0, 0, 0, 0, 83, 0, 0, 0, 0,
-	91, 92, 94, 95, 96, 93, 0, 0, 89, 90,
-	100, 101, 0, 0, 0, 0, 0, 0, 0, 88,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 82,
-	108, 110, 103, 104, 105, 0, 97, 98, 99, 102,
-	0, 0, 0, 161, 0, 0, 0, 85, 0, 87,
-	86, 106, 107, 111, 109, 113, 112, 0, 0, 0,
-	0, 83, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 89, 90, 100, 101, 0, 0,
-	0, 0, 0, 0, 0, 88, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 82, 108, 110, 103, 104,
-	105, 0, 97, 98, 99, 102, 0, 0, 0, 84,
-	0, 0, 0, 85, 0, 87, 86, 106, 107, 111,
-	109, 113, 112, 0, 0, 0, 0, 83, 0, 0,
-	35, 52, 53, 0, 0, 31, 0, 0, 0, 0,
-	89, 90, 100, 101, 0, 0, 0, 0, 0, 39,
-	54, 55, 56, 0, 0, 0, 0, 0, 0, 0,
-	0, 82, 108, 110, 103, 104, 105, 0, 97, 98,
-	99, 102, 0, 0, 0, 84, 40, 57, 0, 85,
-	37, 87, 0, 41, 38, 0, 0, 0, 0, 0,
-	0, 51, 0, 59, 61, 0, 0, 60, 0, 42,
-	0, 34, 35, 52, 53, 32, 336, 31, 58, 0,
+	194, 195, 196, 197, 198, 199, 200, 437, 160, 7,
+	205, 208, 328, 205, 146, 250, 70, 171, 430, 213,
+	211, 429, 425, 424, 132, 132, 423, 132, 203, 421,
+	225, 227, 228, 300, 151, 132, 132, 235, 132, 298,
+	151, 238, 277, 151, 412, 411, 407, 127, 403, 399,
+	202, 87, 266, 205, 397, 128, 247, 396, 395, 214,
+	217, 218, 128, 220, 254, 392, 216, 388, 216, 387,
+	224, 229, 230, 358, 233, 90, 91, 101, 102, 70,
+	345, 237, 313, 348, 310, 165, 251, 151, 303, 295,
+	256, 241, 240, 242, 243, 257, 216, 215, 261, 248,
+	264, 145, 130, 144, 98, 99, 100, 103, 268, 85,
+	86, 88, 132, 446, 444, 213, 128, 280, 410, 130,
+	390, 128, 374, 235, 373, 271, 128, 287, 326, 221,
+	293, 294, 128, 159, 255, 75, 301, 87, 70, 259,
+	304, 289, 139, 136, 306, 271, 134, 135, 274, 166,
+	219, 231, 125, 317, 319, 133, 349, 212, 138, 232,
+	161, 90, 91, 134, 135, 143, 239, 131, 292, 142,
+	337, 203, 133, 136, 141, 140, 341, 85, 86, 88,
+	330, 132, 347, 79, 131, 78, 9, 369, 325, 324,
+	136, 443, 438, 314, 343, 85, 86, 88, 271, 296,
+	356, 330, 80, 432, 332, 335, 10, 312, 286, 273,
+	148, 365, 175, 70, 74, 66, 370, 344, 368, 367,
+	258, 62, 124, 63, 64, 265, 132, 203, 132, 203,
+	272, 383, 128, 386, 121, 353, 275, 389, 4, 376,
+	65, 47, 68, 271, 357, 128, 393, 46, 359, 360,
+	2, 362, 45, 44, 67, 158, 43, 371, 30, 372,
+	50, 29, 377, 334, 380, 321, 22, 21, 20, 25,
+	24, 3, 0, 414, 0, 203, 416, 0, 0, 0,
+	0, 70, 391, 0, 239, 0, 0, 0, 0, 0,
+	128, 0, 0, 0, 398, 0, 400, 401, 0, 128,
+	0, 0, 404, 0, 0, 346, 408, 409, 0, 235,
+	436, 0, 0, 435, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 420, 0, 132, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 426, 366, 0, 427, 428,
+	70, 0, 442, 431, 0, 0, 271, 0, 0, 378,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 441, 0, 0, 87, 107, 108, 112, 110, 114,
+	113, 0, 0, 0, 0, 84, 0, 447, 0, 448,
+	92, 93, 95, 96, 97, 94, 0, 0, 90, 91,
+	101, 102, 0, 0, 413, 0, 0, 0, 0, 89,
+	0, 0, 0, 418, 0, 0, 0, 115, 0, 382,
+	83, 109, 111, 104, 105, 106, 0, 98, 99, 100,
+	103, 0, 85, 86, 88, 0, 0, 0, 0, 0,
+	381, 87, 107, 108, 112, 110, 114, 113, 0, 0,
+	0, 0, 84, 0, 0, 0, 0, 92, 93, 95,
+	96, 97, 94, 0, 0, 90, 91, 101, 102, 0,
+	0, 0, 0, 0, 0, 0, 89, 0, 0, 0,
+	0, 0, 0, 0, 115, 0, 355, 83, 109, 111,
+	104, 105, 106, 0, 98, 99, 100, 103, 0, 85,
+	86, 88, 0, 0, 0, 0, 0, 354, 87, 107,
+	108, 112, 110, 114, 113, 0, 0, 0, 0, 84,
+	0, 0, 0, 0, 92, 93, 95, 96, 97, 94,
+	0, 0, 90, 91, 101, 102, 0, 0, 0, 0,
+	0, 0, 0, 89, 0, 0, 0, 0, 0, 0,
+	0, 115, 0, 340, 83, 109, 111, 104, 105, 106,
+	0, 98, 99, 100, 103, 0, 85, 86, 88, 0,
+	0, 0, 0, 0, 339, 87, 107, 108, 112, 110,
+	114, 113, 0, 0, 0, 0, 84, 0, 0, 0,
+	0, 92, 93, 95, 96, 97, 94, 0, 0, 90,
+	91, 101, 102, 0, 0, 0, 0, 0, 0, 0,
+	89, 0, 0, 0, 0, 0, 0, 0, 115, 0,
+	309, 83, 109, 111, 104, 105, 106, 0, 98, 99,
+	100, 103, 0, 85, 86, 88, 0, 0, 0, 0,
+	0, 308, 87, 107, 108, 112, 110, 114, 113, 0,
+	0, 0, 0, 84, 0, 0, 0, 0, 92, 93,
+	95, 96, 97, 94, 0, 0, 90, 91, 101, 102,
+	0, 0, 0, 0, 0, 0, 0, 89, 0, 0,
+	0, 0, 0, 0, 0, 115, 0, 279, 83, 109,
+	111, 104, 105, 106, 0, 98, 99, 100, 103, 0,
+	85, 86, 88, 0, 0, 0, 0, 0, 278, 87,
+	107, 108, 112, 110, 114, 113, 0, 0, 0, 0,
+	84, 0, 0, 0, 0, 92, 93, 95, 96, 97,
+	94, 0, 0, 90, 91, 101, 102, 0, 0, 0,
+	0, 0, 0, 0, 89, 0, 0, 0, 0, 0,
+	0, 0, 115, 0, 253, 83, 109, 111, 104, 105,
+	106, 0, 98, 99, 100, 103, 0, 85, 86, 88,
+	0, 35, 51, 52, 0, 252, 31, 13, 48, 14,
+	26, 0, 27, 0, 0, 0, 0, 0, 0, 0,
+	39, 53, 54, 55, 0, 15, 16, 0, 0, 0,
+	0, 0, 0, 0, 0, 11, 12, 0, 0, 0,
+	0, 28, 0, 0, 17, 0, 0, 40, 56, 0,
+	0, 37, 18, 19, 41, 38, 0, 0, 0, 0,
+	0, 0, 0, 57, 0, 59, 61, 0, 0, 60,
+	0, 34, 32, 0, 42, 0, 0, 0, 0, 0,
+	58, 87, 107, 108, 112, 110, 114, 113, 0, 0,
+	0, 0, 84, 0, 0, 0, 0, 92, 93, 95,
+	96, 97, 94, 0, 0, 90, 91, 101, 102, 0,
+	0, 0, 0, 0, 0, 0, 89, 0, 0, 0,
+	0, 0, 0, 0, 115, 0, 0, 83, 109, 111,
+	104, 105, 106, 0, 98, 99, 100, 103, 0, 85,
+	86, 88, 0, 0, 0, 0, 0, 433, 87, 107,
+	108, 112, 110, 114, 113, 0, 0, 0, 0, 84,
+	0, 0, 0, 0, 92, 93, 95, 96, 97, 94,
+	0, 0, 90, 91, 101, 102, 0, 0, 0, 0,
+	0, 0, 0, 89, 0, 0, 0, 0, 0, 0,
+	0, 115, 0, 0, 83, 109, 111, 104, 105, 106,
+	0, 98, 99, 100, 103, 0, 85, 86, 88, 0,
+	0, 0, 0, 0, 422, 87, 107, 108, 112, 110,
+	114, 113, 0, 0, 0, 0, 84, 0, 0, 0,
+	0, 92, 93, 95, 96, 97, 94, 0, 0, 90,
+	91, 101, 102, 0, 0, 0, 0, 0, 0, 0,
+	89, 0, 0, 0, 0, 0, 0, 0, 115, 0,
+	0, 83, 109, 111, 104, 105, 106, 0, 98, 99,
+	100, 103, 0, 85, 86, 88, 0, 0, 0, 0,
+	0, 415, 87, 107, 108, 112, 110, 114, 113, 0,
+	0, 0, 0, 84, 0, 0, 0, 0, 92, 93,
+	95, 96, 97, 94, 0, 0, 90, 91, 101, 102,
+	0, 0, 0, 0, 0, 0, 0, 89, 0, 0,
+	0, 0, 0, 0, 0, 115, 0, 0, 83, 109,
+	111, 104, 105, 106, 0, 98, 99, 100, 103, 0,
+	85, 86, 88, 0, 0, 0, 0, 0, 394, 87,
+	107, 108, 112, 110, 114, 113, 0, 0, 0, 0,
+	84, 0, 0, 0, 0, 92, 93, 95, 96, 97,
+	94, 0, 0, 90, 91, 101, 102, 0, 0, 0,
+	0, 0, 0, 0, 89, 0, 0, 0, 0, 0,
+	0, 0, 115, 0, 0, 83, 109, 111, 104, 105,
+	106, 0, 98, 99, 100, 103, 0, 85, 86, 88,
+	0, 0, 384, 385, 87, 107, 108, 112, 110, 114,
+	113, 0, 0, 0, 0, 84, 0, 0, 0, 0,
+	92, 93, 95, 96, 97, 94, 0, 0, 90, 91,
+	101, 102, 0, 0, 0, 0, 0, 0, 0, 89,
+	0, 0, 0, 0, 0, 0, 0, 115, 0, 0,
+	83, 109, 111, 104, 105, 106, 0, 98, 99, 100,
+	103, 0, 85, 86, 88, 0, 0, 0, 0, 320,
+	87, 107, 108, 112, 110, 114, 113, 0, 0, 0,
+	0, 84, 0, 0, 0, 0, 92, 93, 95, 96,
+	97, 94, 0, 0, 90, 91, 101, 102, 0, 0,
+	0, 0, 0, 0, 0, 89, 0, 0, 0, 0,
+	0, 0, 0, 115, 0, 0, 83, 109, 111, 104,
+	105, 106, 0, 98, 99, 100, 103, 0, 85, 86,
+	88, 0, 0, 0, 0, 262, 87, 107, 108, 112,
+	110, 114, 113, 0, 0, 0, 0, 84, 0, 0,
+	0, 0, 92, 93, 95, 96, 97, 94, 0, 0,
+	90, 91, 101, 102, 0, 0, 0, 0, 0, 0,
+	0, 89, 0, 0, 0, 0, 0, 0, 0, 115,
+	0, 0, 83, 109, 111, 104, 105, 106, 0, 98,
+	99, 100, 103, 0, 85, 86, 88, 0, 0, 244,
+	245, 87, 107, 108, 112, 110, 114, 113, 0, 0,
+	0, 0, 84, 0, 0, 0, 0, 92, 93, 95,
+	96, 97, 94, 0, 0, 90, 91, 101, 102, 0,
+	0, 0, 0, 0, 0, 0, 89, 0, 0, 0,
+	0, 0, 0, 0, 115, 0, 0, 83, 109, 111,
+	104, 105, 106, 0, 98, 99, 100, 103, 0, 85,
+	86, 88, 0, 0, 434, 87, 107, 108, 112, 110,
+	114, 113, 0, 0, 0, 0, 84, 0, 0, 0,
+	0, 92, 93, 95, 96, 97, 94, 0, 0, 90,
+	91, 101, 102, 0, 0, 0, 0, 0, 0, 0,
+	89, 0, 0, 0, 0, 0, 0, 0, 115, 0,
+	0, 83, 109, 111, 104, 105, 106, 0, 98, 99,
+	100, 103, 0, 85, 86, 88, 0, 0, 417, 87,
+	107, 108, 112, 110, 114, 113, 0, 0, 0, 0,
+	84, 0, 0, 0, 0, 92, 93, 95, 96, 97,
+	94, 0, 0, 90, 91, 101, 102, 0, 0, 0,
+	0, 0, 0, 0, 89, 0, 0, 0, 0, 0,
+	0, 0, 115, 0, 0, 83, 109, 111, 104, 105,
+	106, 0, 98, 99, 100, 103, 0, 85, 86, 88,
+	0, 0, 352, 87, 107, 108, 112, 110, 114, 113,
+	0, 0, 0, 0, 84, 0, 0, 0, 0, 92,
+	93, 95, 96, 97, 94, 0, 0, 90, 91, 101,
+	102, 0, 0, 0, 0, 0, 0, 0, 89, 0,
+	0, 0, 0, 0, 0, 0, 115, 0, 0, 83,
+	109, 111, 104, 105, 106, 0, 98, 99, 100, 103,
+	0, 85, 86, 88, 0, 0, 282, 87, 107, 108,
+	112, 110, 114, 113, 0, 0, 0, 0, 84, 0,
+	0, 0, 0, 92, 93, 95, 96, 97, 94, 0,
+	0, 90, 91, 101, 102, 0, 0, 0, 0, 0,
+	0, 0, 89, 0, 0, 0, 0, 0, 0, 0,
+	115, 0, 0, 83, 109, 111, 104, 105, 106, 0,
+	98, 99, 100, 103, 0, 85, 86, 88, 0, 0,
+	281, 87, 107, 108, 112, 110, 114, 113, 0, 0,
+	0, 0, 84, 0, 0, 0, 0, 92, 93, 95,
+	96, 97, 94, 0, 0, 90, 91, 101, 102, 0,
+	0, 0, 0, 0, 0, 0, 89, 0, 0, 0,
+	0, 0, 0, 0, 115, 0, 0, 83, 109, 111,
+	104, 105, 106, 0, 98, 99, 100, 103, 0, 85,
+	86, 88, 0, 0, 246, 87, 107, 108, 112, 110,
+	114, 113, 0, 0, 0, 0, 84, 0, 0, 0,
+	0, 92, 93, 95, 96, 97, 94, 0, 0, 90,
+	91, 101, 102, 0, 0, 0, 0, 0, 0, 0,
+	89, 0, 0, 0, 0, 0, 0, 0, 115, 0,
+	0, 83, 109, 111, 104, 105, 106, 0, 98, 99,
+	100, 103, 0, 85, 86, 88, 0, 0, 223, 87,
+	107, 108, 112, 110, 114, 113, 0, 0, 0, 0,
+	84, 0, 0, 0, 0, 92, 93, 95, 96, 97,
+	94, 0, 0, 90, 91, 101, 102, 0, 0, 0,
+	0, 0, 0, 0, 89, 82, 0, 0, 0, 0,
+	0, 0, 115, 81, 0, 83, 109, 111, 104, 105,
+	106, 0, 98, 99, 100, 103, 0, 85, 86, 88,
+	206, 87, 107, 108, 112, 110, 114, 113, 0, 0,
+	0, 0, 84, 0, 0, 0, 0, 92, 93, 95,
+	96, 97, 94, 0, 0, 90, 91, 101, 102, 0,
+	0, 0, 0, 0, 0, 0, 89, 0, 0, 0,
+	0, 0, 0, 0, 115, 0, 0, 83, 109, 111,
+	104, 105, 106, 0, 98, 99, 100, 103, 0, 85,
+	86, 88, 402, 87, 107, 108, 112, 110, 114, 113,
+	0, 0, 0, 0, 84, 0, 0, 0, 0, 92,
+	93, 95, 96, 97, 94, 0, 0, 90, 91, 101,
+	102, 0, 0, 0, 0, 0, 0, 0, 89, 0,
+	0, 0, 0, 0, 0, 0, 115, 0, 0, 83,
+	109, 111, 104, 105, 106, 0, 98, 99, 100, 103,
+	0, 85, 86, 88, 363, 87, 107, 108, 112, 110,
+	114, 113, 0, 0, 0, 0, 84, 0, 0, 0,
+	0, 92, 93, 95, 96, 97, 94, 0, 0, 90,
+	91, 101, 102, 0, 0, 0, 0, 0, 0, 0,
+	89, 0, 0, 0, 0, 0, 0, 0, 115, 0,
+	0, 83, 109, 111, 104, 105, 106, 0, 98, 99,
+	100, 103, 0, 85, 86, 88, 361, 87, 107, 108,
+	112, 110, 114, 113, 0, 0, 0, 0, 84, 0,
+	0, 0, 0, 92, 93, 95, 96, 97, 94, 0,
+	0, 90, 91, 101, 102, 0, 0, 0, 0, 0,
+	0, 0, 89, 0, 0, 0, 0, 0, 0, 0,
+	115, 0, 0, 83, 109, 111, 104, 105, 106, 0,
+	98, 99, 100, 103, 0, 85, 86, 88, 315, 87,
+	107, 108, 112, 110, 114, 113, 0, 0, 0, 0,
+	84, 0, 0, 0, 0, 92, 93, 95, 96, 97,
+	94, 0, 0, 90, 91, 101, 102, 0, 0, 0,
+	0, 0, 0, 0, 89, 0, 0, 0, 0, 0,
+	0, 0, 115, 0, 0, 83, 109, 111, 104, 105,
+	106, 0, 98, 99, 100, 103, 0, 85, 86, 88,
+	311, 87, 107, 108, 112, 110, 114, 113, 0, 0,
+	0, 0, 84, 0, 0, 0, 0, 92, 93, 95,
+	96, 97, 94, 0, 0, 90, 91, 101, 102, 0,
+	0, 0, 0, 0, 0, 0, 89, 0, 0, 0,
+	0, 0, 0, 0, 115, 0, 0, 83, 109, 111,
+	104, 105, 106, 0, 98, 99, 100, 103, 0, 85,
+	86, 88, 302, 87, 107, 108, 112, 110, 114, 113,
+	0, 0, 0, 0, 84, 0, 0, 0, 0, 92,
+	93, 95, 96, 97, 94, 0, 0, 90, 91, 101,
+	102, 0, 0, 0, 0, 0, 0, 0, 89, 0,
+	0, 0, 0, 0, 0, 0, 115, 0, 0, 83,
+	109, 111, 104, 105, 106, 0, 98, 99, 100, 103,
+	0, 85, 86, 88, 210, 87, 107, 108, 112, 110,
+	114, 113, 0, 0, 0, 0, 84, 0, 0, 0,
+	0, 92, 93, 95, 96, 97, 94, 0, 0, 90,
+	91, 101, 102, 0, 0, 0, 0, 0, 0, 0,
+	89, 0, 0, 0, 0, 0, 0, 0, 115, 0,
+	0, 83, 109, 111, 104, 105, 106, 0, 98, 99,
+	100, 103, 0, 85, 86, 88, 201, 87, 107, 108,
+	112, 110, 114, 113, 0, 0, 0, 0, 84, 0,
+	0, 0, 0, 92, 93, 95, 96, 97, 94, 0,
+	0, 90, 91, 101, 102, 0, 0, 0, 0, 0,
+	0, 0, 89, 82, 0, 0, 0, 0, 0, 0,
+	115, 81, 0, 83, 109, 111, 104, 105, 106, 0,
+	98, 99, 100, 103, 0, 85, 86, 88, 87, 107,
+	108, 112, 110, 114, 113, 0, 0, 0, 0, 84,
+	0, 0, 0, 0, 92, 93, 95, 96, 97, 94,
+	0, 0, 90, 91, 101, 102, 0, 0, 0, 0,
+	0, 0, 0, 89, 0, 0, 0, 0, 0, 0,
+	0, 115, 0, 419, 83, 109, 111, 104, 105, 106,
+	0, 98, 99, 100, 103, 0, 85, 86, 88, 87,
+	107, 108, 112, 110, 114, 113, 0, 0, 0, 0,
+	84, 0, 0, 0, 0, 92, 93, 95, 96, 97,
+	94, 0, 0, 90, 91, 101, 102, 0, 0, 0,
+	0, 0, 0, 0, 89, 0, 0, 0, 0, 0,
+	0, 0, 115, 0, 405, 83, 109, 111, 104, 105,
+	106, 0, 98, 99, 100, 103, 0, 85, 86, 88,
+	87, 107, 108, 112, 110, 114, 113, 0, 0, 0,
+	0, 84, 0, 0, 0, 0, 92, 93, 95, 96,
+	97, 94, 0, 0, 90, 91, 101, 102, 0, 0,
+	0, 0, 0, 0, 0, 89, 0, 0, 0, 0,
+	0, 0, 0, 115, 0, 291, 83, 109, 111, 104,
+	105, 106, 0, 98, 99, 100, 103, 0, 85, 86,
+	88, 87, 107, 108, 112, 110, 114, 113, 0, 0,
+	0, 0, 84, 0, 0, 0, 0, 92, 93, 95,
+	96, 97, 94, 0, 0, 90, 91, 101, 102, 0,
+	0, 0, 0, 0, 0, 0, 89, 0, 0, 0,
+	0, 0, 0, 0, 115, 0, 249, 83, 109, 111,
+	104, 105, 106, 0, 98, 99, 100, 103, 0, 85,
+	86, 88, 87, 107, 108, 112, 110, 114, 113, 0,
+	0, 0, 0, 84, 0, 0, 0, 0, 92, 93,
+	95, 96, 97, 94, 0, 0, 90, 91, 101, 102,
+	0, 0, 0, 0, 0, 0, 0, 89, 0, 0,
+	0, 0, 0, 0, 0, 115, 0, 0, 83, 109,
+	111, 104, 105, 106, 0, 98, 99, 100, 103, 0,
+	85, 86, 88, 87, 107, 108, 112, 110, 114, 113,
+	0, 0, 0, 0, 84, 0, 0, 0, 0, 92,
+	93, 95, 96, 97, 94, 0, 0, 90, 91, 101,
+	102, 0, 0, 0, 0, 0, 0, 0, 89, 0,
+	0, 0, 0, 0, 0, 0, 115, 0, 0, 83,
+	109, 111, 104, 105, 106, 0, 98, 99, 100, 103,
+	0, 162, 86, 88, 122, 51, 52, 0, 0, 31,
+	0, 48, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 39, 53, 54, 55, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 39, 54, 55, 56, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 35, 52,
-	53, 0, 0, 31, 0, 0, 0, 0, 40, 57,
-	0, 0, 37, 0, 0, 41, 38, 39, 54, 55,
-	56, 0, 0, 51, 0, 59, 61, 0, 0, 60,
-	0, 42, 0, 34, 35, 52, 53, 32, 305, 31,
-	58, 0, 0, 0, 40, 57, 0, 0, 37, 0,
-	0, 41, 38, 39, 54, 55, 56, 0, 0, 51,
-	0, 59, 61, 0, 0, 60, 0, 42, 0, 34,
-	0, 0, 261, 32, 0, 0, 58, 0, 0, 0,
-	40, 57, 0, 0, 37, 0, 0, 41, 38, 0,
-	224, 0, 0, 0, 0, 51, 0, 59, 61, 0,
-	0, 60, 0, 42, 0, 34, 35, 52, 53, 32,
-	0, 31, 58, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 39, 54, 55, 56, 0,
+	35, 51, 52, 0, 0, 31, 0, 0, 0, 0,
+	40, 56, 0, 0, 37, 0, 0, 41, 38, 39,
+	53, 54, 55, 0, 0, 0, 57, 0, 59, 61,
+	0, 0, 60, 0, 34, 32, 0, 117, 0, 0,
+	0, 120, 0, 58, 0, 0, 40, 56, 0, 0,
+	37, 0, 0, 41, 38, 35, 51, 52, 0, 0,
+	31, 0, 57, 0, 59, 61, 0, 0, 60, 0,
+	34, 32, 0, 42, 39, 53, 54, 55, 338, 58,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 35, 52, 53, 0, 0, 31, 0, 0,
-	0, 0, 40, 57, 0, 0, 37, 0, 0, 41,
-	38, 39, 54, 55, 56, 0, 0, 51, 0, 59,
-	61, 0, 0, 60, 0, 42, 0, 34, 0, 0,
-	205, 32, 0, 0, 58, 0, 0, 0, 40, 57,
-	0, 0, 37, 0, 0, 41, 38, 0, 172, 0,
-	0, 0, 0, 51, 0, 59, 61, 0, 0, 60,
-	0, 42, 0, 34, 35, 52, 53, 32, 0, 31,
-	58, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 39, 54, 55, 56, 0, 0, 0,
+	0, 35, 51, 52, 0, 0, 31, 0, 0, 0,
+	0, 40, 56, 0, 0, 37, 0, 0, 41, 38,
+	39, 53, 54, 55, 0, 0, 0, 57, 0, 59,
+	61, 0, 0, 60, 0, 34, 32, 0, 42, 0,
+	35, 51, 52, 307, 58, 31, 0, 40, 56, 0,
+	0, 37, 0, 0, 41, 38, 0, 0, 0, 39,
+	53, 54, 55, 57, 0, 59, 61, 0, 0, 60,
+	0, 34, 32, 0, 42, 0, 0, 0, 263, 0,
+	58, 0, 0, 0, 0, 0, 40, 56, 0, 0,
+	37, 0, 0, 41, 38, 0, 0, 226, 0, 0,
+	0, 0, 57, 0, 59, 61, 0, 0, 60, 0,
+	34, 32, 0, 42, 0, 35, 51, 52, 0, 58,
+	31, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 39, 53, 54, 55, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	35, 52, 53, 0, 0, 31, 0, 0, 0, 0,
-	40, 57, 0, 0, 37, 0, 0, 41, 38, 39,
-	54, 55, 56, 0, 0, 51, 0, 59, 61, 0,
-	0, 60, 0, 42, 0, 34, 35, 52, 53, 32,
-	0, 31, 58, 0, 0, 0, 40, 57, 0, 0,
-	37, 0, 0, 41, 38, 39, 54, 55, 56, 0,
-	0, 51, 0, 59, 61, 0, 0, 60, 0, 362,
-	0, 34, 35, 52, 53, 32, 0, 31, 58, 0,
-	0, 0, 40, 57, 0, 0, 37, 0, 0, 41,
-	38, 39, 54, 55, 56, 0, 0, 51, 0, 59,
-	61, 0, 0, 60, 0, 316, 0, 34, 35, 52,
-	53, 32, 0, 31, 58, 0, 0, 0, 40, 57,
-	0, 0, 37, 0, 0, 41, 38, 39, 54, 55,
-	56, 0, 0, 51, 0, 59, 61, 0, 0, 60,
-	0, 314, 0, 34, 0, 0, 0, 32, 0, 0,
-	58, 0, 0, 0, 40, 57, 0, 0, 37, 0,
-	0, 41, 38, 0, 86, 106, 107, 111, 109, 51,
-	112, 59, 61, 0, 0, 60, 0, 258, 0, 34,
-	0, 0, 0, 32, 0, 0, 58, 0, 89, 90,
-	100, 101, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 35, 51, 52, 0, 0, 31, 0, 0, 0,
+	0, 40, 56, 0, 0, 37, 0, 0, 41, 38,
+	39, 53, 54, 55, 0, 0, 0, 57, 0, 59,
+	61, 0, 0, 60, 0, 34, 32, 0, 42, 0,
+	0, 0, 207, 0, 58, 0, 0, 40, 56, 0,
+	0, 37, 0, 0, 41, 38, 0, 0, 173, 0,
+	0, 0, 0, 57, 0, 59, 61, 0, 0, 60,
+	0, 34, 32, 0, 42, 0, 35, 51, 52, 0,
+	58, 31, 0, 0, 0, 0, 0, 0, 0, 35,
+	51, 52, 0, 0, 31, 39, 53, 54, 55, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 39, 53,
+	54, 55, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 40, 56, 0, 0, 37, 0, 0, 41,
+	38, 0, 0, 0, 0, 40, 56, 0, 57, 37,
+	59, 61, 41, 38, 60, 0, 34, 32, 0, 42,
+	0, 57, 0, 59, 61, 58, 0, 60, 0, 34,
+	32, 0, 364, 0, 35, 51, 52, 0, 58, 31,
+	0, 0, 0, 0, 0, 0, 0, 35, 51, 52,
+	0, 0, 31, 39, 53, 54, 55, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 39, 53, 54, 55,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	108, 110, 103, 104, 105, 0, 97, 98, 99, 102,
-	35, 150, 53, 84, 0, 31, 0, 85, 0, 87,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 39,
-	54, 55, 56, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 75, 52, 53, 0,
-	0, 31, 0, 0, 0, 0, 40, 57, 0, 0,
-	37, 0, 0, 41, 38, 39, 54, 55, 56, 0,
-	0, 51, 0, 59, 61, 0, 0, 60, 0, 42,
-	0, 34, 0, 0, 0, 32, 0, 0, 58, 0,
-	0, 0, 40, 57, 0, 0, 37, 0, 0, 41,
-	38, 86, 106, 107, 111, 109, 0, 51, 0, 59,
-	61, 0, 0, 60, 0, 42, 0, 34, 0, 0,
-	0, 32, 0, 0, 58, 89, 90, 100, 101, 0,
+	40, 56, 0, 0, 37, 0, 0, 41, 38, 0,
+	0, 0, 0, 40, 56, 0, 57, 37, 59, 61,
+	41, 38, 60, 0, 34, 32, 0, 318, 0, 57,
+	0, 59, 61, 58, 0, 60, 0, 34, 32, 0,
+	316, 0, 35, 51, 52, 0, 58, 31, 0, 0,
+	0, 0, 0, 0, 0, 76, 51, 52, 0, 0,
+	31, 39, 53, 54, 55, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 39, 53, 54, 55, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 40, 56,
+	0, 0, 37, 0, 0, 41, 38, 0, 0, 0,
+	0, 40, 56, 0, 57, 37, 59, 61, 41, 38,
+	60, 0, 34, 32, 0, 260, 0, 57, 0, 59,
+	61, 58, 0, 60, 0, 34, 32, 0, 42, 0,
+	0, 0, 0, 0, 58, 87, 107, 108, 112, 110,
+	114, 113, 0, 0, 0, 0, 84, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 90,
+	91, 101, 102, 0, 0, 0, 0, 0, 0, 0,
+	89, 0, 0, 0, 0, 0, 0, 0, 115, 0,
+	0, 83, 109, 111, 104, 105, 106, 0, 98, 99,
+	100, 103, 0, 85, 86, 88, 87, 107, 108, 112,
+	110, 114, 113, 0, 0, 0, 0, 84, 0, 0,
+	87, 107, 108, 112, 110, 114, 113, 0, 0, 0,
+	90, 91, 101, 102, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 90, 91, 101, 102, 0, 115,
+	0, 0, 83, 109, 111, 104, 105, 106, 0, 98,
+	99, 100, 103, 0, 85, 86, 88, 109, 111, 104,
+	105, 106, 0, 98, 99, 100, 103, 0, 85, 86,
+	88, 87, 107, 108, 112, 110, 0, 113, 0, 0,
+	0, 0, 0, 0, 0, 87, 107, 108, 112, 110,
+	0, 0, 0, 0, 0, 90, 91, 101, 102, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 90,
+	91, 101, 102, 0, 0, 0, 0, 0, 109, 111,
+	104, 105, 106, 87, 98, 99, 100, 103, 0, 85,
+	86, 88, 109, 111, 104, 105, 106, 0, 98, 99,
+	100, 103, 0, 85, 86, 88, 0, 90, 91, 101,
+	102, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 108, 110, 103,
-	104, 105, 0, 97, 98, 99, 102, 0, 0, 0,
-	84, 0, 0, 0, 85, 0, 87,
+	0, 0, 104, 105, 106, 0, 98, 99, 100, 103,
+	0, 85, 86, 88,
 }
 
 var yyPact = [...]int{
-	-67, -1000, 631, -67, -1000, -71, -71, -1000, -1000, -1000,
-	-1000, -1000, -1000, 3490, 3490, 306, 213, 3782, 93, 89,
-	283, -1000, -1000, 1226, -1000, -1000, 3490, 465, 3490, -1000,
-	-1000, 146, -59, 206, 3490, 40, -28, 76, 62, 59,
-	53, -7, -71, -1000, -1000, -1000, -1000, -1000, 302, 71,
-	-1000, 3746, -1000, -1000, -1000, -1000, -1000, 3490, 3490, 3490,
-	3490, 3490, -1000, -1000, -1000, -1000, -1000, 631, -71, -1000,
-	-8, 2942, 2942, 210, -67, 50, 3008, 3490, 3490, 200,
-	3490, 3490, 3490, 3490, 3490, 3418, 3490, 303, 3490, -1000,
-	-1000, 3490, 3490, 3490, 3490, 3490, 3490, 3490, 3490, 3490,
-	3490, 3490, 3490, 3490, 3490, 3490, 3490, 3490, 3490, 3490,
-	3490, 3490, 3490, 3490, 2876, -67, 49, 553, 3382, -34,
-	40, 2810, 302, 16, -25, 3490, -71, -10, -1000, 206,
-	206, -15, 206, 209, -30, 2744, 3490, 3310, 3490, 3490,
-	206, 179, -71, 206, 3490, 65, -1000, 3490, 3490, -71,
-	-1000, -41, 3074, -41, -41, -41, -41, -1000, -67, 197,
-	3490, 3490, 1160, 2678, 3490, -67, 2942, 2942, 2612, 3140,
-	163, 1094, 3490, 189, -1000, 3074, 2942, 2942, 2942, 2942,
-	2942, 2942, 189, 189, 189, 189, 189, 189, 120, 120,
-	120, 484, 484, 484, 484, 484, 484, 3825, 3678, -67,
-	195, -71, 3490, -71, -67, 3634, 2546, 3274, -71, 139,
-	302, -1000, -60, -71, 301, -42, -42, 206, -42, -71,
-	-25, -1000, 121, 1028, 3490, 2480, 2414, -50, -44, 300,
-	3490, -35, -64, 2348, 3490, -8, 2942, 3490, 193, 266,
-	110, 77, -1000, 3490, -1000, 2282, 192, 3490, 12, -1000,
-	-1000, 3238, 962, 191, -1000, 2216, 297, 186, -67, 2150,
-	3598, 3562, 2084, 247, 208, 4, 45, -71, -31, -71,
-	3490, -1000, -38, 295, 1, -1000, -1000, 3166, 896, -1000,
-	-1000, -1000, -1000, 3490, -3, -64, 206, 185, -71, 3490,
-	-8, 2942, -28, -1000, 180, 0, -1000, -1, -1000, 2018,
-	-67, -1000, 3074, -1000, 830, -1000, -1000, 3490, -1000, -67,
-	-1000, -1000, 183, -67, -67, 1952, -67, 1886, 3526, -36,
-	-1000, -1000, 134, 3490, -67, 207, 204, -9, -71, -1000,
-	-60, 206, -63, 206, -1000, 764, -1000, -1000, 3490, 698,
-	3490, 182, -51, -1000, 3490, 2942, 203, -67, -1000, -1000,
-	-1000, 177, -1000, 3490, 1820, 175, -1000, 168, 159, -67,
-	152, -67, -67, 1754, 150, -1000, -1000, -67, 1688, 13,
-	140, -67, -67, 202, 137, -42, 135, -71, -42, -1000,
-	3490, 1622, -1000, 3490, 1556, -1000, -71, 1490, -67, 128,
-	-1000, 1424, -1000, -1000, -1000, -1000, 122, -1000, 107, 106,
-	-67, -1000, -1000, -67, -67, -1000, 104, 103, -67, -1000,
-	-1000, 294, 1358, -1000, 1292, -1000, 3490, 3490, 102, 263,
-	-1000, -1000, -1000, -1000, 100, -1000, -1000, -1000, -1000, 98,
-	206, -1000, -1000, -64, 2942, 257, 199, -1000, -1000, -42,
-	96, 164, -67, -1000, -67, 78, 46, -1000, -1000,
+	-71, -1000, 867, -71, -1000, -73, -73, -1000, -1000, -1000,
+	-1000, -1000, -1000, 3262, 3262, 320, 168, 3451, 221, 219,
+	298, -1000, -1000, 2471, -1000, -1000, 3262, 2910, 3262, -1000,
+	-1000, 258, -70, 225, 3262, 194, 177, 211, 210, 205,
+	201, 136, -73, -1000, -1000, -1000, -1000, -1000, 316, -15,
+	-1000, -1000, -1000, -1000, -1000, -1000, 3262, 3262, 3262, 3262,
+	3262, 3262, -1000, -1000, -1000, -1000, -1000, -1000, 867, -73,
+	-1000, -31, 2776, 2776, 166, -71, 196, 2837, 3262, 3262,
+	182, 3262, 3262, 3262, 3262, 3262, 3187, 3262, 318, 3262,
+	-1000, -1000, 3262, 3262, 3262, 3262, 3262, 3262, 3262, 3262,
+	3262, 3262, 3262, 3262, 3262, 3262, 3262, 3262, 3262, 3262,
+	3262, 3262, 3262, 3262, 3262, 3262, 2409, -71, 40, 1913,
+	3151, -35, 194, 2347, 316, 193, 178, 3262, -73, 130,
+	-1000, 225, 225, 185, 225, 162, -58, 1849, 3262, 3076,
+	3262, 3262, 225, 208, -73, 225, 3262, -28, -1000, 3262,
+	3262, -73, 3519, 213, 213, 213, 213, 213, -1000, -71,
+	123, 3262, 3262, 1400, 1785, 3262, -71, 2776, 2776, 2715,
+	3580, 117, 793, 3262, 231, -1000, 3519, 2776, 2776, 2776,
+	2776, 2776, 2776, 231, 231, 231, 231, 231, 231, 145,
+	145, 145, 3707, 3707, 3707, 3707, 3707, 3707, 3669, 3655,
+	3594, -71, 122, -73, 3262, -73, -71, 3438, 1334, 3037,
+	-73, 83, 316, -1000, -50, -73, 315, 102, 102, 225,
+	102, -73, 178, -1000, 73, 726, 3262, 1721, 1657, -8,
+	-36, 314, 3262, -43, -63, 2654, 3262, -31, 2776, 3262,
+	121, 279, 70, 64, -1000, 3262, -1000, 2285, 120, 3262,
+	-2, -1000, -1000, 3001, 659, 116, -1000, 2223, 313, 114,
+	-71, 2161, 3363, 3350, 1268, 253, 161, -3, 43, -73,
+	-60, -73, 3262, -1000, -51, 311, -4, -1000, -1000, 2946,
+	592, -1000, -1000, -1000, -1000, 3262, -30, -63, 225, 112,
+	-73, 3262, -31, 2776, 177, -1000, 189, -5, -1000, -6,
+	-1000, 1593, -71, -1000, 3519, -1000, 525, -1000, -1000, 3262,
+	-1000, -71, -1000, -1000, 105, -71, -71, 2099, -71, 2037,
+	3275, -37, -1000, -1000, 236, 3262, -71, 157, 155, -9,
+	-73, -1000, -50, 225, -61, 225, -1000, 458, -1000, -1000,
+	3262, 1203, 3262, 101, 100, -1000, 3262, 2776, 153, -71,
+	-1000, -1000, -1000, 97, -1000, 3262, 1136, 90, -1000, 89,
+	86, -71, 81, -71, -71, 1975, 80, -1000, -1000, -71,
+	2593, -13, 78, -71, -71, 151, 77, 102, 76, -73,
+	102, -1000, 3262, 1069, -1000, 3262, 1529, -1000, -73, 2532,
+	-71, 61, -1000, 1002, -1000, -1000, -1000, -1000, 58, -1000,
+	55, 54, -71, -1000, -1000, -71, -71, -1000, 53, 50,
+	-71, -1000, -1000, 309, 935, -1000, 1465, -1000, 3262, 3262,
+	39, 271, -1000, -1000, -1000, -1000, 14, -1000, -1000, -1000,
+	-1000, 11, 225, -1000, -1000, -63, 2776, 270, 147, -1000,
+	-1000, 102, 8, 146, -71, -1000, -71, -12, -14, -1000,
+	-1000,
 }
 
 var yyPgo = [...]int{
-	0, 41, 353, 279, 303, 352, 350, 349, 348, 346,
-	344, 6, 5, 56, 0, 8, 25, 343, 2, 340,
-	338, 7, 337, 4, 335, 333, 330, 328, 323, 321,
-	320, 316, 314, 313, 311, 159, 1, 179, 48,
+	0, 42, 381, 296, 316, 380, 379, 378, 377, 376,
+	375, 7, 6, 41, 0, 5, 38, 373, 2, 371,
+	370, 1, 368, 4, 366, 363, 362, 357, 351, 350,
+	334, 333, 331, 325, 360, 348, 124, 16, 20, 119,
 }
 
 var yyR1 = [...]int{
@@ -760,14 +752,14 @@ var yyR1 = [...]int{
 	14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
 	14, 14, 14, 14, 14, 15, 15, 15, 16, 16,
 	16, 16, 16, 16, 16, 17, 17, 18, 18, 19,
-	19, 20, 21, 22, 22, 22, 22, 22, 22, 23,
-	23, 23, 24, 24, 24, 24, 24, 24, 24, 24,
-	24, 24, 25, 25, 26, 26, 26, 26, 26, 27,
+	19, 20, 21, 22, 22, 22, 22, 22, 23, 23,
+	23, 24, 24, 24, 24, 24, 24, 24, 24, 24,
+	24, 25, 25, 26, 26, 26, 26, 26, 27, 27,
 	27, 27, 27, 28, 28, 28, 28, 28, 28, 28,
 	28, 32, 32, 32, 32, 32, 32, 31, 31, 31,
-	30, 30, 30, 30, 30, 30, 29, 29, 33, 33,
-	34, 34, 34, 35, 35, 37, 37, 38, 36, 36,
-	36, 36,
+	30, 30, 30, 30, 30, 30, 29, 29, 33, 34,
+	34, 35, 35, 35, 36, 36, 38, 38, 39, 37,
+	37, 37, 37,
 }
 
 var yyR2 = [...]int{
@@ -782,126 +774,128 @@ var yyR2 = [...]int{
 	4, 4, 4, 6, 8, 7, 3, 6, 10, 5,
 	1, 1, 1, 1, 1, 0, 1, 4, 1, 3,
 	2, 2, 5, 2, 6, 2, 5, 2, 3, 1,
-	1, 3, 1, 2, 1, 1, 1, 1, 1, 0,
-	3, 6, 6, 5, 5, 7, 8, 6, 5, 5,
-	7, 8, 3, 2, 2, 2, 2, 2, 2, 1,
+	1, 3, 1, 1, 1, 1, 1, 1, 0, 3,
+	6, 6, 5, 5, 7, 8, 6, 5, 5, 7,
+	8, 3, 2, 2, 2, 2, 2, 2, 1, 1,
 	1, 1, 1, 2, 2, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 0, 1,
-	2, 1, 1, 0, 1, 1, 2, 1, 0, 2,
-	1, 1,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 0,
+	1, 2, 1, 1, 0, 1, 1, 2, 1, 0,
+	2, 1, 1,
 }
 
 var yyChk = [...]int{
-	-1000, -1, -33, -2, -34, 78, -37, -38, 83, -3,
+	-1000, -1, -34, -2, -35, 81, -38, -39, 84, -3,
 	-4, 38, 39, 10, 12, 28, 29, 47, 55, 56,
 	-7, -8, -9, -14, -5, -6, 13, 15, 44, -19,
-	-22, 9, 79, -18, 75, 4, -21, 54, 58, 23,
-	50, 57, 73, -24, -25, -26, -27, -28, 11, -13,
-	-20, 65, 5, 6, 24, 25, 26, 51, 82, 67,
-	71, 68, -32, -31, -30, -29, -33, -34, -37, -38,
-	-13, -14, -14, 4, 73, 4, -14, 75, 75, 14,
-	59, 52, 61, 27, 75, 79, 16, 81, 51, 40,
-	41, 32, 33, 37, 34, 35, 36, 68, 69, 70,
-	42, 43, 71, 64, 65, 66, 17, 18, 62, 20,
-	63, 19, 22, 21, -14, 73, -15, -14, 78, -4,
-	4, -14, 75, 4, 80, -35, -37, -16, 4, 68,
-	-18, 57, 48, 49, 79, -14, 75, 79, 75, 75,
-	75, 75, 73, 79, -35, -15, 4, 59, 52, 77,
-	5, -14, -14, -14, -14, -14, -14, -3, 73, -1,
-	75, 75, -14, -14, 13, 73, -14, -14, -14, -14,
-	-13, -14, 60, -14, 4, -14, -14, -14, -14, -14,
+	-22, 9, 75, -18, 74, 4, -21, 54, 58, 23,
+	50, 57, 77, -24, -25, -26, -27, -28, 11, -13,
+	-20, 5, 6, 24, 25, 26, 51, 66, 83, 68,
+	72, 69, -32, -31, -30, -29, -33, -34, -35, -38,
+	-39, -13, -14, -14, 4, 77, 4, -14, 74, 74,
+	14, 60, 52, 62, 27, 74, 75, 16, 76, 51,
+	40, 41, 32, 33, 37, 34, 35, 36, 69, 70,
+	71, 42, 43, 72, 65, 66, 67, 17, 18, 63,
+	20, 64, 19, 22, 21, 59, -14, 77, -15, -14,
+	81, -4, 4, -14, 74, 4, 82, -36, -38, -16,
+	4, 69, -18, 57, 48, 49, 75, -14, 74, 75,
+	74, 74, 74, 74, 77, 75, -36, -15, 4, 60,
+	52, 80, -14, -14, -14, -14, -14, -14, -3, 77,
+	-1, 74, 74, -14, -14, 13, 77, -14, -14, -14,
+	-14, -13, -14, 61, -14, 4, -14, -14, -14, -14,
 	-14, -14, -14, -14, -14, -14, -14, -14, -14, -14,
-	-14, -14, -14, -14, -14, -14, -14, -14, -14, 73,
-	-1, -37, 16, 77, 73, 78, -14, 78, 73, -15,
-	75, -18, -13, 73, 81, -16, -16, 79, -16, 73,
-	80, 76, -13, -14, 60, -14, -14, -16, -16, 53,
-	-35, -16, -23, -14, 59, -13, -14, -35, -1, 74,
-	-13, -13, 76, 77, 76, -14, -1, 60, 8, 76,
-	80, 60, -14, -1, 74, -14, -35, -1, 73, -14,
-	78, 78, -14, -35, 76, 8, -15, 77, -36, -37,
-	-35, 4, -16, -35, 8, 76, 80, 60, -14, 76,
-	76, 76, 76, 77, 4, -23, 80, -36, 77, 60,
-	-13, -14, -21, 74, 30, 8, 76, 8, 76, -14,
-	73, 74, -14, 76, -14, 80, 80, 60, 74, 73,
-	4, 74, -1, 73, 73, -14, 73, -14, 78, -10,
-	-12, -11, 46, 45, 73, 76, 76, 8, -37, 80,
-	-13, 80, -17, 4, 76, -14, 80, 80, 60, -14,
-	77, -36, -16, 74, -35, -14, 4, 73, 76, 76,
-	76, -1, 80, 60, -14, -1, 74, -1, -1, 73,
-	-1, 73, 73, -14, -35, -11, -12, 60, -14, -13,
-	-1, 73, 73, 76, -36, -16, -35, 77, -16, 80,
-	60, -14, 76, 77, -14, 74, 73, -14, 73, -1,
-	74, -14, 80, 74, 74, 74, -1, 74, -1, -1,
-	73, 74, -1, 60, 60, 74, -1, -1, 73, 74,
-	74, -35, -14, 80, -14, 76, -35, 60, -1, 74,
-	80, 74, 74, 74, -1, -1, -1, 74, 74, -1,
-	4, 80, 76, -23, -14, 74, 31, 74, 74, -16,
-	-36, 31, 73, 74, 73, -1, -1, 74, 74,
+	-14, -14, -14, -14, -14, -14, -14, -14, -14, -14,
+	-14, 77, -1, -38, 16, 80, 77, 81, -14, 81,
+	77, -15, 74, -18, -13, 77, 76, -16, -16, 75,
+	-16, 77, 82, 79, -13, -14, 61, -14, -14, -16,
+	-16, 53, -36, -16, -23, -14, 60, -13, -14, -36,
+	-1, 78, -13, -13, 79, 80, 79, -14, -1, 61,
+	8, 79, 82, 61, -14, -1, 78, -14, -36, -1,
+	77, -14, 81, 81, -14, -36, 79, 8, -15, 80,
+	-37, -38, -36, 4, -16, -36, 8, 79, 82, 61,
+	-14, 79, 79, 79, 79, 80, 4, -23, 82, -37,
+	80, 61, -13, -14, -21, 78, 30, 8, 79, 8,
+	79, -14, 77, 78, -14, 79, -14, 82, 82, 61,
+	78, 77, 4, 78, -1, 77, 77, -14, 77, -14,
+	81, -10, -12, -11, 46, 45, 77, 79, 79, 8,
+	-38, 82, -13, 82, -17, 4, 79, -14, 82, 82,
+	61, -14, 80, -37, -16, 78, -36, -14, 4, 77,
+	79, 79, 79, -1, 82, 61, -14, -1, 78, -1,
+	-1, 77, -1, 77, 77, -14, -36, -11, -12, 61,
+	-14, -13, -1, 77, 77, 79, -37, -16, -36, 80,
+	-16, 82, 61, -14, 79, 80, -14, 78, 77, -14,
+	77, -1, 78, -14, 82, 78, 78, 78, -1, 78,
+	-1, -1, 77, 78, -1, 61, 61, 78, -1, -1,
+	77, 78, 78, -36, -14, 82, -14, 79, -36, 61,
+	-1, 78, 82, 78, 78, 78, -1, -1, -1, 78,
+	78, -1, 4, 82, 79, -23, -14, 78, 31, 78,
+	78, -16, -37, 31, 77, 78, 77, -1, -1, 78,
+	78,
 }
 
 var yyDef = [...]int{
-	168, -2, -2, 168, 169, 172, 171, 175, 177, 3,
+	169, -2, -2, 169, 170, 173, 172, 176, 178, 3,
 	6, 7, 8, 57, 0, 0, 0, 0, 0, 0,
 	23, 24, 25, -2, 27, 28, 0, -2, 0, 61,
-	62, 0, 173, 0, 0, 112, 110, 0, 0, 0,
-	0, 0, 173, 90, 91, 92, 93, 94, 95, 0,
-	109, 0, 114, 115, 116, 117, 118, 0, 0, 0,
-	0, 0, 139, 140, 141, 142, 2, -2, 170, 176,
-	9, 58, 10, 0, 168, 112, 0, 0, 0, 0,
-	0, 0, 0, 0, 57, 0, 0, 0, 0, 143,
-	144, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	62, 0, 174, 0, 0, 112, 110, 0, 0, 0,
+	0, 0, 174, 90, 91, 92, 93, 94, 95, 0,
+	109, 113, 114, 115, 116, 117, 0, 0, 0, 0,
+	0, 0, 138, 139, 140, 141, 142, 2, -2, 171,
+	177, 9, 58, 10, 0, 169, 112, 0, 0, 0,
+	0, 0, 0, 0, 0, 57, 0, 0, 0, 0,
+	143, 144, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 168, 0, 58, 0, 0,
-	-2, 0, 95, 0, -2, 57, 174, 0, 98, 0,
-	0, 0, 0, 0, 0, 0, 57, 0, 0, 0,
-	0, 0, 173, 0, 119, 0, 96, 57, 0, 173,
-	113, 134, 133, 135, 136, 137, 138, 4, 168, 0,
-	57, 57, 0, 0, 0, 168, 30, 32, 0, 64,
-	0, 0, 0, 86, 111, 132, 145, 146, 147, 148,
-	149, 150, 151, 152, 153, 154, 155, 156, 157, 158,
-	159, 160, 161, 162, 163, 164, 165, 166, 167, 168,
-	0, 171, 0, 173, 168, 0, 0, 0, 173, 0,
-	95, 108, 178, 173, 0, 100, 101, 0, 103, 173,
-	107, 72, 0, 0, 0, 0, 0, 0, 0, 0,
-	119, 0, 178, 0, 57, 31, 33, 0, 0, 0,
-	0, 0, 20, 0, 22, 0, 0, 0, 0, 76,
-	78, 0, 0, 0, 37, 0, 0, 0, 168, 0,
-	0, 0, 0, 49, 0, 0, 0, -2, 0, 180,
-	57, 99, 0, 0, 0, 74, 77, 0, 0, 79,
-	80, 81, 82, 0, 0, 178, 0, 0, -2, 0,
-	29, 59, -2, 11, 0, 0, -2, 0, -2, 0,
-	168, 36, 63, 75, 0, 128, 129, 0, 34, 168,
-	97, 39, 0, 168, 168, 0, 168, 0, 0, 173,
-	50, 51, 0, 57, 168, 0, 0, 0, -2, 70,
-	178, 0, 173, 0, 73, 0, 123, 124, 0, 0,
-	0, 0, 0, 89, 0, 120, 0, 168, -2, -2,
-	21, 0, 127, 0, 0, 0, 40, 0, 0, 168,
-	0, 168, 168, 0, 0, 52, 53, 168, 58, 0,
-	0, 168, 168, 0, 0, 102, 0, 173, 105, 122,
-	0, 0, 83, 0, 0, 87, 173, 0, 168, 0,
-	35, 0, 130, 38, 41, 42, 0, 44, 0, 0,
-	168, 48, 56, 168, 168, 65, 0, 0, 168, 71,
-	104, 0, 0, 125, 0, 85, 119, 0, 0, 15,
-	131, 43, 45, 46, 0, 54, 55, 66, 67, 0,
-	0, 126, 84, 178, 121, 14, 0, 47, 68, 106,
-	0, 0, 168, 88, 168, 0, 0, 13, 12,
+	0, 0, 0, 0, 0, 0, 0, 169, 0, 58,
+	0, 0, -2, 0, 95, 0, -2, 57, 175, 0,
+	98, 0, 0, 0, 0, 0, 0, 0, 57, 0,
+	0, 0, 0, 0, 174, 0, 118, 0, 96, 57,
+	0, 174, 132, 133, 134, 135, 136, 137, 4, 169,
+	0, 57, 57, 0, 0, 0, 169, 30, 32, 0,
+	64, 0, 0, 0, 86, 111, 131, 145, 146, 147,
+	148, 149, 150, 151, 152, 153, 154, 155, 156, 157,
+	158, 159, 160, 161, 162, 163, 164, 165, 166, 167,
+	168, 169, 0, 172, 0, 174, 169, 0, 0, 0,
+	174, 0, 95, 108, 179, 174, 0, 100, 101, 0,
+	103, 174, 107, 72, 0, 0, 0, 0, 0, 0,
+	0, 0, 118, 0, 179, 0, 57, 31, 33, 0,
+	0, 0, 0, 0, 20, 0, 22, 0, 0, 0,
+	0, 76, 78, 0, 0, 0, 37, 0, 0, 0,
+	169, 0, 0, 0, 0, 49, 0, 0, 0, -2,
+	0, 181, 57, 99, 0, 0, 0, 74, 77, 0,
+	0, 79, 80, 81, 82, 0, 0, 179, 0, 0,
+	-2, 0, 29, 59, -2, 11, 0, 0, -2, 0,
+	-2, 0, 169, 36, 63, 75, 0, 127, 128, 0,
+	34, 169, 97, 39, 0, 169, 169, 0, 169, 0,
+	0, 174, 50, 51, 0, 57, 169, 0, 0, 0,
+	-2, 70, 179, 0, 174, 0, 73, 0, 122, 123,
+	0, 0, 0, 0, 0, 89, 0, 119, 0, 169,
+	-2, -2, 21, 0, 126, 0, 0, 0, 40, 0,
+	0, 169, 0, 169, 169, 0, 0, 52, 53, 169,
+	58, 0, 0, 169, 169, 0, 0, 102, 0, 174,
+	105, 121, 0, 0, 83, 0, 0, 87, 174, 0,
+	169, 0, 35, 0, 129, 38, 41, 42, 0, 44,
+	0, 0, 169, 48, 56, 169, 169, 65, 0, 0,
+	169, 71, 104, 0, 0, 124, 0, 85, 118, 0,
+	0, 15, 130, 43, 45, 46, 0, 54, 55, 66,
+	67, 0, 0, 125, 84, 179, 120, 14, 0, 47,
+	68, 106, 0, 0, 169, 88, 169, 0, 0, 13,
+	12,
 }
 
 var yyTok1 = [...]int{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	83, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	84, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 82, 3, 3, 3, 70, 71, 3,
-	75, 76, 68, 64, 77, 65, 81, 69, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 60, 78,
-	62, 59, 63, 61, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 83, 3, 3, 3, 71, 72, 3,
+	74, 79, 69, 65, 80, 66, 76, 70, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 61, 81,
+	63, 60, 64, 62, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 79, 3, 80, 67, 3, 3, 3, 3, 3,
+	3, 75, 3, 82, 68, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 73, 66, 74,
+	3, 3, 3, 77, 67, 78,
 }
 
 var yyTok2 = [...]int{
@@ -910,7 +904,7 @@ var yyTok2 = [...]int{
 	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
 	32, 33, 34, 35, 36, 37, 38, 39, 40, 41,
 	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
-	52, 53, 54, 55, 56, 57, 58, 72,
+	52, 53, 54, 55, 56, 57, 58, 59, 73,
 }
 
 var yyTok3 = [...]int{
@@ -1256,19 +1250,19 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:108
+//line parser.go.y:112
 		{
 			yyVAL.compstmt = nil
 		}
 	case 2:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:112
+//line parser.go.y:116
 		{
 			yyVAL.compstmt = yyDollar[1].stmts
 		}
 	case 3:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:118
+//line parser.go.y:122
 		{
 			if yyDollar[2].stmt != nil {
 				yyVAL.stmts = &ast.StmtsStmt{Stmts: []ast.Stmt{yyDollar[2].stmt}}
@@ -1279,7 +1273,7 @@ yydefault:
 		}
 	case 4:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:127
+//line parser.go.y:131
 		{
 			if yyDollar[3].stmt != nil {
 				if yyDollar[1].stmts == nil {
@@ -1295,182 +1289,182 @@ yydefault:
 		}
 	case 5:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser.go.y:143
+//line parser.go.y:147
 		{
 			yyVAL.stmt = nil
 		}
 	case 6:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:147
+//line parser.go.y:151
 		{
 			yyVAL.stmt = yyDollar[1].stmt_var_or_lets
 		}
 	case 7:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:151
+//line parser.go.y:155
 		{
 			yyVAL.stmt = &ast.BreakStmt{}
 			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
 		}
 	case 8:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:156
+//line parser.go.y:160
 		{
 			yyVAL.stmt = &ast.ContinueStmt{}
 			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
 		}
 	case 9:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:161
+//line parser.go.y:165
 		{
 			yyVAL.stmt = &ast.ReturnStmt{Exprs: yyDollar[2].exprs}
 			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
 		}
 	case 10:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:166
+//line parser.go.y:170
 		{
 			yyVAL.stmt = &ast.ThrowStmt{Expr: yyDollar[2].expr}
 			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
 		}
 	case 11:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:171
+//line parser.go.y:175
 		{
 			yyVAL.stmt = &ast.ModuleStmt{Name: yyDollar[2].tok.Lit, Stmt: yyDollar[4].compstmt}
 			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
 		}
 	case 12:
 		yyDollar = yyS[yypt-13 : yypt+1]
-//line parser.go.y:176
+//line parser.go.y:180
 		{
 			yyVAL.stmt = &ast.TryStmt{Try: yyDollar[3].compstmt, Var: yyDollar[6].tok.Lit, Catch: yyDollar[8].compstmt, Finally: yyDollar[12].compstmt}
 			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
 		}
 	case 13:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line parser.go.y:181
+//line parser.go.y:185
 		{
 			yyVAL.stmt = &ast.TryStmt{Try: yyDollar[3].compstmt, Catch: yyDollar[7].compstmt, Finally: yyDollar[11].compstmt}
 			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
 		}
 	case 14:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line parser.go.y:186
+//line parser.go.y:190
 		{
 			yyVAL.stmt = &ast.TryStmt{Try: yyDollar[3].compstmt, Var: yyDollar[6].tok.Lit, Catch: yyDollar[8].compstmt}
 			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
 		}
 	case 15:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser.go.y:191
+//line parser.go.y:195
 		{
 			yyVAL.stmt = &ast.TryStmt{Try: yyDollar[3].compstmt, Catch: yyDollar[7].compstmt}
 			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
 		}
 	case 16:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser.go.y:196
+//line parser.go.y:200
 		{
 			yyVAL.stmt = &ast.GoroutineStmt{Expr: &ast.CallExpr{Name: yyDollar[2].tok.Lit, SubExprs: yyDollar[4].exprs, VarArg: true, Go: true}}
 			yyVAL.stmt.SetPosition(yyDollar[2].tok.Position())
 		}
 	case 17:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:201
+//line parser.go.y:205
 		{
 			yyVAL.stmt = &ast.GoroutineStmt{Expr: &ast.CallExpr{Name: yyDollar[2].tok.Lit, SubExprs: yyDollar[4].exprs, Go: true}}
 			yyVAL.stmt.SetPosition(yyDollar[2].tok.Position())
 		}
 	case 18:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser.go.y:206
+//line parser.go.y:210
 		{
 			yyVAL.stmt = &ast.GoroutineStmt{Expr: &ast.AnonCallExpr{Expr: yyDollar[2].expr, SubExprs: yyDollar[4].exprs, VarArg: true, Go: true}}
 			yyVAL.stmt.SetPosition(yyDollar[2].expr.Position())
 		}
 	case 19:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:211
+//line parser.go.y:215
 		{
 			yyVAL.stmt = &ast.GoroutineStmt{Expr: &ast.AnonCallExpr{Expr: yyDollar[2].expr, SubExprs: yyDollar[4].exprs, Go: true}}
 			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
 		}
 	case 20:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:216
+//line parser.go.y:220
 		{
 			yyVAL.stmt = &ast.DeleteStmt{Item: yyDollar[3].expr}
 			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
 		}
 	case 21:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser.go.y:221
+//line parser.go.y:225
 		{
 			yyVAL.stmt = &ast.DeleteStmt{Item: yyDollar[3].expr, Key: yyDollar[5].expr}
 			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
 		}
 	case 22:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:226
+//line parser.go.y:230
 		{
 			yyVAL.stmt = &ast.CloseStmt{Expr: yyDollar[3].expr}
 			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
 		}
 	case 23:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:231
+//line parser.go.y:235
 		{
 			yyVAL.stmt = yyDollar[1].stmt_if
 		}
 	case 24:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:235
+//line parser.go.y:239
 		{
 			yyVAL.stmt = yyDollar[1].stmt_for
 		}
 	case 25:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:239
+//line parser.go.y:243
 		{
 			yyVAL.stmt = yyDollar[1].stmt_switch
 		}
 	case 26:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:243
+//line parser.go.y:247
 		{
 			yyVAL.stmt = &ast.ExprStmt{Expr: yyDollar[1].expr}
 			yyVAL.stmt.SetPosition(yyDollar[1].expr.Position())
 		}
 	case 27:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:250
+//line parser.go.y:254
 		{
 			yyVAL.stmt_var_or_lets = yyDollar[1].stmt_var
 		}
 	case 28:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:254
+//line parser.go.y:258
 		{
 			yyVAL.stmt_var_or_lets = yyDollar[1].stmt_lets
 		}
 	case 29:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:260
+//line parser.go.y:264
 		{
 			yyVAL.stmt_var = &ast.VarStmt{Names: yyDollar[2].expr_idents, Exprs: yyDollar[4].exprs}
 			yyVAL.stmt_var.SetPosition(yyDollar[1].tok.Position())
 		}
 	case 30:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:267
+//line parser.go.y:271
 		{
 			yyVAL.stmt_lets = &ast.LetsStmt{LHSS: []ast.Expr{yyDollar[1].expr}, RHSS: []ast.Expr{yyDollar[3].expr}}
 			yyVAL.stmt_lets.SetPosition(yyDollar[1].expr.Position())
 		}
 	case 31:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:272
+//line parser.go.y:276
 		{
 			if len(yyDollar[1].exprs) == 2 && len(yyDollar[3].exprs) == 1 {
 				if _, ok := yyDollar[3].exprs[0].(*ast.ItemExpr); ok {
@@ -1485,14 +1479,14 @@ yydefault:
 		}
 	case 32:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:285
+//line parser.go.y:289
 		{
 			yyVAL.stmt_lets = &ast.ChanStmt{LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 			yyVAL.stmt_lets.SetPosition(yyDollar[1].expr.Position())
 		}
 	case 33:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:290
+//line parser.go.y:294
 		{
 			if len(yyDollar[1].exprs) == 2 {
 				chanStmt := &ast.ChanStmt{LHS: yyDollar[1].exprs[0].(ast.Expr), OkExpr: yyDollar[1].exprs[1].(ast.Expr), RHS: yyDollar[3].expr}
@@ -1506,21 +1500,21 @@ yydefault:
 		}
 	case 34:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:304
+//line parser.go.y:308
 		{
 			yyVAL.stmt_if = &ast.IfStmt{If: yyDollar[2].expr, Then: yyDollar[4].compstmt, Else: nil}
 			yyVAL.stmt_if.SetPosition(yyDollar[1].tok.Position())
 		}
 	case 35:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser.go.y:309
+//line parser.go.y:313
 		{
 			ifStmt := yyDollar[1].stmt_if.(*ast.IfStmt)
 			ifStmt.ElseIf = append(ifStmt.ElseIf, &ast.IfStmt{If: yyDollar[4].expr, Then: yyDollar[6].compstmt})
 		}
 	case 36:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:314
+//line parser.go.y:318
 		{
 			ifStmt := yyDollar[1].stmt_if.(*ast.IfStmt)
 			if ifStmt.Else != nil {
@@ -1530,14 +1524,14 @@ yydefault:
 		}
 	case 37:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:324
+//line parser.go.y:328
 		{
 			yyVAL.stmt_for = &ast.LoopStmt{Stmt: yyDollar[3].compstmt}
 			yyVAL.stmt_for.SetPosition(yyDollar[1].tok.Position())
 		}
 	case 38:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser.go.y:329
+//line parser.go.y:333
 		{
 			if len(yyDollar[2].expr_idents) < 1 {
 				yylex.Error("missing identifier")
@@ -1550,70 +1544,70 @@ yydefault:
 		}
 	case 39:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:340
+//line parser.go.y:344
 		{
 			yyVAL.stmt_for = &ast.LoopStmt{Expr: yyDollar[2].expr, Stmt: yyDollar[4].compstmt}
 			yyVAL.stmt_for.SetPosition(yyDollar[1].tok.Position())
 		}
 	case 40:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser.go.y:345
+//line parser.go.y:349
 		{
 			yyVAL.stmt_for = &ast.CForStmt{Stmt: yyDollar[5].compstmt}
 			yyVAL.stmt_for.SetPosition(yyDollar[1].tok.Position())
 		}
 	case 41:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser.go.y:350
+//line parser.go.y:354
 		{
 			yyVAL.stmt_for = &ast.CForStmt{Expr3: yyDollar[4].expr, Stmt: yyDollar[6].compstmt}
 			yyVAL.stmt_for.SetPosition(yyDollar[1].tok.Position())
 		}
 	case 42:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser.go.y:355
+//line parser.go.y:359
 		{
 			yyVAL.stmt_for = &ast.CForStmt{Expr2: yyDollar[3].expr, Stmt: yyDollar[6].compstmt}
 			yyVAL.stmt_for.SetPosition(yyDollar[1].tok.Position())
 		}
 	case 43:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser.go.y:360
+//line parser.go.y:364
 		{
 			yyVAL.stmt_for = &ast.CForStmt{Expr2: yyDollar[3].expr, Expr3: yyDollar[5].expr, Stmt: yyDollar[7].compstmt}
 			yyVAL.stmt_for.SetPosition(yyDollar[1].tok.Position())
 		}
 	case 44:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser.go.y:365
+//line parser.go.y:369
 		{
 			yyVAL.stmt_for = &ast.CForStmt{Stmt1: yyDollar[2].stmt_var_or_lets, Stmt: yyDollar[6].compstmt}
 			yyVAL.stmt_for.SetPosition(yyDollar[1].tok.Position())
 		}
 	case 45:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser.go.y:370
+//line parser.go.y:374
 		{
 			yyVAL.stmt_for = &ast.CForStmt{Stmt1: yyDollar[2].stmt_var_or_lets, Expr3: yyDollar[5].expr, Stmt: yyDollar[7].compstmt}
 			yyVAL.stmt_for.SetPosition(yyDollar[1].tok.Position())
 		}
 	case 46:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser.go.y:375
+//line parser.go.y:379
 		{
 			yyVAL.stmt_for = &ast.CForStmt{Stmt1: yyDollar[2].stmt_var_or_lets, Expr2: yyDollar[4].expr, Stmt: yyDollar[7].compstmt}
 			yyVAL.stmt_for.SetPosition(yyDollar[1].tok.Position())
 		}
 	case 47:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line parser.go.y:380
+//line parser.go.y:384
 		{
 			yyVAL.stmt_for = &ast.CForStmt{Stmt1: yyDollar[2].stmt_var_or_lets, Expr2: yyDollar[4].expr, Expr3: yyDollar[6].expr, Stmt: yyDollar[8].compstmt}
 			yyVAL.stmt_for.SetPosition(yyDollar[1].tok.Position())
 		}
 	case 48:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser.go.y:387
+//line parser.go.y:391
 		{
 			switchStmt := yyDollar[5].stmt_switch_cases.(*ast.SwitchStmt)
 			switchStmt.Expr = yyDollar[2].expr
@@ -1622,25 +1616,25 @@ yydefault:
 		}
 	case 49:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser.go.y:396
+//line parser.go.y:400
 		{
 			yyVAL.stmt_switch_cases = &ast.SwitchStmt{}
 		}
 	case 50:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:400
+//line parser.go.y:404
 		{
 			yyVAL.stmt_switch_cases = &ast.SwitchStmt{Default: yyDollar[1].stmt_switch_default}
 		}
 	case 51:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:404
+//line parser.go.y:408
 		{
 			yyVAL.stmt_switch_cases = &ast.SwitchStmt{Cases: []ast.Stmt{yyDollar[1].stmt_switch_case}}
 		}
 	case 52:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:408
+//line parser.go.y:412
 		{
 			switchStmt := yyDollar[1].stmt_switch_cases.(*ast.SwitchStmt)
 			switchStmt.Cases = append(switchStmt.Cases, yyDollar[2].stmt_switch_case)
@@ -1648,7 +1642,7 @@ yydefault:
 		}
 	case 53:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:414
+//line parser.go.y:418
 		{
 			switchStmt := yyDollar[1].stmt_switch_cases.(*ast.SwitchStmt)
 			if switchStmt.Default != nil {
@@ -1658,39 +1652,39 @@ yydefault:
 		}
 	case 54:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:424
+//line parser.go.y:428
 		{
 			yyVAL.stmt_switch_case = &ast.SwitchCaseStmt{Exprs: []ast.Expr{yyDollar[2].expr}, Stmt: yyDollar[4].compstmt}
 			yyVAL.stmt_switch_case.SetPosition(yyDollar[1].tok.Position())
 		}
 	case 55:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:429
+//line parser.go.y:433
 		{
 			yyVAL.stmt_switch_case = &ast.SwitchCaseStmt{Exprs: yyDollar[2].exprs, Stmt: yyDollar[4].compstmt}
 			yyVAL.stmt_switch_case.SetPosition(yyDollar[1].tok.Position())
 		}
 	case 56:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:436
+//line parser.go.y:440
 		{
 			yyVAL.stmt_switch_default = yyDollar[3].compstmt
 		}
 	case 57:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser.go.y:443
+//line parser.go.y:447
 		{
 			yyVAL.exprs = nil
 		}
 	case 58:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:447
+//line parser.go.y:451
 		{
 			yyVAL.exprs = []ast.Expr{yyDollar[1].expr}
 		}
 	case 59:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:451
+//line parser.go.y:455
 		{
 			if len(yyDollar[1].exprs) == 0 {
 				yylex.Error("syntax error: unexpected ','")
@@ -1699,7 +1693,7 @@ yydefault:
 		}
 	case 60:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:458
+//line parser.go.y:462
 		{
 			if len(yyDollar[1].exprs) == 0 {
 				yylex.Error("syntax error: unexpected ','")
@@ -1708,61 +1702,61 @@ yydefault:
 		}
 	case 61:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:467
+//line parser.go.y:471
 		{
 			yyVAL.expr = yyDollar[1].expr_member_or_ident
 		}
 	case 62:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:471
+//line parser.go.y:475
 		{
 			yyVAL.expr = yyDollar[1].expr_literals
 		}
 	case 63:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:475
+//line parser.go.y:479
 		{
 			yyVAL.expr = &ast.TernaryOpExpr{Expr: yyDollar[1].expr, LHS: yyDollar[3].expr, RHS: yyDollar[5].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
 	case 64:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:480
+//line parser.go.y:484
 		{
 			yyVAL.expr = &ast.NilCoalescingOpExpr{LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
 	case 65:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser.go.y:485
+//line parser.go.y:489
 		{
 			yyVAL.expr = &ast.FuncExpr{Params: yyDollar[3].expr_idents, Stmt: yyDollar[6].compstmt}
 			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
 		}
 	case 66:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser.go.y:490
+//line parser.go.y:494
 		{
 			yyVAL.expr = &ast.FuncExpr{Params: yyDollar[3].expr_idents, Stmt: yyDollar[7].compstmt, VarArg: true}
 			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
 		}
 	case 67:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser.go.y:495
+//line parser.go.y:499
 		{
 			yyVAL.expr = &ast.FuncExpr{Name: yyDollar[2].tok.Lit, Params: yyDollar[4].expr_idents, Stmt: yyDollar[7].compstmt}
 			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
 		}
 	case 68:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line parser.go.y:500
+//line parser.go.y:504
 		{
 			yyVAL.expr = &ast.FuncExpr{Name: yyDollar[2].tok.Lit, Params: yyDollar[4].expr_idents, Stmt: yyDollar[8].compstmt, VarArg: true}
 			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
 		}
 	case 69:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:505
+//line parser.go.y:509
 		{
 			yyVAL.expr = &ast.ArrayExpr{}
 			if l, ok := yylex.(*Lexer); ok {
@@ -1771,7 +1765,7 @@ yydefault:
 		}
 	case 70:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:510
+//line parser.go.y:514
 		{
 			yyVAL.expr = &ast.ArrayExpr{Exprs: yyDollar[3].exprs}
 			if l, ok := yylex.(*Lexer); ok {
@@ -1780,7 +1774,7 @@ yydefault:
 		}
 	case 71:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser.go.y:515
+//line parser.go.y:519
 		{
 			yyVAL.expr = &ast.ArrayExpr{Exprs: yyDollar[5].exprs, TypeData: &ast.TypeStruct{Kind: ast.TypeSlice, SubType: yyDollar[2].type_data, Dimensions: yyDollar[1].slice_count}}
 			if l, ok := yylex.(*Lexer); ok {
@@ -1789,7 +1783,7 @@ yydefault:
 		}
 	case 72:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:520
+//line parser.go.y:524
 		{
 			yyVAL.expr = &ast.ParenExpr{SubExpr: yyDollar[2].expr}
 			if l, ok := yylex.(*Lexer); ok {
@@ -1798,63 +1792,63 @@ yydefault:
 		}
 	case 73:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:525
+//line parser.go.y:529
 		{
 			yyVAL.expr = &ast.CallExpr{Name: yyDollar[1].tok.Lit, SubExprs: yyDollar[3].exprs, VarArg: true}
 			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
 		}
 	case 74:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:530
+//line parser.go.y:534
 		{
 			yyVAL.expr = &ast.CallExpr{Name: yyDollar[1].tok.Lit, SubExprs: yyDollar[3].exprs}
 			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
 		}
 	case 75:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:535
+//line parser.go.y:539
 		{
 			yyVAL.expr = &ast.AnonCallExpr{Expr: yyDollar[1].expr, SubExprs: yyDollar[3].exprs, VarArg: true}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
 	case 76:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:540
+//line parser.go.y:544
 		{
 			yyVAL.expr = &ast.AnonCallExpr{Expr: yyDollar[1].expr, SubExprs: yyDollar[3].exprs}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
 	case 77:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:545
+//line parser.go.y:549
 		{
 			yyVAL.expr = &ast.ItemExpr{Item: yyDollar[1].expr_ident, Index: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr_ident.Position())
 		}
 	case 78:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:550
+//line parser.go.y:554
 		{
 			yyVAL.expr = &ast.ItemExpr{Item: yyDollar[1].expr, Index: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
 	case 79:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:555
+//line parser.go.y:559
 		{
 			yyVAL.expr = &ast.LenExpr{Expr: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
 		}
 	case 80:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:560
+//line parser.go.y:564
 		{
 			yyVAL.expr = &ast.ImportExpr{Name: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
 		}
 	case 81:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:565
+//line parser.go.y:569
 		{
 			if yyDollar[3].type_data.Kind == ast.TypeDefault {
 				yyDollar[3].type_data.Kind = ast.TypePtr
@@ -1866,42 +1860,42 @@ yydefault:
 		}
 	case 82:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:575
+//line parser.go.y:579
 		{
 			yyVAL.expr = &ast.MakeExpr{TypeData: yyDollar[3].type_data}
 			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
 		}
 	case 83:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser.go.y:580
+//line parser.go.y:584
 		{
 			yyVAL.expr = &ast.MakeExpr{TypeData: yyDollar[3].type_data, LenExpr: yyDollar[5].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
 		}
 	case 84:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser.go.y:585
+//line parser.go.y:589
 		{
 			yyVAL.expr = &ast.MakeExpr{TypeData: yyDollar[3].type_data, LenExpr: yyDollar[5].expr, CapExpr: yyDollar[7].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
 		}
 	case 85:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser.go.y:590
+//line parser.go.y:594
 		{
 			yyVAL.expr = &ast.MakeTypeExpr{Name: yyDollar[4].tok.Lit, Type: yyDollar[6].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
 		}
 	case 86:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:595
+//line parser.go.y:599
 		{
 			yyVAL.expr = &ast.IncludeExpr{ItemExpr: yyDollar[1].expr, ListExpr: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
 	case 87:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser.go.y:600
+//line parser.go.y:604
 		{
 			yyDollar[4].expr_map.TypeData = &ast.TypeStruct{Kind: ast.TypeMap, Key: &ast.TypeStruct{Name: "interface"}, SubType: &ast.TypeStruct{Name: "interface"}}
 			yyVAL.expr = yyDollar[4].expr_map
@@ -1909,7 +1903,7 @@ yydefault:
 		}
 	case 88:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line parser.go.y:606
+//line parser.go.y:610
 		{
 			yyDollar[8].expr_map.TypeData = &ast.TypeStruct{Kind: ast.TypeMap, Key: yyDollar[3].type_data, SubType: yyDollar[5].type_data}
 			yyVAL.expr = yyDollar[8].expr_map
@@ -1917,40 +1911,40 @@ yydefault:
 		}
 	case 89:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:612
+//line parser.go.y:616
 		{
 			yyVAL.expr = yyDollar[3].expr_map
 			yyVAL.expr.SetPosition(yyDollar[3].expr_map.Position())
 		}
 	case 90:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:617
+//line parser.go.y:621
 		{
 			yyVAL.expr = yyDollar[1].expr_slice
 			yyVAL.expr.SetPosition(yyDollar[1].expr_slice.Position())
 		}
 	case 91:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:622
+//line parser.go.y:626
 		{
 			yyVAL.expr = yyDollar[1].expr_chan
 			yyVAL.expr.SetPosition(yyDollar[1].expr_chan.Position())
 		}
 	case 95:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser.go.y:631
+//line parser.go.y:635
 		{
 			yyVAL.expr_idents = []string{}
 		}
 	case 96:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:635
+//line parser.go.y:639
 		{
 			yyVAL.expr_idents = []string{yyDollar[1].tok.Lit}
 		}
 	case 97:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:639
+//line parser.go.y:643
 		{
 			if len(yyDollar[1].expr_idents) == 0 {
 				yylex.Error("syntax error: unexpected ','")
@@ -1959,13 +1953,13 @@ yydefault:
 		}
 	case 98:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:648
+//line parser.go.y:652
 		{
 			yyVAL.type_data = &ast.TypeStruct{Name: yyDollar[1].tok.Lit}
 		}
 	case 99:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:652
+//line parser.go.y:656
 		{
 			if yyDollar[1].type_data.Kind != ast.TypeDefault {
 				yylex.Error("not type default")
@@ -1976,7 +1970,7 @@ yydefault:
 		}
 	case 100:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:661
+//line parser.go.y:665
 		{
 			if yyDollar[2].type_data.Kind == ast.TypeDefault {
 				yyDollar[2].type_data.Kind = ast.TypePtr
@@ -1987,7 +1981,7 @@ yydefault:
 		}
 	case 101:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:670
+//line parser.go.y:674
 		{
 			if yyDollar[2].type_data.Kind == ast.TypeDefault {
 				yyDollar[2].type_data.Kind = ast.TypeSlice
@@ -1999,13 +1993,13 @@ yydefault:
 		}
 	case 102:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:680
+//line parser.go.y:684
 		{
 			yyVAL.type_data = &ast.TypeStruct{Kind: ast.TypeMap, Key: yyDollar[3].type_data, SubType: yyDollar[5].type_data}
 		}
 	case 103:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:684
+//line parser.go.y:688
 		{
 			if yyDollar[2].type_data.Kind == ast.TypeDefault {
 				yyDollar[2].type_data.Kind = ast.TypeChan
@@ -2016,19 +2010,19 @@ yydefault:
 		}
 	case 104:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser.go.y:693
+//line parser.go.y:697
 		{
 			yyVAL.type_data = yyDollar[4].type_data_struct
 		}
 	case 105:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:699
+//line parser.go.y:703
 		{
 			yyVAL.type_data_struct = &ast.TypeStruct{Kind: ast.TypeStructType, StructNames: []string{yyDollar[1].tok.Lit}, StructTypes: []*ast.TypeStruct{yyDollar[2].type_data}}
 		}
 	case 106:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:703
+//line parser.go.y:707
 		{
 			if yyDollar[1].type_data_struct == nil {
 				yylex.Error("syntax error: unexpected ','")
@@ -2038,107 +2032,100 @@ yydefault:
 		}
 	case 107:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:713
+//line parser.go.y:717
 		{
 			yyVAL.slice_count = 1
 		}
 	case 108:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:717
+//line parser.go.y:721
 		{
 			yyVAL.slice_count = yyDollar[3].slice_count + 1
 		}
 	case 109:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:723
+//line parser.go.y:727
 		{
 			yyVAL.expr_member_or_ident = yyDollar[1].expr_member
 		}
 	case 110:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:727
+//line parser.go.y:731
 		{
 			yyVAL.expr_member_or_ident = yyDollar[1].expr_ident
 		}
 	case 111:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:733
+//line parser.go.y:737
 		{
 			yyVAL.expr_member = &ast.MemberExpr{Expr: yyDollar[1].expr, Name: yyDollar[3].tok.Lit}
 			yyVAL.expr_member.SetPosition(yyDollar[1].expr.Position())
 		}
 	case 112:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:740
+//line parser.go.y:744
 		{
 			yyVAL.expr_ident = &ast.IdentExpr{Lit: yyDollar[1].tok.Lit}
 			yyVAL.expr_ident.SetPosition(yyDollar[1].tok.Position())
 		}
 	case 113:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:747
-		{
-			num, err := toNumber("-" + yyDollar[2].tok.Lit)
-			if err != nil {
-				yylex.Error("invalid number: -" + yyDollar[2].tok.Lit)
-			}
-			yyVAL.expr_literals = &ast.LiteralExpr{Literal: num}
-			yyVAL.expr_literals.SetPosition(yyDollar[2].tok.Position())
-		}
-	case 114:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:756
+//line parser.go.y:751
 		{
-			num, err := toNumber(yyDollar[1].tok.Lit)
-			if err != nil {
-				yylex.Error("invalid number: " + yyDollar[1].tok.Lit)
+			if l, ok := yylex.(*Lexer); ok {
+				yyVAL.expr_literals = l.numberExpr(yyDollar[1].tok)
+			} else {
+				num, err := toNumber(yyDollar[1].tok.Lit)
+				if err != nil {
+					yylex.Error("invalid number: " + yyDollar[1].tok.Lit)
+				}
+				yyVAL.expr_literals = &ast.LiteralExpr{Literal: num}
+				yyVAL.expr_literals.SetPosition(yyDollar[1].tok.Position())
 			}
-			yyVAL.expr_literals = &ast.LiteralExpr{Literal: num}
-			yyVAL.expr_literals.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 115:
+	case 114:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:765
+//line parser.go.y:764
 		{
 			yyVAL.expr_literals = &ast.LiteralExpr{Literal: stringToValue(yyDollar[1].tok.Lit)}
 			yyVAL.expr_literals.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 116:
+	case 115:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:770
+//line parser.go.y:769
 		{
 			yyVAL.expr_literals = &ast.LiteralExpr{Literal: trueValue}
 			yyVAL.expr_literals.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 117:
+	case 116:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:775
+//line parser.go.y:774
 		{
 			yyVAL.expr_literals = &ast.LiteralExpr{Literal: falseValue}
 			yyVAL.expr_literals.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 118:
+	case 117:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:780
+//line parser.go.y:779
 		{
 			yyVAL.expr_literals = &ast.LiteralExpr{Literal: nilValue}
 			yyVAL.expr_literals.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 119:
+	case 118:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser.go.y:787
+//line parser.go.y:786
 		{
 			yyVAL.expr_map = &ast.MapExpr{}
 		}
-	case 120:
+	case 119:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:791
+//line parser.go.y:790
 		{
 			yyVAL.expr_map = &ast.MapExpr{Keys: []ast.Expr{yyDollar[1].expr}, Values: []ast.Expr{yyDollar[3].expr}}
 		}
-	case 121:
+	case 120:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser.go.y:795
+//line parser.go.y:794
 		{
 			if yyDollar[1].expr_map.Keys == nil {
 				yylex.Error("syntax error: unexpected ','")
@@ -2146,144 +2133,159 @@ yydefault:
 			yyVAL.expr_map.Keys = append(yyVAL.expr_map.Keys, yyDollar[4].expr)
 			yyVAL.expr_map.Values = append(yyVAL.expr_map.Values, yyDollar[6].expr)
 		}
-	case 122:
+	case 121:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser.go.y:805
+//line parser.go.y:804
 		{
 			yyVAL.expr_slice = &ast.SliceExpr{Item: yyDollar[1].expr_ident, Begin: yyDollar[3].expr, End: yyDollar[5].expr}
 		}
-	case 123:
+	case 122:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:809
+//line parser.go.y:808
 		{
 			yyVAL.expr_slice = &ast.SliceExpr{Item: yyDollar[1].expr_ident, Begin: yyDollar[3].expr, End: nil}
 		}
-	case 124:
+	case 123:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:813
+//line parser.go.y:812
 		{
 			yyVAL.expr_slice = &ast.SliceExpr{Item: yyDollar[1].expr_ident, Begin: nil, End: yyDollar[4].expr}
 		}
-	case 125:
+	case 124:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser.go.y:817
+//line parser.go.y:816
 		{
 			yyVAL.expr_slice = &ast.SliceExpr{Item: yyDollar[1].expr_ident, End: yyDollar[4].expr, Cap: yyDollar[6].expr}
 		}
-	case 126:
+	case 125:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser.go.y:821
+//line parser.go.y:820
 		{
 			yyVAL.expr_slice = &ast.SliceExpr{Item: yyDollar[1].expr_ident, Begin: yyDollar[3].expr, End: yyDollar[5].expr, Cap: yyDollar[7].expr}
 		}
-	case 127:
+	case 126:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser.go.y:825
+//line parser.go.y:824
 		{
 			yyVAL.expr_slice = &ast.SliceExpr{Item: yyDollar[1].expr, Begin: yyDollar[3].expr, End: yyDollar[5].expr}
 		}
-	case 128:
+	case 127:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:829
+//line parser.go.y:828
 		{
 			yyVAL.expr_slice = &ast.SliceExpr{Item: yyDollar[1].expr, Begin: yyDollar[3].expr, End: nil}
 		}
-	case 129:
+	case 128:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:833
+//line parser.go.y:832
 		{
 			yyVAL.expr_slice = &ast.SliceExpr{Item: yyDollar[1].expr, Begin: nil, End: yyDollar[4].expr}
 		}
-	case 130:
+	case 129:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser.go.y:837
+//line parser.go.y:836
 		{
 			yyVAL.expr_slice = &ast.SliceExpr{Item: yyDollar[1].expr, End: yyDollar[4].expr, Cap: yyDollar[6].expr}
 		}
-	case 131:
+	case 130:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser.go.y:841
+//line parser.go.y:840
 		{
 			yyVAL.expr_slice = &ast.SliceExpr{Item: yyDollar[1].expr, Begin: yyDollar[3].expr, End: yyDollar[5].expr, Cap: yyDollar[7].expr}
 		}
-	case 132:
+	case 131:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:847
+//line parser.go.y:846
 		{
 			yyVAL.expr_chan = &ast.ChanExpr{LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 133:
+	case 132:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:851
+//line parser.go.y:850
 		{
 			yyVAL.expr_chan = &ast.ChanExpr{RHS: yyDollar[2].expr}
 		}
-	case 134:
+	case 133:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:857
+//line parser.go.y:856
 		{
-			yyVAL.expr = &ast.UnaryExpr{Operator: "-", Expr: yyDollar[2].expr}
-			yyVAL.expr.SetPosition(yyDollar[2].expr.Position())
+			var expr ast.Expr
+			if l, ok := yylex.(*Lexer); ok {
+				// a minus in front of a number is a negative number literal
+				expr = l.negateNumber(yyDollar[2].expr)
+			}
+			if expr == nil {
+				expr = &ast.UnaryExpr{Operator: "-", Expr: yyDollar[2].expr}
+				expr.SetPosition(yyDollar[2].expr.Position())
+			}
+			yyVAL.expr = expr
 		}
-	case 135:
+	case 134:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:862
+//line parser.go.y:869
 		{
 			yyVAL.expr = &ast.UnaryExpr{Operator: "!", Expr: yyDollar[2].expr}
 			yyVAL.expr.SetPosition(yyDollar[2].expr.Position())
 		}
-	case 136:
+	case 135:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:867
+//line parser.go.y:874
 		{
 			yyVAL.expr = &ast.UnaryExpr{Operator: "^", Expr: yyDollar[2].expr}
 			yyVAL.expr.SetPosition(yyDollar[2].expr.Position())
 		}
-	case 137:
+	case 136:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:872
+//line parser.go.y:879
 		{
 			yyVAL.expr = &ast.AddrExpr{Expr: yyDollar[2].expr}
 			yyVAL.expr.SetPosition(yyDollar[2].expr.Position())
 		}
-	case 138:
+	case 137:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:877
+//line parser.go.y:884
 		{
 			yyVAL.expr = &ast.DerefExpr{Expr: yyDollar[2].expr}
 			yyVAL.expr.SetPosition(yyDollar[2].expr.Position())
 		}
+	case 138:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.go.y:891
+		{
+			yyVAL.expr = &ast.OpExpr{Op: yyDollar[1].expr}
+			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
+		}
 	case 139:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:884
+//line parser.go.y:896
 		{
 			yyVAL.expr = &ast.OpExpr{Op: yyDollar[1].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
 	case 140:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:889
+//line parser.go.y:901
 		{
 			yyVAL.expr = &ast.OpExpr{Op: yyDollar[1].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
 	case 141:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:894
+//line parser.go.y:906
 		{
 			yyVAL.expr = &ast.OpExpr{Op: yyDollar[1].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
 	case 142:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:899
+//line parser.go.y:911
 		{
-			yyVAL.expr = &ast.OpExpr{Op: yyDollar[1].expr}
-			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
+			yyVAL.expr = &ast.OpExpr{Op: yyDollar[1].op_pipe}
+			yyVAL.expr.SetPosition(yyDollar[1].op_pipe.Position())
 		}
 	case 143:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:906
+//line parser.go.y:918
 		{
 			rhs := &ast.OpExpr{Op: &ast.AddOperator{LHS: yyDollar[1].expr, Operator: "+", RHS: oneLiteral}}
 			rhs.Op.SetPosition(yyDollar[1].expr.Position())
@@ -2293,7 +2295,7 @@ yydefault:
 		}
 	case 144:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:914
+//line parser.go.y:926
 		{
 			rhs := &ast.OpExpr{Op: &ast.AddOperator{LHS: yyDollar[1].expr, Operator: "-", RHS: oneLiteral}}
 			rhs.Op.SetPosition(yyDollar[1].expr.Position())
@@ -2303,7 +2305,7 @@ yydefault:
 		}
 	case 145:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:922
+//line parser.go.y:934
 		{
 			rhs := &ast.OpExpr{Op: &ast.AddOperator{LHS: yyDollar[1].expr, Operator: "+", RHS: yyDollar[3].expr}}
 			rhs.Op.SetPosition(yyDollar[1].expr.Position())
@@ -2313,7 +2315,7 @@ yydefault:
 		}
 	case 146:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:930
+//line parser.go.y:942
 		{
 			rhs := &ast.OpExpr{Op: &ast.AddOperator{LHS: yyDollar[1].expr, Operator: "-", RHS: yyDollar[3].expr}}
 			rhs.Op.SetPosition(yyDollar[1].expr.Position())
@@ -2323,7 +2325,7 @@ yydefault:
 		}
 	case 147:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:938
+//line parser.go.y:950
 		{
 			rhs := &ast.OpExpr{Op: &ast.AddOperator{LHS: yyDollar[1].expr, Operator: "|", RHS: yyDollar[3].expr}}
 			rhs.Op.SetPosition(yyDollar[1].expr.Position())
@@ -2333,7 +2335,7 @@ yydefault:
 		}
 	case 148:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:946
+//line parser.go.y:958
 		{
 			rhs := &ast.OpExpr{Op: &ast.MultiplyOperator{LHS: yyDollar[1].expr, Operator: "*", RHS: yyDollar[3].expr}}
 			rhs.Op.SetPosition(yyDollar[1].expr.Position())
@@ -2343,7 +2345,7 @@ yydefault:
 		}
 	case 149:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:954
+//line parser.go.y:966
 		{
 			rhs := &ast.OpExpr{Op: &ast.MultiplyOperator{LHS: yyDollar[1].expr, Operator: "/", RHS: yyDollar[3].expr}}
 			rhs.Op.SetPosition(yyDollar[1].expr.Position())
@@ -2353,7 +2355,7 @@ yydefault:
 		}
 	case 150:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:962
+//line parser.go.y:974
 		{
 			rhs := &ast.OpExpr{Op: &ast.MultiplyOperator{LHS: yyDollar[1].expr, Operator: "&", RHS: yyDollar[3].expr}}
 			rhs.Op.SetPosition(yyDollar[1].expr.Position())
@@ -2363,123 +2365,130 @@ yydefault:
 		}
 	case 151:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:973
+//line parser.go.y:985
 		{
 			yyVAL.expr = &ast.MultiplyOperator{LHS: yyDollar[1].expr, Operator: "*", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
 	case 152:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:978
+//line parser.go.y:990
 		{
 			yyVAL.expr = &ast.MultiplyOperator{LHS: yyDollar[1].expr, Operator: "/", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
 	case 153:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:983
+//line parser.go.y:995
 		{
 			yyVAL.expr = &ast.MultiplyOperator{LHS: yyDollar[1].expr, Operator: "%", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
 	case 154:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:988
+//line parser.go.y:1000
 		{
 			yyVAL.expr = &ast.MultiplyOperator{LHS: yyDollar[1].expr, Operator: "<<", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
 	case 155:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:993
+//line parser.go.y:1005
 		{
 			yyVAL.expr = &ast.MultiplyOperator{LHS: yyDollar[1].expr, Operator: ">>", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
 	case 156:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:998
+//line parser.go.y:1010
 		{
 			yyVAL.expr = &ast.MultiplyOperator{LHS: yyDollar[1].expr, Operator: "&", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
 	case 157:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:1005
+//line parser.go.y:1017
 		{
 			yyVAL.expr = &ast.AddOperator{LHS: yyDollar[1].expr, Operator: "+", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
 	case 158:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:1010
+//line parser.go.y:1022
 		{
 			yyVAL.expr = &ast.AddOperator{LHS: yyDollar[1].expr, Operator: "-", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
 	case 159:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:1015
+//line parser.go.y:1027
 		{
 			yyVAL.expr = &ast.AddOperator{LHS: yyDollar[1].expr, Operator: "|", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
 	case 160:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:1022
+//line parser.go.y:1034
 		{
 			yyVAL.expr = &ast.ComparisonOperator{LHS: yyDollar[1].expr, Operator: "==", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
 	case 161:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:1027
+//line parser.go.y:1039
 		{
 			yyVAL.expr = &ast.ComparisonOperator{LHS: yyDollar[1].expr, Operator: "!=", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
 	case 162:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:1032
+//line parser.go.y:1044
 		{
 			yyVAL.expr = &ast.ComparisonOperator{LHS: yyDollar[1].expr, Operator: "<", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
 	case 163:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:1037
+//line parser.go.y:1049
 		{
 			yyVAL.expr = &ast.ComparisonOperator{LHS: yyDollar[1].expr, Operator: "<=", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
 	case 164:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:1042
+//line parser.go.y:1054
 		{
 			yyVAL.expr = &ast.ComparisonOperator{LHS: yyDollar[1].expr, Operator: ">", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
 	case 165:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:1047
+//line parser.go.y:1059
 		{
 			yyVAL.expr = &ast.ComparisonOperator{LHS: yyDollar[1].expr, Operator: ">=", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
 	case 166:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:1054
+//line parser.go.y:1066
 		{
 			yyVAL.expr = &ast.BinaryOperator{LHS: yyDollar[1].expr, Operator: "&&", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
 	case 167:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:1059
+//line parser.go.y:1071
 		{
 			yyVAL.expr = &ast.BinaryOperator{LHS: yyDollar[1].expr, Operator: "||", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
+	case 168:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.go.y:1078
+		{
+			yyVAL.op_pipe = &ast.PipeOperator{LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
+			yyVAL.op_pipe.SetPosition(yyDollar[1].expr.Position())
+		}
 	}
 	goto yystack /* stack new state and value */
 }
@@ -41,6 +41,7 @@ import (
 %type<expr> op_comparison
 %type<expr> op_add
 %type<expr> op_multiply
+%type<op_pipe> op_pipe
 
 %union{
 	tok                    ast.Token
@@ -79,9 +80,10 @@ import (
 	op_comparison          ast.Operator
 	op_add                 ast.Operator
 	op_multiply            ast.Operator
+	op_pipe                ast.Operator
 }
 
-%token<tok> IDENT NUMBER STRING ARRAY VARARG FUNC RETURN VAR THROW IF ELSE FOR IN EQEQ NEQ GE LE OROR ANDAND NEW TRUE FALSE NIL NILCOALESCE MODULE TRY CATCH FINALLY PLUSEQ MINUSEQ MULEQ DIVEQ ANDEQ OREQ BREAK CONTINUE PLUSPLUS MINUSMINUS SHIFTLEFT SHIFTRIGHT SWITCH CASE DEFAULT GO CHAN STRUCT MAKE OPCHAN EQOPCHAN TYPE LEN DELETE CLOSE MAP IMPORT
+%token<tok> IDENT NUMBER STRING ARRAY VARARG FUNC RETURN VAR THROW IF ELSE FOR IN EQEQ NEQ GE LE OROR ANDAND NEW TRUE FALSE NIL NILCOALESCE MODULE TRY CATCH FINALLY PLUSEQ MINUSEQ MULEQ DIVEQ ANDEQ OREQ BREAK CONTINUE PLUSPLUS MINUSMINUS SHIFTLEFT SHIFTRIGHT SWITCH CASE DEFAULT GO CHAN STRUCT MAKE OPCHAN EQOPCHAN TYPE LEN DELETE CLOSE MAP IMPORT PIPE
 
 /* lowest precedence */
 %left ,
@@ -89,6 +91,7 @@ import (
 %right ':'
 %right OPCHAN
 %right '?' NILCOALESCE
+%left PIPE
 %left OROR
 %left ANDAND
 %left EQEQ NEQ '<' LE '>' GE
@@ -97,6 +100,7 @@ import (
 %right IN
 %right PLUSPLUS MINUSMINUS
 %right UNARY
+%left '(' '[' '.'
 /* highest precedence */
 /* https://golang.org/ref/spec#Expression */
 
@@ -743,23 +747,18 @@ expr_ident :
 	}
 
 expr_literals :
-	'-' NUMBER
+	NUMBER
 	{
-		num, err := toNumber("-" + $2.Lit)
-		if err != nil {
-			yylex.Error("invalid number: -" + $2.Lit)
-		}
-		$$ = &ast.LiteralExpr{Literal: num}
-		$$.SetPosition($2.Position())
-	}
-	| NUMBER
-	{
-		num, err := toNumber($1.Lit)
-		if err != nil {
-			yylex.Error("invalid number: " + $1.Lit)
+		if l, ok := yylex.(*Lexer); ok {
+			$$ = l.numberExpr($1)
+		} else {
+			num, err := toNumber($1.Lit)
+			if err != nil {
+				yylex.Error("invalid number: " + $1.Lit)
+			}
+			$$ = &ast.LiteralExpr{Literal: num}
+			$$.SetPosition($1.Position())
 		}
-		$$ = &ast.LiteralExpr{Literal: num}
-		$$.SetPosition($1.Position())
 	}
 	| STRING
 	{
@@ -855,8 +854,16 @@ expr_chan :
 expr_unary :
 	'-' expr %prec UNARY
 	{
-		$$ = &ast.UnaryExpr{Operator: "-", Expr: $2}
-		$$.SetPosition($2.Position())
+		var expr ast.Expr
+		if l, ok := yylex.(*Lexer); ok {
+			// a minus in front of a number is a negative number literal
+			expr = l.negateNumber($2)
+		}
+		if expr == nil {
+			expr = &ast.UnaryExpr{Operator: "-", Expr: $2}
+			expr.SetPosition($2.Position())
+		}
+		$$ = expr
 	}
 	| '!' expr %prec UNARY
 	{
@@ -900,6 +907,11 @@ expr_binary :
 		$$ = &ast.OpExpr{Op: $1}
 		$$.SetPosition($1.Position())
 	}
+	| op_pipe
+	{
+		$$ = &ast.OpExpr{Op: $1}
+		$$.SetPosition($1.Position())
+	}
 
 expr_lets:
 	expr PLUSPLUS
@@ -1061,6 +1073,13 @@ op_binary :
 		$$.SetPosition($1.Position())
 	}
 
+op_pipe :
+	expr PIPE expr
+	{
+		$$ = &ast.PipeOperator{LHS: $1, RHS: $3}
+		$$.SetPosition($1.Position())
+	}
+
 
 opt_term :
 	/* nothing */
@@ -0,0 +1,236 @@
+//go:build !appengine
+// +build !appengine
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/mattn/anko/env"
+)
+
+// reflectValueType is the type returned by script functions.
+var reflectValueType = reflect.TypeOf(reflect.Value{})
+
+// replKeywords are completed in the REPL along with the symbols of the env.
+var replKeywords = []string{
+	"break", "case", "close", "continue", "default", "delete", "else", "for", "func", "go", "if", "import",
+	"in", "len", "make", "module", "new", "nil", "return", "switch", "throw", "try", "catch", "finally", "var",
+}
+
+// historyFile returns the path of the REPL history file, set with the ANKO_HISTORY environment variable.
+// Returns an empty string if there is no history file.
+func historyFile() string {
+	if file, ok := os.LookupEnv("ANKO_HISTORY"); ok {
+		return file
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".anko_history")
+}
+
+// completeWord completes the word before pos in line with env symbols, keywords, members of modules
+// like the ones returned by import and, inside import(", the names of the packages.
+func completeWord(e *env.Env, line string, pos int) (string, []string, string) {
+	if pos > len(line) {
+		pos = len(line)
+	}
+	start := pos
+	for start > 0 && isWordByte(line[start-1]) {
+		start--
+	}
+	head, word, tail := line[:start], line[start:pos], line[pos:]
+
+	var candidates []string
+	if strings.HasSuffix(head, `import("`) {
+		for name := range env.Packages {
+			candidates = append(candidates, name)
+		}
+	} else if dot := strings.LastIndexByte(word, '.'); dot >= 0 {
+		members := memberNames(e, strings.Split(word[:dot], "."))
+		head += word[:dot+1]
+		word = word[dot+1:]
+		candidates = members
+	} else {
+		candidates = append(e.GetValueSymbols(), e.GetTypeSymbols()...)
+		candidates = append(candidates, replKeywords...)
+	}
+
+	var completions []string
+	seen := make(map[string]struct{}, len(candidates))
+	for _, candidate := range candidates {
+		if _, ok := seen[candidate]; ok || !strings.HasPrefix(candidate, word) {
+			continue
+		}
+		seen[candidate] = struct{}{}
+		completions = append(completions, candidate)
+	}
+	sort.Strings(completions)
+	return head, completions, tail
+}
+
+// isWordByte returns true if the byte can be part of a word to complete.
+func isWordByte(b byte) bool {
+	return b == '_' || b == '.' || b >= '0' && b <= '9' || b >= 'a' && b <= 'z' || b >= 'A' && b <= 'Z' || b >= 0x80
+}
+
+// memberNames returns the names of the members of the value at the path of symbols,
+// which can be a module, an object or a map with string keys.
+func memberNames(e *env.Env, path []string) []string {
+	value, err := e.Get(path[0])
+	if err != nil {
+		return nil
+	}
+	for _, name := range path[1:] {
+		module, ok := value.(*env.Env)
+		if !ok {
+			return nil
+		}
+		value, err = module.Get(name)
+		if err != nil {
+			return nil
+		}
+	}
+
+	switch value := value.(type) {
+	case *env.Env:
+		return append(value.GetValueSymbols(), value.GetTypeSymbols()...)
+	case *env.Object:
+		return value.Names()
+	}
+
+	rv := reflect.ValueOf(value)
+	if rv.Kind() != reflect.Map {
+		return nil
+	}
+	var names []string
+	for _, key := range rv.MapKeys() {
+		if key.Kind() == reflect.Interface {
+			key = key.Elem()
+		}
+		if key.Kind() == reflect.String {
+			names = append(names, key.String())
+		}
+	}
+	return names
+}
+
+// formatValue formats a REPL result.
+// Strings are quoted, slices and maps are printed like anko literals with map keys sorted,
+// and other values are printed with fmt.
+func formatValue(value interface{}) string {
+	var builder strings.Builder
+	writeValue(&builder, reflect.ValueOf(value), 0)
+	return builder.String()
+}
+
+// writeValue writes the formatted value, depth limits printing values that contain themselves.
+func writeValue(builder *strings.Builder, rv reflect.Value, depth int) {
+	if depth > 10 {
+		builder.WriteString("...")
+		return
+	}
+	if !rv.IsValid() {
+		builder.WriteString("nil")
+		return
+	}
+	if rv.Kind() == reflect.Interface {
+		writeValue(builder, rv.Elem(), depth)
+		return
+	}
+	if rv.CanInterface() {
+		switch value := rv.Interface().(type) {
+		case *env.Env:
+			builder.WriteString("module")
+			return
+		case error:
+			if rv.Kind() != reflect.Ptr || !rv.IsNil() {
+				builder.WriteString("error: " + value.Error())
+				return
+			}
+		case fmt.Stringer:
+			if rv.Kind() != reflect.Ptr || !rv.IsNil() {
+				builder.WriteString(value.String())
+				return
+			}
+		}
+	}
+
+	switch rv.Kind() {
+	case reflect.String:
+		builder.WriteString(strconv.Quote(rv.String()))
+	case reflect.Slice, reflect.Array:
+		if rv.Kind() == reflect.Slice && rv.IsNil() {
+			builder.WriteString("nil")
+			return
+		}
+		builder.WriteString("[")
+		for i := 0; i < rv.Len(); i++ {
+			if i > 0 {
+				builder.WriteString(", ")
+			}
+			writeValue(builder, rv.Index(i), depth+1)
+		}
+		builder.WriteString("]")
+	case reflect.Map:
+		if rv.IsNil() {
+			builder.WriteString("nil")
+			return
+		}
+		keys := make([]string, 0, rv.Len())
+		items := make(map[string]string, rv.Len())
+		for _, key := range rv.MapKeys() {
+			var keyBuilder, itemBuilder strings.Builder
+			writeValue(&keyBuilder, key, depth+1)
+			writeValue(&itemBuilder, rv.MapIndex(key), depth+1)
+			keys = append(keys, keyBuilder.String())
+			items[keyBuilder.String()] = itemBuilder.String()
+		}
+		sort.Strings(keys)
+		builder.WriteString("{")
+		for i, key := range keys {
+			if i > 0 {
+				builder.WriteString(", ")
+			}
+			builder.WriteString(key + ": " + items[key])
+		}
+		builder.WriteString("}")
+	case reflect.Ptr:
+		if rv.IsNil() {
+			builder.WriteString("nil")
+			return
+		}
+		if rv.Elem().Kind() == reflect.Struct {
+			builder.WriteString("&")
+			writeValue(builder, rv.Elem(), depth+1)
+			return
+		}
+		fmt.Fprintf(builder, "%v", rv)
+	case reflect.Struct:
+		if !rv.CanInterface() {
+			fmt.Fprintf(builder, "%v", rv)
+			return
+		}
+		fmt.Fprintf(builder, "%+v", rv.Interface())
+	case reflect.Func:
+		if rv.IsNil() {
+			builder.WriteString("nil")
+			return
+		}
+		if isScriptFunc(rv.Type()) {
+			builder.WriteString("func")
+			return
+		}
+		builder.WriteString(rv.Type().String())
+	default:
+		fmt.Fprintf(builder, "%v", rv)
+	}
+}
@@ -0,0 +1,468 @@
+//go:build !appengine
+// +build !appengine
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/mattn/anko/ast"
+	"github.com/mattn/anko/env"
+	"github.com/mattn/anko/parser"
+	"github.com/mattn/anko/vm"
+)
+
+// replCommand is a REPL meta-command, started with a colon.
+type replCommand struct {
+	usage string
+	help  string
+	run   func(w io.Writer, arg string) error
+}
+
+// replCommands are the REPL meta-commands by name.
+var replCommands map[string]replCommand
+
+func init() {
+	replCommands = map[string]replCommand{
+		":help":  {usage: ":help", help: "list the commands", run: commandHelp},
+		":env":   {usage: ":env", help: "list the values and types defined in the REPL", run: commandEnv},
+		":type":  {usage: ":type expr", help: "print the type of the value of expr", run: commandType},
+		":doc":   {usage: ":doc name", help: "print the signature of a value, type or package member like strings.Split", run: commandDoc},
+		":load":  {usage: ":load file", help: "run a script file in the REPL env", run: commandLoad},
+		":reset": {usage: ":reset", help: "discard all values and types defined in the REPL", run: commandReset},
+		":time":  {usage: ":time expr", help: "run expr and print how long it took", run: commandTime},
+		":ast":   {usage: ":ast expr", help: "print the parsed tree of expr", run: commandAST},
+	}
+}
+
+// isCommand returns true if the line is a REPL meta-command.
+func isCommand(line string) bool {
+	return strings.HasPrefix(strings.TrimSpace(line), ":")
+}
+
+// runCommand runs the REPL meta-command in line, writing its output to w.
+func runCommand(w io.Writer, line string) error {
+	line = strings.TrimSpace(line)
+	name, arg := line, ""
+	if i := strings.IndexAny(line, " \t"); i >= 0 {
+		name, arg = line[:i], strings.TrimSpace(line[i+1:])
+	}
+	command, ok := replCommands[name]
+	if !ok {
+		return fmt.Errorf("unknown command %v, :help lists the commands", name)
+	}
+	if arg == "" && strings.Contains(command.usage, " ") {
+		return fmt.Errorf("usage: %v", command.usage)
+	}
+	return command.run(w, arg)
+}
+
+func commandHelp(w io.Writer, arg string) error {
+	names := make([]string, 0, len(replCommands))
+	for name := range replCommands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	for _, name := range names {
+		fmt.Fprintf(tw, "%v\t%v\n", replCommands[name].usage, replCommands[name].help)
+	}
+	return tw.Flush()
+}
+
+// commandEnv writes a table of the values and types defined in the REPL env, sorted by name.
+func commandEnv(w io.Writer, arg string) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "NAME\tTYPE\tVALUE")
+
+	symbols := e.GetValueSymbols()
+	sort.Strings(symbols)
+	for _, symbol := range symbols {
+		value, err := e.Get(symbol)
+		if err != nil {
+			continue
+		}
+		fmt.Fprintf(tw, "%v\t%v\t%v\n", symbol, typeName(value), truncate(formatValue(value), 40))
+	}
+
+	symbols = e.GetTypeSymbols()
+	sort.Strings(symbols)
+	for _, symbol := range symbols {
+		aType, err := e.Type(symbol)
+		if err != nil {
+			continue
+		}
+		fmt.Fprintf(tw, "%v\ttype\t%v\n", symbol, aType)
+	}
+	return tw.Flush()
+}
+
+// commandType runs expr in a child env of the REPL env, so new symbols are not kept, and writes the type of the result.
+func commandType(w io.Writer, arg string) error {
+	value, err := vm.Execute(e.NewEnv(), nil, arg)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(w, typeName(value))
+	return nil
+}
+
+// commandDoc writes the signature of the value or type at the dotted path,
+// looked up in the REPL env first and then in the packages that can be imported.
+// Package members and methods like time.Duration.String are preceded by their documentation.
+func commandDoc(w io.Writer, arg string) error {
+	name := arg[strings.LastIndexByte(arg, '.')+1:]
+	value, aType, doc, err := lookupDoc(arg)
+	if err != nil {
+		if writeMethodDoc(w, arg) {
+			return nil
+		}
+		return err
+	}
+	writeDocComment(w, doc)
+	if aType != nil {
+		writeTypeDoc(w, name, aType)
+		return nil
+	}
+	writeValueDoc(w, name, value)
+	return nil
+}
+
+// lookupDoc returns the value or the type at the dotted path, with its documentation for package members.
+func lookupDoc(path string) (reflect.Value, reflect.Type, string, error) {
+	parts := strings.Split(path, ".")
+	module := e
+	for i, part := range parts {
+		if i == len(parts)-1 {
+			if value, err := module.Get(part); err == nil {
+				return reflect.ValueOf(value), nil, "", nil
+			}
+			if aType, err := module.Type(part); err == nil {
+				return reflect.Value{}, aType, "", nil
+			}
+			break
+		}
+		value, err := module.Get(part)
+		if err != nil {
+			break
+		}
+		var ok bool
+		if module, ok = value.(*env.Env); !ok {
+			break
+		}
+	}
+
+	dot := strings.LastIndexByte(path, '.')
+	if dot > 0 {
+		pkg, name := path[:dot], path[dot+1:]
+		if value, ok := env.Packages[pkg][name]; ok {
+			return value, nil, env.PackageDocs[pkg][name], nil
+		}
+		if aType, ok := env.PackageTypes[pkg][name]; ok {
+			return reflect.Value{}, aType, env.PackageDocs[pkg][name], nil
+		}
+	}
+	return reflect.Value{}, nil, "", fmt.Errorf("undefined symbol '%v'", path)
+}
+
+// writeValueDoc writes a function signature, the members of a module or the type and value of a variable.
+func writeValueDoc(w io.Writer, name string, value reflect.Value) {
+	for value.Kind() == reflect.Interface && !value.IsNil() {
+		value = value.Elem()
+	}
+	if !value.IsValid() {
+		fmt.Fprintf(w, "var %v = nil\n", name)
+		return
+	}
+
+	if module, ok := value.Interface().(*env.Env); ok {
+		fmt.Fprintf(w, "module %v\n", name)
+		symbols := module.GetValueSymbols()
+		sort.Strings(symbols)
+		for _, symbol := range symbols {
+			member, _ := module.Get(symbol)
+			fmt.Fprintf(w, "    %v %v\n", symbol, typeName(member))
+		}
+		symbols = module.GetTypeSymbols()
+		sort.Strings(symbols)
+		for _, symbol := range symbols {
+			aType, _ := module.Type(symbol)
+			fmt.Fprintf(w, "    type %v %v\n", symbol, aType)
+		}
+		return
+	}
+
+	if value.Kind() == reflect.Func {
+		if isScriptFunc(value.Type()) {
+			fmt.Fprintf(w, "func %v (script function)\n", name)
+			return
+		}
+		fmt.Fprintf(w, "func %v%v\n", name, funcSignature(value.Type(), 0))
+		return
+	}
+
+	fmt.Fprintf(w, "var %v %v = %v\n", name, value.Type(), formatValue(value.Interface()))
+}
+
+// writeTypeDoc writes the kind of the type, the fields of a struct and the exported methods.
+func writeTypeDoc(w io.Writer, name string, aType reflect.Type) {
+	fmt.Fprintf(w, "type %v %v\n", name, aType.Kind())
+	if aType.Kind() == reflect.Struct {
+		for i := 0; i < aType.NumField(); i++ {
+			field := aType.Field(i)
+			if field.PkgPath != "" {
+				continue
+			}
+			fmt.Fprintf(w, "    %v %v\n", field.Name, field.Type)
+		}
+	}
+
+	methodType, skip := methodSet(aType)
+	for i := 0; i < methodType.NumMethod(); i++ {
+		method := methodType.Method(i)
+		fmt.Fprintf(w, "    func (%v) %v%v\n", methodReceiver(aType, method.Name), method.Name, funcSignature(method.Type, skip))
+	}
+}
+
+// methodSet returns the type with all the methods of the type, and the number of receiver arguments of its methods.
+func methodSet(aType reflect.Type) (reflect.Type, int) {
+	// methods of interface types do not have a receiver argument
+	if aType.Kind() == reflect.Interface {
+		return aType, 0
+	}
+	if aType.Kind() != reflect.Ptr {
+		return reflect.PtrTo(aType), 1
+	}
+	return aType, 1
+}
+
+// methodReceiver returns the receiver type of the method of the type,
+// the type itself if the method is in its method set, otherwise the pointer to the type.
+func methodReceiver(aType reflect.Type, name string) reflect.Type {
+	if _, ok := aType.MethodByName(name); ok || aType.Kind() == reflect.Interface || aType.Kind() == reflect.Ptr {
+		return aType
+	}
+	return reflect.PtrTo(aType)
+}
+
+// writeMethodDoc writes the documentation and the signature of the method of a package type at the dotted path,
+// like time.Duration.String. Returns false if there is no such method.
+func writeMethodDoc(w io.Writer, path string) bool {
+	methodDot := strings.LastIndexByte(path, '.')
+	if methodDot < 0 {
+		return false
+	}
+	typeDot := strings.LastIndexByte(path[:methodDot], '.')
+	if typeDot <= 0 {
+		return false
+	}
+	pkg, typeName, methodName := path[:typeDot], path[typeDot+1:methodDot], path[methodDot+1:]
+	aType, ok := env.PackageTypes[pkg][typeName]
+	if !ok {
+		return false
+	}
+	methodType, skip := methodSet(aType)
+	method, ok := methodType.MethodByName(methodName)
+	if !ok {
+		return false
+	}
+	writeDocComment(w, env.PackageDocs[pkg][typeName+"."+methodName])
+	fmt.Fprintf(w, "func (%v) %v%v\n", methodReceiver(aType, method.Name), method.Name, funcSignature(method.Type, skip))
+	return true
+}
+
+// writeDocComment writes the documentation as a comment, nothing if it is empty.
+func writeDocComment(w io.Writer, doc string) {
+	if doc != "" {
+		fmt.Fprintf(w, "// %v\n", doc)
+	}
+}
+
+// funcSignature returns the arguments and results of a function type, skipping the first skip arguments.
+func funcSignature(fType reflect.Type, skip int) string {
+	var builder strings.Builder
+	builder.WriteString("(")
+	for i := skip; i < fType.NumIn(); i++ {
+		if i > skip {
+			builder.WriteString(", ")
+		}
+		if fType.IsVariadic() && i == fType.NumIn()-1 {
+			builder.WriteString("..." + fType.In(i).Elem().String())
+			continue
+		}
+		builder.WriteString(fType.In(i).String())
+	}
+	builder.WriteString(")")
+
+	switch fType.NumOut() {
+	case 0:
+	case 1:
+		builder.WriteString(" " + fType.Out(0).String())
+	default:
+		builder.WriteString(" (")
+		for i := 0; i < fType.NumOut(); i++ {
+			if i > 0 {
+				builder.WriteString(", ")
+			}
+			builder.WriteString(fType.Out(i).String())
+		}
+		builder.WriteString(")")
+	}
+	return builder.String()
+}
+
+// commandLoad runs the script file in the REPL env and writes the result.
+func commandLoad(w io.Writer, arg string) error {
+	source, err := e.ReadFile(arg)
+	if err != nil {
+		return err
+	}
+	value, err := vm.Execute(e, nil, string(source))
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(w, formatValue(value))
+	return nil
+}
+
+func commandReset(w io.Writer, arg string) error {
+	setupEnv()
+	return nil
+}
+
+// commandTime runs expr in the REPL env and writes the result followed by the time it took.
+func commandTime(w io.Writer, arg string) error {
+	stmt, err := parser.ParseSrc(arg)
+	if err != nil {
+		return err
+	}
+	start := time.Now()
+	value, err := vm.Run(e, nil, stmt)
+	elapsed := time.Since(start)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(w, formatValue(value))
+	fmt.Fprintln(w, "time:", elapsed)
+	return nil
+}
+
+// commandAST writes the tree parsed from expr without running it.
+func commandAST(w io.Writer, arg string) error {
+	stmt, err := parser.ParseSrc(arg)
+	if err != nil {
+		return err
+	}
+	writeNode(w, reflect.ValueOf(stmt), "")
+	return nil
+}
+
+// writeNode writes the type and, when known, the position of an ast node, followed by its exported fields indented below it.
+// Fields that are nil or empty are left out.
+func writeNode(w io.Writer, node reflect.Value, indent string) {
+	for node.Kind() == reflect.Interface {
+		node = node.Elem()
+	}
+	if pos, ok := node.Interface().(ast.Pos); ok && pos.Position().Line > 0 {
+		position := pos.Position()
+		fmt.Fprintf(w, "%v %v:%v\n", node.Type(), position.Line, position.Column)
+	} else {
+		fmt.Fprintln(w, node.Type())
+	}
+
+	if node.Kind() == reflect.Ptr {
+		node = node.Elem()
+	}
+	if node.Kind() != reflect.Struct {
+		return
+	}
+	indent += "  "
+	for i := 0; i < node.NumField(); i++ {
+		field := node.Type().Field(i)
+		if field.PkgPath != "" || field.Anonymous {
+			continue
+		}
+		writeNodeField(w, field.Name, node.Field(i), indent)
+	}
+}
+
+// writeNodeField writes a field of an ast node, nodes and lists of nodes are written as nested trees.
+func writeNodeField(w io.Writer, name string, value reflect.Value, indent string) {
+	switch {
+	case value.Type() == reflectValueType:
+		literal := value.Interface().(reflect.Value)
+		if !literal.IsValid() || !literal.CanInterface() {
+			return
+		}
+		fmt.Fprintf(w, "%v%v: %v\n", indent, name, formatValue(literal.Interface()))
+	case value.Kind() == reflect.Interface || value.Kind() == reflect.Ptr:
+		if value.IsNil() {
+			return
+		}
+		fmt.Fprintf(w, "%v%v: ", indent, name)
+		writeNode(w, value, indent)
+	case value.Kind() == reflect.Slice:
+		if value.Len() == 0 {
+			return
+		}
+		elemKind := value.Type().Elem().Kind()
+		if elemKind != reflect.Interface && elemKind != reflect.Ptr {
+			fmt.Fprintf(w, "%v%v: %v\n", indent, name, formatValue(value.Interface()))
+			return
+		}
+		fmt.Fprintf(w, "%v%v:\n", indent, name)
+		for i := 0; i < value.Len(); i++ {
+			if value.Index(i).IsNil() {
+				fmt.Fprintf(w, "%v  - nil\n", indent)
+				continue
+			}
+			fmt.Fprintf(w, "%v  - ", indent)
+			writeNode(w, value.Index(i), indent+"  ")
+		}
+	case value.Kind() == reflect.Bool:
+		if value.Bool() {
+			fmt.Fprintf(w, "%v%v: true\n", indent, name)
+		}
+	case value.Kind() == reflect.String:
+		if value.String() != "" {
+			fmt.Fprintf(w, "%v%v: %v\n", indent, name, formatValue(value.Interface()))
+		}
+	default:
+		fmt.Fprintf(w, "%v%v: %v\n", indent, name, formatValue(value.Interface()))
+	}
+}
+
+// typeName returns the type of a REPL value as printed by :type and :env.
+func typeName(value interface{}) string {
+	switch value.(type) {
+	case nil:
+		return "nil"
+	case *env.Env:
+		return "module"
+	}
+	aType := reflect.TypeOf(value)
+	if isScriptFunc(aType) {
+		return "func"
+	}
+	return aType.String()
+}
+
+// isScriptFunc returns true if the type is the type of functions defined in scripts.
+func isScriptFunc(aType reflect.Type) bool {
+	return aType.Kind() == reflect.Func && aType.NumOut() == 2 && aType.Out(0) == reflectValueType && aType.Out(1) == reflectValueType
+}
+
+// truncate shortens s to at most n runes, ending it with ... when cut.
+func truncate(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n-3]) + "..."
+}
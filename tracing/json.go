@@ -0,0 +1,129 @@
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/mattn/anko/ast"
+	"github.com/mattn/anko/vm"
+)
+
+// JSON is a tracer that writes each event as a line of JSON:
+//
+//	{"time":"2024-01-02T15:04:05.000000001Z","event":"call","id":1,"name":"add","line":1,"column":1}
+//	{"time":"2024-01-02T15:04:05.000000002Z","event":"host_call","parent":1,"name":"strings.ToUpper","line":2,"column":3,"duration_ms":0.002}
+//	{"time":"2024-01-02T15:04:05.000000003Z","event":"return","id":1,"name":"add","line":1,"column":1,"duration_ms":0.01}
+//
+// The events are call and return for script functions, host_call for Go functions, throw, catch and error.
+// Each call of a script function has an id, the events that happen in the function have it as parent.
+type JSON struct {
+	mutex   sync.Mutex
+	encoder *json.Encoder
+	lastID  uint64
+	now     func() time.Time
+}
+
+// jsonEvent is a line written by JSON.
+type jsonEvent struct {
+	Time       string  `json:"time"`
+	Event      string  `json:"event"`
+	ID         uint64  `json:"id,omitempty"`
+	Parent     uint64  `json:"parent,omitempty"`
+	Name       string  `json:"name,omitempty"`
+	File       string  `json:"file,omitempty"`
+	Line       int     `json:"line,omitempty"`
+	Column     int     `json:"column,omitempty"`
+	DurationMS float64 `json:"duration_ms,omitempty"`
+	Value      string  `json:"value,omitempty"`
+	Error      string  `json:"error,omitempty"`
+}
+
+// jsonCallKey is the context key of the id of the script function that is running.
+type jsonCallKey struct{}
+
+// NewJSON creates a JSON tracer that writes to w.
+func NewJSON(w io.Writer) *JSON {
+	return &JSON{encoder: json.NewEncoder(w), now: time.Now}
+}
+
+// callID returns the id of the script function running with ctx, 0 outside of functions.
+func callID(ctx context.Context) uint64 {
+	id, _ := ctx.Value(jsonCallKey{}).(uint64)
+	return id
+}
+
+// write writes the event with the time. Errors writing are ignored, like a logger does.
+func (tracer *JSON) write(event jsonEvent) {
+	event.Time = tracer.now().UTC().Format(time.RFC3339Nano)
+	tracer.mutex.Lock()
+	tracer.encoder.Encode(event)
+	tracer.mutex.Unlock()
+}
+
+// callEvent returns the event of a call.
+func callEvent(name string, call *vm.TraceCall) jsonEvent {
+	event := jsonEvent{Event: name, Name: call.Name, File: call.File, Line: call.Pos.Line, Column: call.Pos.Column}
+	if call.Duration > 0 {
+		event.DurationMS = float64(call.Duration) / float64(time.Millisecond)
+	}
+	if call.Err != nil {
+		event.Error = call.Err.Error()
+	}
+	return event
+}
+
+// FuncStart writes a call event with a new id and returns ctx with the id.
+func (tracer *JSON) FuncStart(ctx context.Context, call *vm.TraceCall) context.Context {
+	id := atomic.AddUint64(&tracer.lastID, 1)
+	event := callEvent("call", call)
+	event.ID = id
+	event.Parent = callID(ctx)
+	tracer.write(event)
+	return context.WithValue(ctx, jsonCallKey{}, id)
+}
+
+// FuncEnd writes a return event.
+func (tracer *JSON) FuncEnd(ctx context.Context, call *vm.TraceCall) {
+	event := callEvent("return", call)
+	event.ID = callID(ctx)
+	tracer.write(event)
+}
+
+// HostCall writes a host_call event.
+func (tracer *JSON) HostCall(ctx context.Context, call *vm.TraceCall) {
+	event := callEvent("host_call", call)
+	event.Parent = callID(ctx)
+	tracer.write(event)
+}
+
+// Throw writes a throw event.
+func (tracer *JSON) Throw(ctx context.Context, pos ast.Position, value interface{}) {
+	tracer.write(jsonEvent{Event: "throw", Parent: callID(ctx), Line: pos.Line, Column: pos.Column, Value: fmt.Sprint(value)})
+}
+
+// Catch writes a catch event.
+func (tracer *JSON) Catch(ctx context.Context, pos ast.Position, err error) {
+	tracer.write(jsonEvent{Event: "catch", Parent: callID(ctx), Line: pos.Line, Column: pos.Column, Error: errorMessage(err)})
+}
+
+// Error writes an error event, with the position of errors from the vm.
+func (tracer *JSON) Error(ctx context.Context, err error) {
+	event := jsonEvent{Event: "error", Parent: callID(ctx), Error: errorMessage(err)}
+	if vmError, ok := err.(*vm.Error); ok {
+		event.Line, event.Column = vmError.Pos.Line, vmError.Pos.Column
+	}
+	tracer.write(event)
+}
+
+// errorMessage returns the message of errors from the vm without the position, the Error of other errors.
+func errorMessage(err error) string {
+	if vmError, ok := err.(*vm.Error); ok {
+		return vmError.Message
+	}
+	return err.Error()
+}
@@ -0,0 +1,104 @@
+// Package oteltracing makes OpenTelemetry spans of what Anko scripts do.
+// It is its own module so the anko module does not depend on OpenTelemetry.
+//
+//	tracer := oteltracing.New(otel.Tracer("scripts"))
+//	vm.ExecuteContext(ctx, e, &vm.Options{Tracer: tracer}, script)
+//
+// Calls of script functions and of Go functions are spans, children of the span of ctx and of the
+// script function they are called from. Throws and catches are events of the span of the function
+// they happen in, and the error a run ends with is recorded in the span of ctx.
+package oteltracing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mattn/anko/ast"
+	"github.com/mattn/anko/vm"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Tracer is a vm.Tracer that makes spans with an OpenTelemetry tracer.
+type Tracer struct {
+	tracer trace.Tracer
+}
+
+// New creates a Tracer that makes spans with tracer.
+func New(tracer trace.Tracer) *Tracer {
+	return &Tracer{tracer: tracer}
+}
+
+// callAttributes returns the attributes of the span of a call.
+func callAttributes(call *vm.TraceCall) []attribute.KeyValue {
+	attributes := []attribute.KeyValue{
+		attribute.String("code.function", call.Name),
+		attribute.Int("code.lineno", call.Pos.Line),
+		attribute.Int("code.column", call.Pos.Column),
+		attribute.Bool("anko.host", call.Host),
+	}
+	if call.File != "" {
+		attributes = append(attributes, attribute.String("code.filepath", call.File))
+	}
+	return attributes
+}
+
+// endSpan ends the span of the call, with an error status if the call failed.
+func endSpan(span trace.Span, call *vm.TraceCall) {
+	if call.Err != nil {
+		span.RecordError(call.Err)
+		span.SetStatus(codes.Error, errorMessage(call.Err))
+	}
+	span.End(trace.WithTimestamp(call.Start.Add(call.Duration)))
+}
+
+// FuncStart starts the span of the script function and returns ctx with it.
+func (tracer *Tracer) FuncStart(ctx context.Context, call *vm.TraceCall) context.Context {
+	ctx, _ = tracer.tracer.Start(ctx, call.Name, trace.WithTimestamp(call.Start), trace.WithAttributes(callAttributes(call)...))
+	return ctx
+}
+
+// FuncEnd ends the span of the script function.
+func (tracer *Tracer) FuncEnd(ctx context.Context, call *vm.TraceCall) {
+	endSpan(trace.SpanFromContext(ctx), call)
+}
+
+// HostCall adds the span of the Go function, from when it was called to when it returned.
+func (tracer *Tracer) HostCall(ctx context.Context, call *vm.TraceCall) {
+	_, span := tracer.tracer.Start(ctx, call.Name, trace.WithTimestamp(call.Start), trace.WithAttributes(callAttributes(call)...))
+	endSpan(span, call)
+}
+
+// Throw adds a throw event with the value to the span of ctx.
+func (tracer *Tracer) Throw(ctx context.Context, pos ast.Position, value interface{}) {
+	trace.SpanFromContext(ctx).AddEvent("throw", trace.WithAttributes(
+		attribute.String("anko.value", fmt.Sprint(value)),
+		attribute.Int("code.lineno", pos.Line),
+		attribute.Int("code.column", pos.Column),
+	))
+}
+
+// Catch adds a catch event with the error to the span of ctx.
+func (tracer *Tracer) Catch(ctx context.Context, pos ast.Position, err error) {
+	trace.SpanFromContext(ctx).AddEvent("catch", trace.WithAttributes(
+		attribute.String("exception.message", errorMessage(err)),
+		attribute.Int("code.lineno", pos.Line),
+		attribute.Int("code.column", pos.Column),
+	))
+}
+
+// Error records the error in the span of ctx and sets its status to error.
+func (tracer *Tracer) Error(ctx context.Context, err error) {
+	span := trace.SpanFromContext(ctx)
+	span.RecordError(err)
+	span.SetStatus(codes.Error, errorMessage(err))
+}
+
+// errorMessage returns the message of errors from the vm without the position, the Error of other errors.
+func errorMessage(err error) string {
+	if vmError, ok := err.(*vm.Error); ok {
+		return vmError.Message
+	}
+	return err.Error()
+}
@@ -0,0 +1,103 @@
+package oteltracing
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/mattn/anko/env"
+	"github.com/mattn/anko/vm"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTracer(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	defer provider.Shutdown(context.Background())
+
+	script := `func f(s) {
+  try {
+    throw "boom"
+  } catch {
+  }
+  return g(upper(s) + apply(func(v) { return v }, "b"))
+}
+func g(s) {
+  throw s
+}
+f("a")
+`
+	e := env.NewEnv()
+	err := e.Define("upper", strings.ToUpper)
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+	err = e.Define("apply", func(f func(string) string, s string) string { return f(s) })
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+
+	ctx, run := provider.Tracer("test").Start(context.Background(), "run")
+	_, err = vm.ExecuteContext(ctx, e, &vm.Options{Tracer: New(provider.Tracer("anko"))}, script)
+	run.End()
+	if err == nil {
+		t.Fatal("ExecuteContext error - received: nil - expected: Ab")
+	}
+
+	spans := make(map[string]tracetest.SpanStub)
+	for _, span := range exporter.GetSpans() {
+		spans[span.Name] = span
+	}
+	if len(spans) != 6 {
+		t.Fatalf("spans - received: %v - expected: run, f, upper, apply, func@6 and g", spans)
+	}
+
+	tests := []struct {
+		name   string
+		parent string
+		host   bool
+		status codes.Code
+		events []string
+	}{
+		{name: "f", parent: "run", status: codes.Error, events: []string{"throw", "catch", "exception"}},
+		{name: "upper", parent: "f", host: true, status: codes.Unset},
+		{name: "apply", parent: "f", host: true, status: codes.Unset},
+		{name: "func@6", parent: "f", status: codes.Unset},
+		{name: "g", parent: "f", status: codes.Error, events: []string{"throw", "exception"}},
+		{name: "run", status: codes.Error, events: []string{"exception"}},
+	}
+	for _, test := range tests {
+		span := spans[test.name]
+		if test.parent != "" && span.Parent.SpanID() != spans[test.parent].SpanContext.SpanID() {
+			t.Errorf("span %v parent - received: %v - expected: %v", test.name, span.Parent.SpanID(), test.parent)
+		}
+		if span.Status.Code != test.status {
+			t.Errorf("span %v status - received: %v - expected: %v", test.name, span.Status.Code, test.status)
+		}
+		var events []string
+		for _, event := range span.Events {
+			events = append(events, event.Name)
+		}
+		if strings.Join(events, " ") != strings.Join(test.events, " ") {
+			t.Errorf("span %v events - received: %v - expected: %v", test.name, events, test.events)
+		}
+		if test.name == "run" {
+			continue
+		}
+		if !span.EndTime.After(span.StartTime) {
+			t.Errorf("span %v - received: start %v end %v - expected end after start", test.name, span.StartTime, span.EndTime)
+		}
+		host := false
+		for _, keyValue := range span.Attributes {
+			if keyValue.Key == attribute.Key("anko.host") {
+				host = keyValue.Value.AsBool()
+			}
+		}
+		if host != test.host {
+			t.Errorf("span %v anko.host - received: %v - expected: %v", test.name, host, test.host)
+		}
+	}
+}
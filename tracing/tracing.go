@@ -0,0 +1,65 @@
+// Package tracing has tracers to see what scripts do in production,
+// set one in the Tracer of the vm.Options of the runs to trace.
+//
+// NewJSON writes the events as lines of JSON, for structured logs.
+// The oteltracing module makes OpenTelemetry spans of the calls.
+package tracing
+
+import (
+	"context"
+
+	"github.com/mattn/anko/ast"
+	"github.com/mattn/anko/vm"
+)
+
+// multi calls each of its tracers.
+type multi []vm.Tracer
+
+// Multi returns a tracer that calls each of the tracers in order,
+// script functions run with the contexts returned by all of them.
+func Multi(tracers ...vm.Tracer) vm.Tracer {
+	return multi(tracers)
+}
+
+// FuncStart calls FuncStart of each tracer with the context returned by the one before.
+func (tracers multi) FuncStart(ctx context.Context, call *vm.TraceCall) context.Context {
+	for _, tracer := range tracers {
+		ctx = tracer.FuncStart(ctx, call)
+	}
+	return ctx
+}
+
+// FuncEnd calls FuncEnd of each tracer.
+func (tracers multi) FuncEnd(ctx context.Context, call *vm.TraceCall) {
+	for _, tracer := range tracers {
+		tracer.FuncEnd(ctx, call)
+	}
+}
+
+// HostCall calls HostCall of each tracer.
+func (tracers multi) HostCall(ctx context.Context, call *vm.TraceCall) {
+	for _, tracer := range tracers {
+		tracer.HostCall(ctx, call)
+	}
+}
+
+// Throw calls Throw of each tracer.
+func (tracers multi) Throw(ctx context.Context, pos ast.Position, value interface{}) {
+	for _, tracer := range tracers {
+		tracer.Throw(ctx, pos, value)
+	}
+}
+
+// Catch calls Catch of each tracer.
+func (tracers multi) Catch(ctx context.Context, pos ast.Position, err error) {
+	for _, tracer := range tracers {
+		tracer.Catch(ctx, pos, err)
+	}
+}
+
+// Error calls Error of each tracer.
+func (tracers multi) Error(ctx context.Context, err error) {
+	for _, tracer := range tracers {
+		tracer.Error(ctx, err)
+	}
+}
@@ -0,0 +1,68 @@
+package tracing
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mattn/anko/env"
+	"github.com/mattn/anko/vm"
+)
+
+func TestJSON(t *testing.T) {
+	script := `func f(s) {
+  try {
+    throw "boom"
+  } catch {
+  }
+  return upper(s)
+}
+f("a")
+throw "end"
+`
+	e := env.NewEnv()
+	err := e.Define("upper", strings.ToUpper)
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+
+	var buffer bytes.Buffer
+	tracer := NewJSON(&buffer)
+	tracer.now = func() time.Time { return time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC) }
+	_, err = vm.Execute(e, &vm.Options{Tracer: Multi(tracer)}, script)
+	if err == nil {
+		t.Fatal("Execute error - received: nil - expected: end")
+	}
+
+	expected := []jsonEvent{
+		{Event: "call", ID: 1, Name: "f", Line: 1, Column: 1},
+		{Event: "throw", Parent: 1, Line: 3, Column: 5, Value: "boom"},
+		{Event: "catch", Parent: 1, Line: 2, Column: 3, Error: "boom"},
+		{Event: "host_call", Parent: 1, Name: "upper", Line: 6, Column: 10},
+		{Event: "return", ID: 1, Name: "f", Line: 1, Column: 1},
+		{Event: "throw", Line: 9, Column: 1, Value: "end"},
+		{Event: "error", Line: 9, Column: 1, Error: "end"},
+	}
+	lines := strings.Split(strings.TrimSpace(buffer.String()), "\n")
+	if len(lines) != len(expected) {
+		t.Fatalf("lines - received: %v - expected: %v lines", buffer.String(), len(expected))
+	}
+	for i, line := range lines {
+		var event jsonEvent
+		err = json.Unmarshal([]byte(line), &event)
+		if err != nil {
+			t.Fatalf("Unmarshal error: %v - line: %v", err, line)
+		}
+		if event.Time != "2024-01-02T15:04:05Z" {
+			t.Errorf("time - received: %v - expected: %v", event.Time, "2024-01-02T15:04:05Z")
+		}
+		event.Time = ""
+		event.DurationMS = 0
+		if !reflect.DeepEqual(event, expected[i]) {
+			t.Errorf("event - received: %+v - expected: %+v", event, expected[i])
+		}
+	}
+}
@@ -182,3 +182,33 @@ println(a)
 	// 3
 
 }
+
+func ExampleBindFunc() {
+	e := env.NewEnv()
+
+	script := `
+func onEvent(name, count) {
+	return name + " " + count
+}
+`
+
+	_, err := vm.Execute(e, nil, script)
+	if err != nil {
+		log.Fatalf("execute error: %v\n", err)
+	}
+
+	var onEvent func(string, int) (string, error)
+	err = vm.BindFunc(e, "onEvent", &onEvent)
+	if err != nil {
+		log.Fatalf("bind error: %v\n", err)
+	}
+
+	value, err := onEvent("click", 2)
+	if err != nil {
+		log.Fatalf("call error: %v\n", err)
+	}
+	fmt.Println(value)
+
+	// output:
+	// click 2
+}
@@ -2,6 +2,7 @@ package vm
 
 import (
 	"fmt"
+	"os"
 	"reflect"
 	"testing"
 
@@ -37,6 +38,27 @@ func TestImport(t *testing.T) {
 	env.Packages = envPackages
 }
 
+func TestPackagesBase64(t *testing.T) {
+	t.Parallel()
+
+	tests := []Test{
+		{Script: `base64 = import("encoding/base64"); base64.StdEncoding.EncodeToString(a)`, Input: map[string]interface{}{"a": []byte("hello")}, RunOutput: "aGVsbG8=", Output: map[string]interface{}{"a": []byte("hello")}},
+		{Script: `base64 = import("encoding/base64"); a, err = base64.URLEncoding.DecodeString("aGVsbG8="); if err != nil { return err }; a`, RunOutput: []byte("hello")},
+		{Script: `base64 = import("encoding/base64"); a, err = base64.StdEncoding.DecodeString("!"); err != nil`, RunOutput: true},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
+func TestPackagesBufio(t *testing.T) {
+	t.Parallel()
+
+	tests := []Test{
+		{Script: `bufio = import("bufio"); strings = import("strings"); s = bufio.NewScanner(strings.NewReader("a\nb\n")); a = []; for s.Scan() { a += s.Text() }; a`, RunOutput: []interface{}{"a", "b"}},
+		{Script: `bufio = import("bufio"); bytes = import("bytes"); a = make(bytes.Buffer); w = bufio.NewWriter(&a); w.WriteString("b"); b = a.Len(); w.Flush(); [b, a.String()]`, RunOutput: []interface{}{0, "b"}},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
 func TestPackagesBytes(t *testing.T) {
 	t.Parallel()
 
@@ -47,6 +69,88 @@ func TestPackagesBytes(t *testing.T) {
 	runTests(t, tests, nil, &Options{Debug: true})
 }
 
+func TestPackagesContext(t *testing.T) {
+	t.Parallel()
+
+	tests := []Test{
+		{Script: `context = import("context"); ctx, cancel = context.WithCancel(context.Background()); a = ctx.Err(); cancel(); [a, ctx.Err() == context.Canceled]`, RunOutput: []interface{}{nil, true}},
+		{Script: `context = import("context"); ctx = context.WithValue(context.Background(), "a", "b"); ctx.Value("a")`, RunOutput: "b"},
+		{Script: `context = import("context"); time = import("time"); ctx, cancel = context.WithTimeout(context.Background(), time.Millisecond); <-ctx.Done(); cancel(); ctx.Err() == context.DeadlineExceeded`, RunOutput: true},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
+func TestPackagesCrc32(t *testing.T) {
+	t.Parallel()
+
+	tests := []Test{
+		{Script: `crc32 = import("hash/crc32"); crc32.ChecksumIEEE(a)`, Input: map[string]interface{}{"a": []byte("hello")}, RunOutput: uint32(907060870), Output: map[string]interface{}{"a": []byte("hello")}},
+		{Script: `crc32 = import("hash/crc32"); h = crc32.NewIEEE(); h.Write(a); h.Sum32()`, Input: map[string]interface{}{"a": []byte("hello")}, RunOutput: uint32(907060870), Output: map[string]interface{}{"a": []byte("hello")}},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
+func TestPackagesCsv(t *testing.T) {
+	t.Parallel()
+
+	tests := []Test{
+		{Script: `csv = import("encoding/csv"); strings = import("strings"); r = csv.NewReader(strings.NewReader("a,b\n1,\"2,3\"\n")); a, err = r.ReadAll(); if err != nil { return err }; a`, RunOutput: [][]string{{"a", "b"}, {"1", "2,3"}}},
+		{Script: `csv = import("encoding/csv"); bytes = import("bytes"); a = make(bytes.Buffer); w = csv.NewWriter(&a); w.Write(["a", "b,c"]); w.Flush(); a.String()`, RunOutput: "a,\"b,c\"\n"},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
+func TestPackagesErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []Test{
+		{Script: `errors = import("errors"); fmt = import("fmt"); a = errors.New("a"); b = fmt.Errorf("b: %w", a); [errors.Is(b, a), errors.Is(a, b), errors.Unwrap(b) == a]`, RunOutput: []interface{}{true, false, true}},
+		{Script: `errors = import("errors"); fmt = import("fmt"); os = import("os"); a = fmt.Errorf("b: %w", b); c = new(*os.PathError); [errors.As(a, c), *c == b]`, Input: map[string]interface{}{"b": &os.PathError{Op: "open", Path: "a", Err: os.ErrNotExist}}, RunOutput: []interface{}{true, true}},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
+func TestPackagesGzip(t *testing.T) {
+	t.Parallel()
+
+	tests := []Test{
+		{Script: `gzip = import("compress/gzip"); bytes = import("bytes"); ioutil = import("io/ioutil"); b = make(bytes.Buffer); w = gzip.NewWriter(&b); w.Write(a); w.Close(); r, err = gzip.NewReader(bytes.NewReader(b.Bytes())); if err != nil { return err }; ioutil.ReadAll(r)`, Input: map[string]interface{}{"a": []byte("hello")}, RunOutput: []interface{}{[]byte("hello"), nil}, Output: map[string]interface{}{"a": []byte("hello")}},
+		{Script: `gzip = import("compress/gzip"); strings = import("strings"); r, err = gzip.NewReader(strings.NewReader("a")); err != nil`, RunOutput: true},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
+func TestPackagesHex(t *testing.T) {
+	t.Parallel()
+
+	tests := []Test{
+		{Script: `hex = import("encoding/hex"); hex.EncodeToString(a)`, Input: map[string]interface{}{"a": []byte("hello")}, RunOutput: "68656c6c6f", Output: map[string]interface{}{"a": []byte("hello")}},
+		{Script: `hex = import("encoding/hex"); a, err = hex.DecodeString("68656c6c6f"); if err != nil { return err }; a`, RunOutput: []byte("hello")},
+		{Script: `hex = import("encoding/hex"); a, err = hex.DecodeString("6"); err == hex.ErrLength`, RunOutput: true},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
+func TestPackagesHTML(t *testing.T) {
+	t.Parallel()
+
+	tests := []Test{
+		{Script: `html = import("html"); html.EscapeString("<a href=\"b\">")`, RunOutput: "&lt;a href=&#34;b&#34;&gt;"},
+		{Script: `html = import("html"); html.UnescapeString("&lt;a&gt; &amp; &eacute;")`, RunOutput: "<a> & é"},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
+func TestPackagesHttptest(t *testing.T) {
+	t.Parallel()
+
+	tests := []Test{
+		{Script: `httptest = import("net/http/httptest"); a = httptest.NewRecorder(); a.WriteHeader(201); a.WriteString("b"); [a.Code, a.Body.String()]`, RunOutput: []interface{}{201, "b"}},
+		{Script: `httptest = import("net/http/httptest"); http = import("net/http"); a = httptest.NewRequest("GET", "/b", nil); b = httptest.NewRecorder(); http.NotFoundHandler().ServeHTTP(b, a); [a.URL.Path, b.Code]`, RunOutput: []interface{}{"/b", 404}},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
 func TestPackagesJson(t *testing.T) {
 	t.Parallel()
 
@@ -59,6 +163,39 @@ func TestPackagesJson(t *testing.T) {
 	runTests(t, tests, nil, &Options{Debug: true})
 }
 
+func TestPackagesMathBig(t *testing.T) {
+	t.Parallel()
+
+	tests := []Test{
+		{Script: `big = import("math/big"); a = big.NewInt(2); b = big.NewInt(3); (a + b).String()`, RunOutput: "5"},
+		{Script: `big = import("math/big"); a = big.NewInt(2); b = big.NewInt(3); (a - b).String()`, RunOutput: "-1"},
+		{Script: `big = import("math/big"); a = big.NewInt(2); b = big.NewInt(3); (a * b * b).String()`, RunOutput: "18"},
+		{Script: `big = import("math/big"); a = big.NewInt(7); b = big.NewInt(2); (a / b).String()`, RunOutput: "3"},
+		{Script: `big = import("math/big"); a = big.NewInt(7); b = big.NewInt(2); (a % b).String()`, RunOutput: "1"},
+		{Script: `big = import("math/big"); a = big.NewInt(2); b = big.NewInt(3); c = a + b; a.String() + b.String()`, RunOutput: "23"},
+		{Script: `big = import("math/big"); a = big.NewInt(2); b = big.NewInt(3); a < b`, RunOutput: true},
+		{Script: `big = import("math/big"); a = big.NewInt(2); b = big.NewInt(3); a >= b`, RunOutput: false},
+		{Script: `big = import("math/big"); a = big.NewInt(2); b = big.NewInt(2); a == b`, RunOutput: true},
+		{Script: `big = import("math/big"); a = big.NewInt(2); a != nil`, RunOutput: true},
+		{Script: `big = import("math/big"); a = big.NewRat(1, 3); b = big.NewRat(1, 6); (a + b).String()`, RunOutput: "1/2"},
+		{Script: `big = import("math/big"); a = big.NewRat(1, 3); b = big.NewRat(1, 6); a > b`, RunOutput: true},
+		{Script: `big = import("math/big"); a = big.NewFloat(1.5); b = big.NewFloat(2); (a * b).String()`, RunOutput: "3"},
+		{Script: `big = import("math/big"); a = big.NewInt(2); a + "a"`, RunError: fmt.Errorf("operator can not use type string as type *big.Int")},
+		{Script: `big = import("math/big"); a = make(big.Int); a.SetInt64(4); a.String()`, RunOutput: "4"},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
+func TestPackagesMime(t *testing.T) {
+	t.Parallel()
+
+	tests := []Test{
+		{Script: `mime = import("mime"); mime.TypeByExtension(".html")`, RunOutput: "text/html; charset=utf-8"},
+		{Script: `mime = import("mime"); a, b, err = mime.ParseMediaType("text/plain; charset=utf-8"); if err != nil { return err }; [a, b]`, RunOutput: []interface{}{"text/plain", map[string]string{"charset": "utf-8"}}},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
 func TestPackagesRegexp(t *testing.T) {
 	t.Parallel()
 
@@ -86,6 +223,16 @@ func TestPackagesRegexp(t *testing.T) {
 	runTests(t, tests, nil, &Options{Debug: true})
 }
 
+func TestPackagesSha256(t *testing.T) {
+	t.Parallel()
+
+	tests := []Test{
+		{Script: `sha256 = import("crypto/sha256"); hex = import("encoding/hex"); h = sha256.New(); h.Write(a); hex.EncodeToString(h.Sum(nil))`, Input: map[string]interface{}{"a": []byte("hello")}, RunOutput: "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", Output: map[string]interface{}{"a": []byte("hello")}},
+		{Script: `sha256 = import("crypto/sha256"); sha256.Size`, RunOutput: 32},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
 func TestPackagesSort(t *testing.T) {
 	t.Parallel()
 
@@ -173,6 +320,16 @@ func TestPackagesSync(t *testing.T) {
 	runTests(t, tests, nil, &Options{Debug: true})
 }
 
+func TestPackagesTemplate(t *testing.T) {
+	t.Parallel()
+
+	tests := []Test{
+		{Script: `template = import("text/template"); bytes = import("bytes"); a, err = template.New("a").Parse("Hello {{.b}}!"); if err != nil { return err }; b = make(bytes.Buffer); err = a.Execute(&b, {"b": "world"}); if err != nil { return err }; b.String()`, RunOutput: "Hello world!"},
+		{Script: `template = import("text/template"); a, err = template.New("a").Parse("{{"); err != nil`, RunOutput: true},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
 func TestPackagesTime(t *testing.T) {
 	t.Parallel()
 
@@ -182,6 +339,17 @@ func TestPackagesTime(t *testing.T) {
 	runTests(t, tests, nil, &Options{Debug: true})
 }
 
+func TestPackagesUnicode(t *testing.T) {
+	t.Parallel()
+
+	tests := []Test{
+		{Script: `unicode = import("unicode"); [unicode.IsUpper(65), unicode.IsUpper(97), unicode.IsDigit(49)]`, RunOutput: []interface{}{true, false, true}},
+		{Script: `unicode = import("unicode"); unicode.ToUpper(97)`, RunOutput: int32(65)},
+		{Script: `unicode = import("unicode"); unicode.Is(unicode.Han, 0x4e16)`, RunOutput: true},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
 func TestPackagesURL(t *testing.T) {
 	t.Parallel()
 
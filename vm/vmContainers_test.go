@@ -1924,3 +1924,90 @@ make(struct {
 	}
 	runTests(t, tests, nil, &Options{Debug: true})
 }
+
+type testObjectBase struct {
+	ID int64 `anko:"id,readonly"`
+}
+
+type testObject struct {
+	testObjectBase
+	Name     string `anko:"name"`
+	Port     int
+	Password string          `anko:"-"`
+	Limit    int64           `anko:",readonly"`
+	Inner    testObjectInner `anko:"inner,readonly"`
+	hidden   int64
+}
+
+type testObjectInner struct {
+	X int64
+}
+
+func (o *testObject) Address() string {
+	return fmt.Sprintf("%v:%v", o.Name, o.Port)
+}
+
+func (o *testObject) Reset() {
+	o.Port = 0
+}
+
+func TestObjects(t *testing.T) {
+	t.Parallel()
+
+	newObject := func(options *env.ObjectOptions) *env.Object {
+		object, err := env.NewObject(&testObject{testObjectBase: testObjectBase{ID: 1}, Name: "a", Port: 80, Password: "b", Limit: 2, Inner: testObjectInner{X: 4}, hidden: 3}, options)
+		if err != nil {
+			t.Fatal("NewObject error:", err)
+		}
+		return object
+	}
+	methods := &env.ObjectOptions{Methods: map[string]string{"address": "Address", "reset": "Reset"}}
+
+	tests := []Test{
+		{Script: `a.id`, Input: map[string]interface{}{"a": newObject(nil)}, RunOutput: int64(1)},
+		{Script: `a.name`, Input: map[string]interface{}{"a": newObject(nil)}, RunOutput: "a"},
+		{Script: `a.Port`, Input: map[string]interface{}{"a": newObject(nil)}, RunOutput: int(80)},
+		{Script: `a.Limit`, Input: map[string]interface{}{"a": newObject(nil)}, RunOutput: int64(2)},
+		{Script: `a.Name`, Input: map[string]interface{}{"a": newObject(nil)}, RunError: fmt.Errorf("no member named 'Name' for object")},
+		{Script: `a.Password`, Input: map[string]interface{}{"a": newObject(nil)}, RunError: fmt.Errorf("no member named 'Password' for object")},
+		{Script: `a.hidden`, Input: map[string]interface{}{"a": newObject(nil)}, RunError: fmt.Errorf("no member named 'hidden' for object")},
+		{Script: `a.Address()`, Input: map[string]interface{}{"a": newObject(nil)}, RunError: fmt.Errorf("no member named 'Address' for object")},
+
+		{Script: `a.name = "c"; a.name`, Input: map[string]interface{}{"a": newObject(nil)}, RunOutput: "c"},
+		{Script: `a.Port = 8080; a.Port`, Input: map[string]interface{}{"a": newObject(nil)}, RunOutput: int(8080)},
+		{Script: `a.Port++; a.Port`, Input: map[string]interface{}{"a": newObject(nil)}, RunOutput: int(81)},
+		{Script: `a.Port = "c"`, Input: map[string]interface{}{"a": newObject(nil)}, RunError: fmt.Errorf("type string cannot be assigned to type int for object")},
+		{Script: `a.id = 2`, Input: map[string]interface{}{"a": newObject(nil)}, RunError: fmt.Errorf("object member 'id' is read-only")},
+		{Script: `a.Limit = 3`, Input: map[string]interface{}{"a": newObject(nil)}, RunError: fmt.Errorf("object member 'Limit' is read-only")},
+		{Script: `a.Limit += 3`, Input: map[string]interface{}{"a": newObject(nil)}, RunError: fmt.Errorf("object member 'Limit' is read-only")},
+		{Script: `a.Password = "c"`, Input: map[string]interface{}{"a": newObject(nil)}, RunError: fmt.Errorf("no member named 'Password' for object")},
+		{Script: `a.name = "c"`, Input: map[string]interface{}{"a": newObject(&env.ObjectOptions{ReadOnly: true})}, RunError: fmt.Errorf("object member 'name' is read-only")},
+		{Script: `a.inner.X`, Input: map[string]interface{}{"a": newObject(nil)}, RunOutput: int64(4)},
+		{Script: `a.inner.X = 5`, Input: map[string]interface{}{"a": newObject(nil)}, RunError: fmt.Errorf("struct member 'X' cannot be assigned")},
+		{Script: `a.inner.X++`, Input: map[string]interface{}{"a": newObject(nil)}, RunError: fmt.Errorf("struct member 'X' cannot be assigned")},
+
+		{Script: `a.address()`, Input: map[string]interface{}{"a": newObject(methods)}, RunOutput: "a:80"},
+		{Script: `a.Port = 8080; a.address()`, Input: map[string]interface{}{"a": newObject(methods)}, RunOutput: "a:8080"},
+		{Script: `a.reset(); a.Port`, Input: map[string]interface{}{"a": newObject(methods)}, RunOutput: int(0)},
+		{Script: `a.Reset()`, Input: map[string]interface{}{"a": newObject(methods)}, RunError: fmt.Errorf("no member named 'Reset' for object")},
+
+		{Script: `a.name`, Input: map[string]interface{}{"a": newObject(&env.ObjectOptions{TagName: "json"})}, RunError: fmt.Errorf("no member named 'name' for object")},
+		{Script: `a.Name`, Input: map[string]interface{}{"a": newObject(&env.ObjectOptions{TagName: "json"})}, RunOutput: "a"},
+		{Script: `a.ID`, Input: map[string]interface{}{"a": newObject(&env.ObjectOptions{TagName: "json"})}, RunOutput: int64(1)},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+
+	value := &testObject{Name: "a", Port: 80}
+	e := env.NewEnv()
+	err := e.DefineObject("a", value, nil)
+	if err != nil {
+		t.Fatal("DefineObject error:", err)
+	}
+	_, err = Execute(e, nil, `a.Port = 8080; a.name = "b"`)
+	if err != nil {
+		t.Fatal("Execute error:", err)
+	}
+	if value.Port != 8080 || value.Name != "b" {
+		t.Errorf("DefineObject value - received: %+v - expected: Port 8080 and Name b", value)
+	}
+}
@@ -0,0 +1,55 @@
+package vm
+
+import (
+	"context"
+)
+
+// contextKeysKey is the context key of the context values scripts can read.
+type contextKeysKey struct{}
+
+// optionsKey is the context key of the options of a run.
+type optionsKey struct{}
+
+// overlayKey is the context key of the overlay env a run is in.
+type overlayKey struct{}
+
+// ContextOptions returns a copy of the options of the run of ctx, nil if ctx is not the context of a run.
+// Go functions that take a context.Context first get the context of the run.
+func ContextOptions(ctx context.Context) *Options {
+	options, ok := ctx.Value(optionsKey{}).(*Options)
+	if !ok {
+		return nil
+	}
+	optionsCopy := *options
+	return &optionsCopy
+}
+
+// WithContextKeys returns a copy of ctx that lets scripts read the values of ctx with the keys,
+// for Go code that calls script functions with a Func.
+// keys maps the names used by scripts to the context keys, names already allowed by ctx are kept.
+// RunContext does the same with the ContextKeys of the Options.
+func WithContextKeys(ctx context.Context, keys map[string]interface{}) context.Context {
+	if len(keys) < 1 {
+		return ctx
+	}
+	parent, _ := ctx.Value(contextKeysKey{}).(map[string]interface{})
+	allowed := make(map[string]interface{}, len(parent)+len(keys))
+	for name, key := range parent {
+		allowed[name] = key
+	}
+	for name, key := range keys {
+		allowed[name] = key
+	}
+	return context.WithValue(ctx, contextKeysKey{}, allowed)
+}
+
+// ContextValue returns the value of ctx for the name scripts use.
+// Returns nil if the name is not allowed by WithContextKeys or the ContextKeys of the Options.
+func ContextValue(ctx context.Context, name string) interface{} {
+	allowed, _ := ctx.Value(contextKeysKey{}).(map[string]interface{})
+	key, ok := allowed[name]
+	if !ok {
+		return nil
+	}
+	return ctx.Value(key)
+}
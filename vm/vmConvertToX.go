@@ -1,24 +1,389 @@
 package vm
 
 import (
+	"context"
 	"fmt"
 	"reflect"
+	"strings"
+	"sync"
+	"time"
 )
 
-// Remove duplicate definition of convertReflectValueToType
-// Ensure undefined variables inputValue and targetType are removed or replaced
+type (
+	// ConvertFunc converts the reflect.Value to the reflect.Type, returning an error if it can not.
+	ConvertFunc func(rv reflect.Value, rt reflect.Type) (reflect.Value, error)
 
-func someFunctionUsingConvertReflectValueToType() {
-	// Example usage of convertReflectValueToType
-	// Replace undefined variables with valid ones
-	var inputValue reflect.Value
-	var targetType reflect.Type
+	// Converters is a registry of ConvertFunc used to convert script values to the Go types
+	// of function arguments, assignments to typed variables, struct fields, map keys and values, and slice items.
+	// Registered conversions are tried before the built-in ones, which convert:
+	//  numbers to other number types and types reflect can convert
+	//  slices and arrays to slices and arrays of other item types, converting each item
+	//  maps to maps of other key and value types, converting each key and value
+	//  maps to structs, setting the fields named by the map keys, see convertMapToStruct
+	//  values to pointers to the converted value
+	//  script functions to Go function types, which run with the context of the run that converted them
+	//  strings of one character to byte and rune
+	//  strings to time.Duration with time.ParseDuration and to time.Time with RFC 3339 or 2006-01-02 dates
+	Converters struct {
+		parent  *Converters
+		rwMutex sync.RWMutex
+		funcs   map[converterKey]ConvertFunc
+	}
+
+	converterKey struct {
+		from reflect.Type
+		to   reflect.Type
+	}
+)
+
+var (
+	durationType = reflect.TypeOf(time.Duration(0))
+	timeType     = reflect.TypeOf(time.Time{})
+
+	// DefaultConverters are the Converters used when Options has no Converters, and by Func and BindFunc.
+	// Conversions registered here are used by all runs.
+	DefaultConverters = newDefaultConverters()
+)
+
+// newDefaultConverters creates the DefaultConverters with the built-in string conversions.
+func newDefaultConverters() *Converters {
+	converters := &Converters{funcs: make(map[converterKey]ConvertFunc)}
+	converters.Register(stringType, durationType, func(rv reflect.Value, rt reflect.Type) (reflect.Value, error) {
+		duration, err := time.ParseDuration(rv.String())
+		if err != nil {
+			return rv, err
+		}
+		return reflect.ValueOf(duration).Convert(rt), nil
+	})
+	converters.Register(stringType, timeType, func(rv reflect.Value, rt reflect.Type) (reflect.Value, error) {
+		aTime, err := time.Parse(time.RFC3339, rv.String())
+		if err != nil {
+			aTime, err = time.Parse("2006-01-02", rv.String())
+			if err != nil {
+				return rv, fmt.Errorf("cannot parse %q as RFC 3339 time or date", rv.String())
+			}
+		}
+		return reflect.ValueOf(aTime), nil
+	})
+	return converters
+}
+
+// NewConverters creates Converters that falls back to the DefaultConverters for types it has no conversion for.
+func NewConverters() *Converters {
+	return &Converters{parent: DefaultConverters, funcs: make(map[converterKey]ConvertFunc)}
+}
+
+// Register registers fn to convert values of the from type to the to type.
+// A nil from type registers fn for values of any type that are not already the to type.
+// Registering a nil fn removes the conversion.
+func (c *Converters) Register(from reflect.Type, to reflect.Type, fn ConvertFunc) {
+	c.rwMutex.Lock()
+	if fn == nil {
+		delete(c.funcs, converterKey{from: from, to: to})
+	} else {
+		c.funcs[converterKey{from: from, to: to}] = fn
+	}
+	c.rwMutex.Unlock()
+}
+
+// lookup returns the registered ConvertFunc for the types, nil if there is none.
+// Conversions for the from type are found before the ones registered for any type.
+func (c *Converters) lookup(from reflect.Type, to reflect.Type) ConvertFunc {
+	for converters := c; converters != nil; converters = converters.parent {
+		converters.rwMutex.RLock()
+		fn, ok := converters.funcs[converterKey{from: from, to: to}]
+		if !ok {
+			fn, ok = converters.funcs[converterKey{to: to}]
+		}
+		converters.rwMutex.RUnlock()
+		if ok {
+			return fn
+		}
+	}
+	return nil
+}
+
+// converters returns the Converters of the run.
+func (runInfo *runInfoStruct) converters() *Converters {
+	if runInfo.options.Converters != nil {
+		return runInfo.options.Converters
+	}
+	return DefaultConverters
+}
+
+// convertReflectValueToType trys to covert the reflect.Value to the reflect.Type with the Converters of the run.
+// if it can not, it returns the original rv and an error
+func (runInfo *runInfoStruct) convertReflectValueToType(rv reflect.Value, rt reflect.Type) (reflect.Value, error) {
+	return runInfo.converters().convert(runInfo.ctx, rv, rt)
+}
+
+// convertReflectValueToType trys to covert the reflect.Value to the reflect.Type with the DefaultConverters.
+// if it can not, it returns the original rv and an error
+func convertReflectValueToType(rv reflect.Value, rt reflect.Type) (reflect.Value, error) {
+	return DefaultConverters.Convert(rv, rt)
+}
+
+// Convert trys to covert the reflect.Value to the reflect.Type
+// if it can not, it returns the original rv and an error
+func (c *Converters) Convert(rv reflect.Value, rt reflect.Type) (reflect.Value, error) {
+	return c.convert(context.Background(), rv, rt)
+}
+
+// convert trys to covert the reflect.Value to the reflect.Type,
+// script functions converted to Go functions are called with ctx.
+// if it can not, it returns the original rv and an error
+func (c *Converters) convert(ctx context.Context, rv reflect.Value, rt reflect.Type) (reflect.Value, error) {
+	if rt == interfaceType || rv.Type() == rt {
+		// if reflect.Type is interface or the types match, return the provided reflect.Value
+		return rv, nil
+	}
+	if rv.Type() != interfaceType {
+		// interface values are looked up by the type of their element below
+		if fn := c.lookup(rv.Type(), rt); fn != nil {
+			return fn(rv, rt)
+		}
+	}
+	if value, ok, err := convertBigNumber(rv, rt); ok {
+		return value, err
+	}
+	if rv.Type().ConvertibleTo(rt) {
+		// if reflect can covert, do that conversion and return
+		return rv.Convert(rt), nil
+	}
+	if (rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array) &&
+		(rt.Kind() == reflect.Slice || rt.Kind() == reflect.Array) {
+		// covert slice or array
+		return c.convertSliceOrArray(ctx, rv, rt)
+	}
+	if rv.Kind() == rt.Kind() {
+		// kind matches
+		switch rv.Kind() {
+		case reflect.Map:
+			// convert map
+			return c.convertMap(ctx, rv, rt)
+		case reflect.Func:
+			// for runVMFunction conversions, call convertVMFunctionToType
+			return c.convertVMFunctionToType(ctx, rv, rt)
+		case reflect.Ptr:
+			// both rv and rt are pointers, convert what they are pointing to
+			value, err := c.convert(ctx, rv.Elem(), rt.Elem())
+			if err != nil {
+				return rv, err
+			}
+			// need to make a new value to be able to set it
+			ptrV, err := makeValue(rt)
+			if err != nil {
+				return rv, err
+			}
+			// set value and return new pointer
+			ptrV.Elem().Set(value)
+			return ptrV, nil
+		}
+	}
+	if rv.Type() == interfaceType {
+		if rv.IsNil() {
+			// return nil of correct type
+			return reflect.Zero(rt), nil
+		}
+		// try to convert the element
+		return c.convert(ctx, rv.Elem(), rt)
+	}
 
-	value, err := convertReflectValueToType(inputValue, targetType)
-	if err != nil {
-		// Handle error
-		fmt.Println("Error:", err)
+	if rv.Type() == stringType {
+		if rt == byteType {
+			aString := rv.String()
+			if len(aString) < 1 {
+				return reflect.Zero(rt), nil
+			}
+			if len(aString) > 1 {
+				return rv, errInvalidTypeConversion
+			}
+			return reflect.ValueOf(aString[0]), nil
+		}
+		if rt == runeType {
+			aString := rv.String()
+			if len(aString) < 1 {
+				return reflect.Zero(rt), nil
+			}
+			if len(aString) > 1 {
+				return rv, errInvalidTypeConversion
+			}
+			return reflect.ValueOf(rune(aString[0])), nil
+		}
 	}
-	// Use the converted value
-	_ = value
+
+	if rv.Kind() == reflect.Map && rt.Kind() == reflect.Struct {
+		// convert map to struct
+		return c.convertMapToStruct(ctx, rv, rt)
+	}
+	if rt.Kind() == reflect.Ptr && rv.Kind() != reflect.Ptr {
+		// convert the value to what rt points to and return a pointer to it
+		value, err := c.convert(ctx, rv, rt.Elem())
+		if err != nil {
+			return rv, err
+		}
+		ptrV := reflect.New(rt.Elem())
+		ptrV.Elem().Set(value)
+		return ptrV, nil
+	}
+
+	return rv, errInvalidTypeConversion
+}
+
+// convertSliceOrArray trys to covert the reflect.Value slice or array to the slice or array reflect.Type
+func (c *Converters) convertSliceOrArray(ctx context.Context, rv reflect.Value, rt reflect.Type) (reflect.Value, error) {
+	rtElemType := rt.Elem()
+
+	// try to covert elements to new slice/array
+	var value reflect.Value
+	if rt.Kind() == reflect.Slice {
+		// make slice
+		value = reflect.MakeSlice(rt, rv.Len(), rv.Len())
+	} else {
+		// make array
+		value = reflect.New(rt).Elem()
+	}
+
+	var err error
+	var v reflect.Value
+	for i := 0; i < rv.Len(); i++ {
+		v, err = c.convert(ctx, rv.Index(i), rtElemType)
+		if err != nil {
+			return rv, err
+		}
+		value.Index(i).Set(v)
+	}
+
+	// return new converted slice or array
+	return value, nil
+}
+
+// convertMapToStruct trys to covert the reflect.Value map to the struct reflect.Type.
+// The map keys must be strings and name the exported fields to set,
+// either by the name in the anko struct tag of the field or by the field name ignoring case.
+func (c *Converters) convertMapToStruct(ctx context.Context, rv reflect.Value, rt reflect.Type) (reflect.Value, error) {
+	value := reflect.New(rt).Elem()
+
+	mapKeys := rv.MapKeys()
+	for i := 0; i < len(mapKeys); i++ {
+		key := mapKeys[i]
+		if key.Kind() == reflect.Interface && !key.IsNil() {
+			key = key.Elem()
+		}
+		if key.Kind() != reflect.String {
+			return rv, fmt.Errorf("map key type %v cannot name a field of struct %v", key.Type(), rt)
+		}
+
+		index, ok := structFieldIndex(rt, key.String())
+		if !ok {
+			return rv, fmt.Errorf("no field named '%v' for struct %v", key.String(), rt)
+		}
+		field := value.Field(index)
+		fieldValue, err := c.convert(ctx, rv.MapIndex(mapKeys[i]), field.Type())
+		if err != nil {
+			return rv, fmt.Errorf("field '%v' of struct %v cannot be type %v", key.String(), rt, rv.MapIndex(mapKeys[i]).Type())
+		}
+		field.Set(fieldValue)
+	}
+
+	return value, nil
+}
+
+// structFieldIndex returns the index of the exported field of the struct type for the name.
+// The name in the anko struct tag is matched first, then the field name ignoring case.
+func structFieldIndex(rt reflect.Type, name string) (int, bool) {
+	index := -1
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if field.PkgPath != "" {
+			// unexported
+			continue
+		}
+		tag := strings.Split(field.Tag.Get("anko"), ",")[0]
+		if tag == "-" {
+			continue
+		}
+		if tag == name {
+			return i, true
+		}
+		if tag == "" && index < 0 && strings.EqualFold(field.Name, name) {
+			index = i
+		}
+	}
+	return index, index >= 0
+}
+
+// convertVMFunctionToType is for translating a runVMFunction into the correct type
+// so it can be passed to a Go function argument with the correct static types
+// it creates a translate function runVMConvertFunction
+func (c *Converters) convertVMFunctionToType(ctx context.Context, rv reflect.Value, rt reflect.Type) (reflect.Value, error) {
+	// only translates runVMFunction type
+	if !checkIfRunVMFunction(rv.Type()) {
+		return rv, errInvalidTypeConversion
+	}
+
+	// create runVMConvertFunction to match reflect.Type
+	// this function is being called by the Go function
+	runVMConvertFunction := func(in []reflect.Value) []reflect.Value {
+		// note: this function is being called by another reflect Call
+		// only way to pass along any errors is by panic
+
+		// make the reflect.Value slice of each of the VM reflect.Value
+		args := make([]reflect.Value, 0, rt.NumIn()+1)
+		// for runVMFunction first arg is always context, the one of the run that converted the function
+		args = append(args, reflect.ValueOf(ctx))
+		for i := 0; i < rt.NumIn(); i++ {
+			// have to do the double reflect.ValueOf that runVMFunction expects
+			args = append(args, reflect.ValueOf(in[i]))
+		}
+
+		// Call runVMFunction
+		rvs := rv.Call(args)
+
+		// call processCallReturnValues to process runVMFunction return values
+		// returns normal VM reflect.Value form
+		rv, err := processCallReturnValues(rvs, true, false)
+		if err != nil {
+			panic(err)
+		}
+
+		if rt.NumOut() < 1 {
+			// Go function does not want any return values, so give it none
+			return []reflect.Value{}
+		}
+		if rt.NumOut() < 2 {
+			// Go function wants one return value
+			// will try to covert to reflect.Value correct type and return
+			rv, err = c.convert(ctx, rv, rt.Out(0))
+			if err != nil {
+				panic("function wants return type " + rt.Out(0).String() + " but received type " + rv.Type().String())
+			}
+			return []reflect.Value{rv}
+		}
+
+		// Go function wants more than one return value
+		// make sure we have a slice/array with enought values
+
+		if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+			panic(fmt.Sprintf("function wants %v return values but received %v", rt.NumOut(), rv.Kind().String()))
+		}
+		if rv.Len() < rt.NumOut() {
+			panic(fmt.Sprintf("function wants %v return values but received %v values", rt.NumOut(), rv.Len()))
+		}
+
+		// try to covert each value in slice to wanted type and put into a reflect.Value slice
+		rvs = make([]reflect.Value, rt.NumOut())
+		for i := 0; i < rv.Len(); i++ {
+			rvs[i], err = c.convert(ctx, rv.Index(i), rt.Out(i))
+			if err != nil {
+				panic("function wants return type " + rt.Out(i).String() + " but received type " + rvs[i].Type().String())
+			}
+		}
+
+		// return created reflect.Value slice
+		return rvs
+	}
+
+	// make the reflect.Value function that calls runVMConvertFunction
+	return reflect.MakeFunc(rt, runVMConvertFunction), nil
 }
@@ -0,0 +1,241 @@
+package vm
+
+import (
+	"reflect"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/mattn/anko/ast"
+)
+
+// Coverage records how many times the statements of scripts run, and how many times
+// each branch of their if and switch statements is taken. Set it in the Options of the runs to cover
+// and add the scripts with Add, modules imported by the scripts are added when they are loaded.
+// A Coverage can be used by many runs at the same time.
+type Coverage struct {
+	rwMutex sync.RWMutex
+	files   map[string]*coverageFile
+	// stmts and branches are the counters of the statements that were added
+	stmts    map[ast.Stmt]*int64
+	branches map[ast.Stmt][]*int64
+}
+
+// coverageFile has the counters of a file by position, so files added more than once share their counters.
+type coverageFile struct {
+	source   []byte
+	stmts    map[ast.Position]*int64
+	branches map[ast.Position][]*int64
+}
+
+// FileCoverage is the coverage of a file.
+type FileCoverage struct {
+	// File is the name of the file
+	File string
+	// Source is the source of the file
+	Source []byte
+	// Stmts are the statements of the file, sorted by position
+	Stmts []StmtCoverage
+	// Branches are the branches of the if and switch statements of the file, sorted by position and branch
+	Branches []BranchCoverage
+}
+
+// StmtCoverage is how many times the statement at Pos ran.
+type StmtCoverage struct {
+	Pos   ast.Position
+	Count int64
+}
+
+// BranchCoverage is how many times a branch of the if or switch statement at Pos was taken.
+// The branches of an if statement are the then block, each else if and the else block,
+// the ones of a switch statement are each case and the default. The last branch
+// is counted even when the statement does not have an else or a default.
+type BranchCoverage struct {
+	Pos    ast.Position
+	Branch int
+	Count  int64
+}
+
+// NewCoverage creates a Coverage without files.
+func NewCoverage() *Coverage {
+	return &Coverage{
+		files:    make(map[string]*coverageFile),
+		stmts:    make(map[ast.Stmt]*int64),
+		branches: make(map[ast.Stmt][]*int64),
+	}
+}
+
+// Add adds the statements of the file parsed from source to the coverage, with a count of zero.
+// Adding a file that was already added, parsed again from the same source, keeps its counts.
+func (c *Coverage) Add(file string, source []byte, stmt ast.Stmt) {
+	c.rwMutex.Lock()
+	defer c.rwMutex.Unlock()
+
+	covered, ok := c.files[file]
+	if !ok {
+		covered = &coverageFile{
+			source:   source,
+			stmts:    make(map[ast.Position]*int64),
+			branches: make(map[ast.Position][]*int64),
+		}
+		c.files[file] = covered
+	}
+
+	walkCoverageStmts(reflect.ValueOf(stmt), func(stmt ast.Stmt) {
+		pos := stmt.Position()
+		counter, ok := covered.stmts[pos]
+		if !ok {
+			counter = new(int64)
+			covered.stmts[pos] = counter
+		}
+		c.stmts[stmt] = counter
+
+		var numBranches int
+		switch stmt := stmt.(type) {
+		case *ast.IfStmt:
+			numBranches = len(stmt.ElseIf) + 2
+		case *ast.SwitchStmt:
+			numBranches = len(stmt.Cases) + 1
+		default:
+			return
+		}
+		counters, ok := covered.branches[pos]
+		if !ok {
+			counters = make([]*int64, numBranches)
+			for i := range counters {
+				counters[i] = new(int64)
+			}
+			covered.branches[pos] = counters
+		}
+		c.branches[stmt] = counters
+	})
+}
+
+// walkCoverageStmts calls visit with each statement that is counted, including the ones in function literals.
+// Blocks of statements and switch cases are not counted, the branches of if and switch statements are.
+func walkCoverageStmts(rv reflect.Value, visit func(ast.Stmt)) {
+	switch rv.Kind() {
+	case reflect.Interface, reflect.Ptr:
+		if rv.IsNil() {
+			return
+		}
+		switch stmt := rv.Interface().(type) {
+		case *ast.ExprStmt, *ast.VarStmt, *ast.LetsStmt, *ast.LetMapItemStmt, *ast.IfStmt, *ast.TryStmt,
+			*ast.LoopStmt, *ast.ForStmt, *ast.CForStmt, *ast.ThrowStmt, *ast.ModuleStmt, *ast.SwitchStmt,
+			*ast.GoroutineStmt, *ast.DeleteStmt, *ast.CloseStmt, *ast.ChanStmt,
+			*ast.BreakStmt, *ast.ContinueStmt, *ast.ReturnStmt:
+			visit(stmt.(ast.Stmt))
+		}
+		walkCoverageStmts(rv.Elem(), visit)
+	case reflect.Slice:
+		for i := 0; i < rv.Len(); i++ {
+			walkCoverageStmts(rv.Index(i), visit)
+		}
+	case reflect.Struct:
+		if rv.Type() == reflectValueType {
+			return
+		}
+		for i := 0; i < rv.NumField(); i++ {
+			field := rv.Type().Field(i)
+			if field.PkgPath != "" || field.Anonymous {
+				continue
+			}
+			if field.Name == "ElseIf" {
+				// else if statements are branches of the if statement, only walk what is in them
+				for j := 0; j < rv.Field(i).Len(); j++ {
+					walkCoverageStmts(rv.Field(i).Index(j).Elem().Elem(), visit)
+				}
+				continue
+			}
+			walkCoverageStmts(rv.Field(i), visit)
+		}
+	}
+}
+
+// countStmt adds one to the count of the statement, if it was added.
+func (c *Coverage) countStmt(stmt ast.Stmt) {
+	c.rwMutex.RLock()
+	counter := c.stmts[stmt]
+	c.rwMutex.RUnlock()
+	if counter != nil {
+		atomic.AddInt64(counter, 1)
+	}
+}
+
+// countBranch adds one to the count of the branch of the if or switch statement, if it was added.
+func (c *Coverage) countBranch(stmt ast.Stmt, branch int) {
+	c.rwMutex.RLock()
+	counters := c.branches[stmt]
+	c.rwMutex.RUnlock()
+	if branch < len(counters) {
+		atomic.AddInt64(counters[branch], 1)
+	}
+}
+
+// coverStmt counts the statement when the run records coverage.
+func (runInfo *runInfoStruct) coverStmt(stmt ast.Stmt) {
+	if runInfo.options.Coverage != nil {
+		runInfo.options.Coverage.countStmt(stmt)
+	}
+}
+
+// coverBranch counts the branch of the if or switch statement when the run records coverage.
+func (runInfo *runInfoStruct) coverBranch(stmt ast.Stmt, branch int) {
+	if runInfo.options.Coverage != nil {
+		runInfo.options.Coverage.countBranch(stmt, branch)
+	}
+}
+
+// Files returns the coverage of the files, sorted by name.
+func (c *Coverage) Files() []FileCoverage {
+	c.rwMutex.RLock()
+	defer c.rwMutex.RUnlock()
+
+	files := make([]FileCoverage, 0, len(c.files))
+	for name, covered := range c.files {
+		file := FileCoverage{File: name, Source: covered.source}
+		for pos, counter := range covered.stmts {
+			file.Stmts = append(file.Stmts, StmtCoverage{Pos: pos, Count: atomic.LoadInt64(counter)})
+		}
+		sort.Slice(file.Stmts, func(i, j int) bool { return positionLess(file.Stmts[i].Pos, file.Stmts[j].Pos) })
+		for pos, counters := range covered.branches {
+			for branch, counter := range counters {
+				file.Branches = append(file.Branches, BranchCoverage{Pos: pos, Branch: branch, Count: atomic.LoadInt64(counter)})
+			}
+		}
+		sort.Slice(file.Branches, func(i, j int) bool {
+			if file.Branches[i].Pos != file.Branches[j].Pos {
+				return positionLess(file.Branches[i].Pos, file.Branches[j].Pos)
+			}
+			return file.Branches[i].Branch < file.Branches[j].Branch
+		})
+		files = append(files, file)
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].File < files[j].File })
+	return files
+}
+
+// Percent returns the percentage of statements that ran, 0 if there are no statements.
+func (c *Coverage) Percent() float64 {
+	var total, covered int
+	for _, file := range c.Files() {
+		for _, stmt := range file.Stmts {
+			total++
+			if stmt.Count > 0 {
+				covered++
+			}
+		}
+	}
+	if total == 0 {
+		return 0
+	}
+	return 100 * float64(covered) / float64(total)
+}
+
+// positionLess returns true if position a is before position b.
+func positionLess(a ast.Position, b ast.Position) bool {
+	if a.Line != b.Line {
+		return a.Line < b.Line
+	}
+	return a.Column < b.Column
+}
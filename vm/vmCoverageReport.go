@@ -0,0 +1,206 @@
+package vm
+
+import (
+	"bufio"
+	"fmt"
+	"html/template"
+	"io"
+	"strings"
+)
+
+// WriteProfile writes the statement counts in the cover profile format of Go, in count mode.
+// Each statement is a block from where it starts to the end of its first line.
+func (c *Coverage) WriteProfile(w io.Writer) error {
+	buffer := bufio.NewWriter(w)
+	fmt.Fprintln(buffer, "mode: count")
+	for _, file := range c.Files() {
+		lines := strings.Split(string(file.Source), "\n")
+		for _, stmt := range file.Stmts {
+			endColumn := stmt.Pos.Column + 1
+			if stmt.Pos.Line > 0 && stmt.Pos.Line <= len(lines) {
+				if lineEnd := len([]rune(strings.TrimRight(lines[stmt.Pos.Line-1], "\r"))) + 1; lineEnd > stmt.Pos.Column {
+					endColumn = lineEnd
+				}
+			}
+			fmt.Fprintf(buffer, "%v:%v.%v,%v.%v 1 %v\n", file.File, stmt.Pos.Line, stmt.Pos.Column, stmt.Pos.Line, endColumn, stmt.Count)
+		}
+	}
+	return buffer.Flush()
+}
+
+// WriteLCOV writes the line and branch counts in the LCOV tracefile format.
+// The count of a line is the highest count of the statements that start on it.
+func (c *Coverage) WriteLCOV(w io.Writer) error {
+	buffer := bufio.NewWriter(w)
+	for _, file := range c.Files() {
+		fmt.Fprintln(buffer, "TN:")
+		fmt.Fprintf(buffer, "SF:%v\n", file.File)
+
+		stmtCounts := make(map[int]int64)
+		for _, stmt := range file.Stmts {
+			if count, ok := stmtCounts[stmt.Pos.Line]; !ok || stmt.Count > count {
+				stmtCounts[stmt.Pos.Line] = stmt.Count
+			}
+		}
+
+		block := -1
+		var branchesHit int
+		for i, branch := range file.Branches {
+			if i == 0 || branch.Pos != file.Branches[i-1].Pos {
+				block++
+			}
+			taken := "-"
+			if file.statementRan(branch) {
+				taken = fmt.Sprint(branch.Count)
+			}
+			if branch.Count > 0 {
+				branchesHit++
+			}
+			fmt.Fprintf(buffer, "BRDA:%v,%v,%v,%v\n", branch.Pos.Line, block, branch.Branch, taken)
+		}
+		fmt.Fprintf(buffer, "BRF:%v\n", len(file.Branches))
+		fmt.Fprintf(buffer, "BRH:%v\n", branchesHit)
+
+		var linesHit int
+		for _, line := range file.lines() {
+			if stmtCounts[line] > 0 {
+				linesHit++
+			}
+			fmt.Fprintf(buffer, "DA:%v,%v\n", line, stmtCounts[line])
+		}
+		fmt.Fprintf(buffer, "LF:%v\n", len(stmtCounts))
+		fmt.Fprintf(buffer, "LH:%v\n", linesHit)
+		fmt.Fprintln(buffer, "end_of_record")
+	}
+	return buffer.Flush()
+}
+
+// statementRan returns true if the if or switch statement of the branch ran.
+func (file *FileCoverage) statementRan(branch BranchCoverage) bool {
+	for _, stmt := range file.Stmts {
+		if stmt.Pos == branch.Pos {
+			return stmt.Count > 0
+		}
+	}
+	return false
+}
+
+// lines returns the line numbers that have statements, in order.
+func (file *FileCoverage) lines() []int {
+	var lines []int
+	for _, stmt := range file.Stmts {
+		if len(lines) == 0 || lines[len(lines)-1] != stmt.Pos.Line {
+			lines = append(lines, stmt.Pos.Line)
+		}
+	}
+	return lines
+}
+
+// coverageHTML is the template of the HTML report.
+var coverageHTML = template.Must(template.New("coverage").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Anko coverage</title>
+<style>
+body { font-family: sans-serif; }
+pre { background: #fff; border: 1px solid #ddd; padding: 4px 0; }
+pre span { display: block; padding: 0 8px; }
+.count { display: inline-block; width: 5em; color: #888; text-align: right; margin-right: 1em; }
+.covered { background: #dfd; }
+.partial { background: #ffd; }
+.uncovered { background: #fdd; }
+</style>
+</head>
+<body>
+<h1>Anko coverage: {{printf "%.1f" .Percent}}% of statements</h1>
+{{range .Files}}<h2>{{.File}}: {{printf "%.1f" .Percent}}%</h2>
+<pre>{{range .Lines}}<span class="{{.Class}}" title="{{.Title}}"><span class="count">{{.Count}}</span>{{.Text}}</span>{{end}}</pre>
+{{end}}</body>
+</html>
+`))
+
+// coverageHTMLFile is a file of the HTML report.
+type coverageHTMLFile struct {
+	File    string
+	Percent float64
+	Lines   []coverageHTMLLine
+}
+
+// coverageHTMLLine is a line of source in the HTML report.
+type coverageHTMLLine struct {
+	Class string
+	Title string
+	Count string
+	Text  string
+}
+
+// WriteHTML writes a report with the source of each file, lines are green when all their statements
+// and branches ran, yellow when some did and red when none did. The counts are shown next to the lines.
+func (c *Coverage) WriteHTML(w io.Writer) error {
+	report := struct {
+		Percent float64
+		Files   []coverageHTMLFile
+	}{Percent: c.Percent()}
+
+	for _, file := range c.Files() {
+		htmlFile := coverageHTMLFile{File: file.File}
+		lines := strings.Split(strings.TrimRight(string(file.Source), "\n"), "\n")
+		htmlFile.Lines = make([]coverageHTMLLine, len(lines))
+		for i, line := range lines {
+			htmlFile.Lines[i].Text = strings.TrimRight(line, "\r")
+		}
+
+		var covered int
+		ran := make([]int, len(lines))
+		notRan := make([]int, len(lines))
+		for _, stmt := range file.Stmts {
+			if stmt.Count > 0 {
+				covered++
+			}
+			if stmt.Pos.Line < 1 || stmt.Pos.Line > len(lines) {
+				continue
+			}
+			htmlLine := &htmlFile.Lines[stmt.Pos.Line-1]
+			if stmt.Count > 0 {
+				ran[stmt.Pos.Line-1]++
+			} else {
+				notRan[stmt.Pos.Line-1]++
+			}
+			if htmlLine.Count == "" {
+				htmlLine.Count = fmt.Sprint(stmt.Count)
+			}
+		}
+		for _, branch := range file.Branches {
+			if branch.Pos.Line < 1 || branch.Pos.Line > len(lines) {
+				continue
+			}
+			htmlLine := &htmlFile.Lines[branch.Pos.Line-1]
+			if branch.Count > 0 {
+				ran[branch.Pos.Line-1]++
+			} else {
+				notRan[branch.Pos.Line-1]++
+			}
+			if htmlLine.Title == "" {
+				htmlLine.Title = "branches:"
+			}
+			htmlLine.Title += fmt.Sprintf(" %v", branch.Count)
+		}
+		for i := range htmlFile.Lines {
+			switch {
+			case ran[i] > 0 && notRan[i] > 0:
+				htmlFile.Lines[i].Class = "partial"
+			case ran[i] > 0:
+				htmlFile.Lines[i].Class = "covered"
+			case notRan[i] > 0:
+				htmlFile.Lines[i].Class = "uncovered"
+			}
+		}
+		if len(file.Stmts) > 0 {
+			htmlFile.Percent = 100 * float64(covered) / float64(len(file.Stmts))
+		}
+		report.Files = append(report.Files, htmlFile)
+	}
+
+	return coverageHTML.Execute(w, report)
+}
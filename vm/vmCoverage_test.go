@@ -0,0 +1,124 @@
+package vm
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/mattn/anko/env"
+	"github.com/mattn/anko/parser"
+)
+
+func TestCoverage(t *testing.T) {
+	t.Parallel()
+
+	script := `a = 0
+for i in [0, 1, 2] {
+  if i == 0 {
+    a += 1
+  } else if i == 1 {
+    a += 2
+  }
+}
+switch a {
+case 1:
+  a = 10
+default:
+  a = 20
+}
+func f() {
+  return 1
+}
+`
+	stmt, err := parser.ParseSrc(script)
+	if err != nil {
+		t.Fatal("ParseSrc error:", err)
+	}
+	coverage := NewCoverage()
+	coverage.Add("script.ank", []byte(script), stmt)
+
+	for i := 0; i < 2; i++ {
+		_, err = RunContext(context.Background(), env.NewEnv(), &Options{Debug: true, Coverage: coverage}, stmt)
+		if err != nil {
+			t.Fatal("RunContext error:", err)
+		}
+	}
+
+	// adding the file again keeps the counts
+	stmt, err = parser.ParseSrc(script)
+	if err != nil {
+		t.Fatal("ParseSrc error:", err)
+	}
+	coverage.Add("script.ank", []byte(script), stmt)
+
+	files := coverage.Files()
+	if len(files) != 1 || files[0].File != "script.ank" {
+		t.Fatalf("Files - received: %v - expected: %v", files, "script.ank")
+	}
+
+	stmtCounts := make(map[int]int64)
+	for _, stmt := range files[0].Stmts {
+		stmtCounts[stmt.Pos.Line] = stmt.Count
+	}
+	expectedStmtCounts := map[int]int64{1: 2, 2: 2, 3: 6, 4: 2, 6: 2, 9: 2, 11: 0, 13: 2, 15: 2, 16: 0}
+	for line, expected := range expectedStmtCounts {
+		if stmtCounts[line] != expected {
+			t.Errorf("statement count - received: %v - expected: %v - line: %v", stmtCounts[line], expected, line)
+		}
+	}
+	if len(stmtCounts) != len(expectedStmtCounts) {
+		t.Errorf("statement lines - received: %v - expected: %v", stmtCounts, expectedStmtCounts)
+	}
+
+	var branchCounts []int64
+	for _, branch := range files[0].Branches {
+		branchCounts = append(branchCounts, branch.Count)
+	}
+	expectedBranchCounts := []int64{2, 2, 2, 0, 2}
+	if len(branchCounts) != len(expectedBranchCounts) {
+		t.Fatalf("branch counts - received: %v - expected: %v", branchCounts, expectedBranchCounts)
+	}
+	for i := range branchCounts {
+		if branchCounts[i] != expectedBranchCounts[i] {
+			t.Fatalf("branch counts - received: %v - expected: %v", branchCounts, expectedBranchCounts)
+		}
+	}
+
+	if percent := coverage.Percent(); percent != 80 {
+		t.Errorf("Percent - received: %v - expected: %v", percent, 80)
+	}
+
+	var buffer bytes.Buffer
+	err = coverage.WriteProfile(&buffer)
+	if err != nil {
+		t.Fatal("WriteProfile error:", err)
+	}
+	for _, expected := range []string{"mode: count\n", "script.ank:1.1,1.6 1 2\n", "script.ank:11.3,11.9 1 0\n"} {
+		if !strings.Contains(buffer.String(), expected) {
+			t.Errorf("WriteProfile - received: %v - expected to contain: %q", buffer.String(), expected)
+		}
+	}
+
+	buffer.Reset()
+	err = coverage.WriteLCOV(&buffer)
+	if err != nil {
+		t.Fatal("WriteLCOV error:", err)
+	}
+	for _, expected := range []string{"SF:script.ank\n", "BRDA:3,0,2,2\n", "BRDA:9,1,0,0\n", "BRF:5\nBRH:4\n", "DA:11,0\n", "LF:10\nLH:8\n", "end_of_record\n"} {
+		if !strings.Contains(buffer.String(), expected) {
+			t.Errorf("WriteLCOV - received: %v - expected to contain: %q", buffer.String(), expected)
+		}
+	}
+
+	buffer.Reset()
+	err = coverage.WriteHTML(&buffer)
+	if err != nil {
+		t.Fatal("WriteHTML error:", err)
+	}
+	for _, expected := range []string{"80.0% of statements", `class="covered"`, `class="uncovered"`, `class="partial"`} {
+		if !strings.Contains(buffer.String(), expected) {
+			t.Errorf("WriteHTML - expected to contain: %q", expected)
+		}
+	}
+}
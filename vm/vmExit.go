@@ -0,0 +1,70 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// ExitError is returned by a run that was stopped by Exit, with the exit code the script asked for.
+type ExitError struct {
+	Code int
+}
+
+// Error returns the exit status as a string.
+func (e *ExitError) Error() string {
+	return fmt.Sprintf("exit status %d", e.Code)
+}
+
+// exitKey is the context key of the exit state of a run.
+type exitKey struct{}
+
+// exitState records the exit code of a run and cancels it.
+type exitState struct {
+	mutex  sync.Mutex
+	cancel context.CancelFunc
+	code   int
+	exited bool
+}
+
+// withExit returns a copy of ctx that can be stopped with Exit.
+// Runs started with the context of another run, like module imports, share the exit state of that run.
+func withExit(ctx context.Context) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Value(exitKey{}).(*exitState); ok {
+		return ctx, func() {}
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	return context.WithValue(ctx, exitKey{}, &exitState{cancel: cancel}), cancel
+}
+
+// Exit stops the run of ctx, which then returns an ExitError with code.
+// Only the first call sets the exit code. Exit does not call os.Exit,
+// it is up to the caller of the run to end the process with the code.
+// Exit does nothing if ctx is not the context of a run.
+func Exit(ctx context.Context, code int) {
+	state, ok := ctx.Value(exitKey{}).(*exitState)
+	if !ok {
+		return
+	}
+	state.mutex.Lock()
+	if !state.exited {
+		state.code = code
+		state.exited = true
+	}
+	state.mutex.Unlock()
+	state.cancel()
+}
+
+// exitError returns the ExitError of the run of ctx, nil if Exit was not called.
+func exitError(ctx context.Context) error {
+	state, ok := ctx.Value(exitKey{}).(*exitState)
+	if !ok {
+		return nil
+	}
+	state.mutex.Lock()
+	defer state.mutex.Unlock()
+	if !state.exited {
+		return nil
+	}
+	return &ExitError{Code: state.code}
+}
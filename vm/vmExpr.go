@@ -2,18 +2,14 @@ package vm
 
 import (
 	"errors"
+	"math"
+	"math/big"
 	"reflect"
 
 	"github.com/mattn/anko/ast"
 	"github.com/mattn/anko/env"
 )
 
-// Placeholder for convertReflectValueToType
-func convertReflectValueToType(value reflect.Value, targetType reflect.Type) (reflect.Value, error) {
-	// Implement the actual conversion logic here
-	return value, nil
-}
-
 // Placeholder for newError
 func newError(expr ast.Expr, err error) error {
 	return errors.New("error in expression")
@@ -52,6 +48,9 @@ func (runInfo *runInfoStruct) invokeExpr() {
 	// LiteralExpr
 	case *ast.LiteralExpr:
 		runInfo.rv = expr.Literal
+		if runInfo.options.Numeric == NumericBig {
+			runInfo.rv = literalToBig(runInfo.rv)
+		}
 
 	// ArrayExpr
 	case *ast.ArrayExpr:
@@ -89,7 +88,7 @@ func (runInfo *runInfoStruct) invokeExpr() {
 				return
 			}
 
-			runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.rv, valueType)
+			runInfo.rv, runInfo.err = runInfo.convertReflectValueToType(runInfo.rv, valueType)
 			if runInfo.err != nil {
 				runInfo.err = newStringError(expr, "cannot use type "+runInfo.rv.Type().String()+" as type "+valueType.String()+" as slice value")
 				runInfo.rv = nilValue
@@ -152,7 +151,7 @@ func (runInfo *runInfoStruct) invokeExpr() {
 			if runInfo.err != nil {
 				return
 			}
-			key, runInfo.err = convertReflectValueToType(runInfo.rv, keyType)
+			key, runInfo.err = runInfo.convertReflectValueToType(runInfo.rv, keyType)
 			if runInfo.err != nil {
 				runInfo.err = newStringError(expr, "cannot use type "+key.Type().String()+" as type "+keyType.String()+" as map key")
 				runInfo.rv = nilValue
@@ -164,7 +163,7 @@ func (runInfo *runInfoStruct) invokeExpr() {
 			if runInfo.err != nil {
 				return
 			}
-			runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.rv, valueType)
+			runInfo.rv, runInfo.err = runInfo.convertReflectValueToType(runInfo.rv, valueType)
 			if runInfo.err != nil {
 				runInfo.err = newStringError(expr, "cannot use type "+runInfo.rv.Type().String()+" as type "+valueType.String()+" as map value")
 				runInfo.rv = nilValue
@@ -215,8 +214,22 @@ func (runInfo *runInfoStruct) invokeExpr() {
 
 		switch expr.Operator {
 		case "-":
+			if n, ok := toBigNumber(runInfo.rv); ok && (runInfo.options.Numeric == NumericBig || runInfo.rv.Kind() == reflect.Ptr) {
+				switch n := n.(type) {
+				case *big.Int:
+					runInfo.rv = reflect.ValueOf(new(big.Int).Neg(n))
+				case *big.Rat:
+					runInfo.rv = reflect.ValueOf(new(big.Rat).Neg(n))
+				}
+				return
+			}
 			switch runInfo.rv.Kind() {
 			case reflect.Int64:
+				if runInfo.options.Numeric == NumericChecked && runInfo.rv.Int() == math.MinInt64 {
+					runInfo.err = newStringError(expr, "integer overflow")
+					runInfo.rv = nilValue
+					return
+				}
 				runInfo.rv = reflect.ValueOf(-runInfo.rv.Int())
 			case reflect.Int32, reflect.Int16, reflect.Int8, reflect.Int, reflect.Bool:
 				runInfo.rv = reflect.ValueOf(-toInt64(runInfo.rv))
@@ -267,6 +280,15 @@ func (runInfo *runInfoStruct) invokeExpr() {
 			return
 		}
 
+		if object, ok := runInfo.rv.Interface().(*env.Object); ok {
+			runInfo.rv, runInfo.err = object.Get(expr.Name)
+			if runInfo.err != nil {
+				runInfo.err = newError(expr, runInfo.err)
+				runInfo.rv = nilValue
+			}
+			return
+		}
+
 		value := runInfo.rv.MethodByName(expr.Name)
 		if value.IsValid() {
 			runInfo.rv = value
@@ -540,7 +562,7 @@ func (runInfo *runInfoStruct) invokeExpr() {
 		if runInfo.err != nil {
 			return
 		}
-		runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.rv, stringType)
+		runInfo.rv, runInfo.err = runInfo.convertReflectValueToType(runInfo.rv, stringType)
 		if runInfo.err != nil {
 			runInfo.rv = nilValue
 			return
@@ -548,6 +570,19 @@ func (runInfo *runInfoStruct) invokeExpr() {
 		name := runInfo.rv.String()
 		runInfo.rv = nilValue
 
+		if isModuleName(name) {
+			var module *env.Env
+			module, runInfo.err = runInfo.importModule(name)
+			if runInfo.err != nil {
+				if runInfo.err != ErrInterrupt {
+					runInfo.err = newStringError(expr, runInfo.err.Error())
+				}
+				return
+			}
+			runInfo.rv = reflect.ValueOf(module)
+			return
+		}
+
 		methods, ok := env.Packages[name]
 		if !ok {
 			runInfo.err = newStringError(expr, "package not found: "+name)
@@ -723,7 +758,7 @@ func (runInfo *runInfoStruct) invokeExpr() {
 		// chan lhs <- rhs is send
 
 		runInfo.rv = nilValue
-		rhs, runInfo.err = convertReflectValueToType(rhs, lhs.Type().Elem())
+		rhs, runInfo.err = runInfo.convertReflectValueToType(rhs, lhs.Type().Elem())
 		if runInfo.err != nil {
 			runInfo.err = newStringError(expr, "cannot use type "+rhs.Type().String()+" as type "+lhs.Type().Elem().String()+" to send to chan")
 			return
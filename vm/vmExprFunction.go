@@ -6,6 +6,7 @@ import (
 	"reflect"
 
 	"github.com/mattn/anko/ast"
+	"github.com/mattn/anko/env"
 )
 
 // funcExpr creates a function that reflect Call can use.
@@ -29,12 +30,30 @@ func (runInfo *runInfoStruct) funcExpr() {
 	// for adding env into saved function
 	envFunc := runInfo.env
 
+	var name string
+	if runInfo.options.Profiler != nil || runInfo.options.Tracer != nil {
+		name = funcExprName(funcExpr)
+	}
+
 	// create a function that can be used by reflect.MakeFunc
 	// this function is a translator that converts a function call into a vm run
 	// returns slice of reflect.Type with two values:
 	// return value of the function and error value of the run
 	runVMFunction := func(in []reflect.Value) []reflect.Value {
-		runInfo := runInfoStruct{ctx: in[0].Interface().(context.Context), options: runInfo.options, env: envFunc.NewEnv(), stmt: funcExpr.Stmt, rv: nilValue}
+		ctx := in[0].Interface().(context.Context)
+		funcEnv := envFunc
+		if overlay, ok := ctx.Value(overlayKey{}).(*env.Env); ok && overlay.IsOverlayOf(envFunc) {
+			// functions defined in the env of an overlay read and set its symbols through the overlay
+			funcEnv = overlay
+		}
+		runInfo := runInfoStruct{ctx: ctx, options: runInfo.options, env: funcEnv.NewEnv(), stmt: funcExpr.Stmt, rv: nilValue}
+		if thread := runInfo.profileThread(); thread != nil {
+			thread.enter(name, runInfo.options.profileFile(), funcExpr.Position().Line)
+			defer thread.exit()
+		}
+		if runInfo.options.Tracer != nil {
+			defer runInfo.endFunc(runInfo.startFunc(name, funcExpr))
+		}
 
 		// add Params to newEnv, except last Params
 		for i := 0; i < len(funcExpr.Params)-1; i++ {
@@ -57,11 +76,14 @@ func (runInfo *runInfoStruct) funcExpr() {
 		// run function statements
 		runInfo.runSingleStmt()
 		if runInfo.err != nil && runInfo.err != ErrReturn {
-			runInfo.err = newError(funcExpr, runInfo.err)
+			if _, ok := runInfo.err.(*Error); !ok {
+				// errors from inside the function keep the position where they happened
+				runInfo.err = newError(funcExpr, runInfo.err)
+			}
 			// return nil value and error
 			// need to do single reflect.ValueOf because nilValue is already reflect.Value of nil
-			// need to do double reflect.ValueOf of newError in order to match
-			return []reflect.Value{reflectValueNilValue, reflect.ValueOf(reflect.ValueOf(newError(funcExpr, runInfo.err)))}
+			// need to do double reflect.ValueOf of the error in order to match
+			return []reflect.Value{reflectValueNilValue, reflect.ValueOf(reflect.ValueOf(runInfo.err))}
 		}
 
 		// the reflect.ValueOf of rv is needed to work in the reflect.Value slice
@@ -78,6 +100,15 @@ func (runInfo *runInfoStruct) funcExpr() {
 	}
 }
 
+// funcExprName returns the name of the function for the profiler and the tracer,
+// function literals are named func@ followed by the line they start on.
+func funcExprName(funcExpr *ast.FuncExpr) string {
+	if funcExpr.Name != "" {
+		return funcExpr.Name
+	}
+	return fmt.Sprintf("func@%v", funcExpr.Position().Line)
+}
+
 // anonCallExpr handles ast.AnonCallExpr which calls a function anonymously
 func (runInfo *runInfoStruct) anonCallExpr() {
 	anonCallExpr := runInfo.expr.(*ast.AnonCallExpr)
@@ -98,7 +129,7 @@ func (runInfo *runInfoStruct) anonCallExpr() {
 		return
 	}
 
-	runInfo.expr = &ast.CallExpr{Func: runInfo.rv, SubExprs: anonCallExpr.SubExprs, VarArg: anonCallExpr.VarArg, Go: anonCallExpr.Go}
+	runInfo.expr = &ast.CallExpr{Func: runInfo.rv, Name: callName(anonCallExpr.Expr), SubExprs: anonCallExpr.SubExprs, VarArg: anonCallExpr.VarArg, Go: anonCallExpr.Go}
 	runInfo.expr.SetPosition(anonCallExpr.Expr.Position())
 	runInfo.invokeExpr()
 }
@@ -135,19 +166,35 @@ func (runInfo *runInfoStruct) callExpr() {
 	fType := f.Type()
 	// check if this is a runVMFunction type
 	isRunVMFunction := checkIfRunVMFunction(fType)
+	// Go functions that take a context.Context first get the context of the run, see addContextArg
+	takesContext := !isRunVMFunction && fType.NumIn() > 0 && fType.In(0) == contextType
+	if takesContext {
+		callExpr = runInfo.addContextArg(fType, callExpr)
+	}
 	// create/convert the args to the function
 	args, useCallSlice = runInfo.makeCallArgs(fType, isRunVMFunction, callExpr)
 	if runInfo.err != nil {
 		return
 	}
 
+	if runInfo.options.Tracer != nil && !isRunVMFunction && !callExpr.Go {
+		// deferred before recoverCall so the error of a panic is set when the call is traced
+		call := runInfo.startHostCall(callExpr, f)
+		defer func() { runInfo.endHostCall(call, rvs) }()
+	}
+
 	if !runInfo.options.Debug {
 		// captures panic
-		defer recoverFunc(runInfo)
+		defer recoverCall(runInfo, callExpr)
 	}
 
 	runInfo.rv = nilValue
 
+	if callExpr.Go && isRunVMFunction && runInfo.profileThread() != nil {
+		// the goroutine has its own place in the call tree of the profiler
+		args[0] = reflect.ValueOf(runInfo.profileGoroutine())
+	}
+
 	// useCallSlice lets us know to use CallSlice instead of Call because of the format of the args
 	if useCallSlice {
 		if callExpr.Go {
@@ -163,6 +210,13 @@ func (runInfo *runInfoStruct) callExpr() {
 		rvs = f.Call(args)
 	}
 
+	if takesContext && runInfo.ctx.Err() != nil {
+		// the function most likely returned because the run was canceled, so stop the run
+		runInfo.rv = nilValue
+		runInfo.err = ErrInterrupt
+		return
+	}
+
 	// TOFIX: how VM pointers/addressing work
 	// Until then, this is a work around to set pointers back to VM variables
 	// This will probably panic for some functions and/or calls that are variadic
@@ -182,6 +236,40 @@ func (runInfo *runInfoStruct) callExpr() {
 	runInfo.rv, runInfo.err = processCallReturnValues(rvs, isRunVMFunction, true)
 }
 
+// recoverCall captures a panic of the called function as the error of the run, at the position of the call.
+func recoverCall(runInfo *runInfoStruct, callExpr *ast.CallExpr) {
+	recoverInterface := recover()
+	if recoverInterface == nil {
+		return
+	}
+	switch value := recoverInterface.(type) {
+	case *Error:
+		runInfo.err = value
+	case error:
+		runInfo.err = newError(callExpr, value)
+	default:
+		runInfo.err = newStringError(callExpr, fmt.Sprint(recoverInterface))
+	}
+}
+
+// addContextArg returns callExpr with the context of the run as first argument.
+// Functions that are not variadic get it when the call has one argument fewer than the function takes.
+// Variadic functions always get it, the arguments of the call are never used as the context.
+func (runInfo *runInfoStruct) addContextArg(rt reflect.Type, callExpr *ast.CallExpr) *ast.CallExpr {
+	if !rt.IsVariadic() && (callExpr.VarArg || len(callExpr.SubExprs) != rt.NumIn()-1) {
+		return callExpr
+	}
+
+	subExprs := make([]ast.Expr, 1, len(callExpr.SubExprs)+1)
+	subExprs[0] = &ast.LiteralExpr{Literal: reflect.ValueOf(runInfo.ctx)}
+	subExprs[0].SetPosition(callExpr.Position())
+	subExprs = append(subExprs, callExpr.SubExprs...)
+
+	contextCallExpr := *callExpr
+	contextCallExpr.SubExprs = subExprs
+	return &contextCallExpr
+}
+
 // checkIfRunVMFunction checking the number and types of the reflect.Type.
 // If it matches the types for a runVMFunction this will return true, otherwise false
 func checkIfRunVMFunction(rt reflect.Type) bool {
@@ -269,7 +357,7 @@ func (runInfo *runInfoStruct) makeCallArgs(rt reflect.Type, isRunVMFunction bool
 		if isRunVMFunction {
 			args = append(args, reflect.ValueOf(runInfo.rv))
 		} else {
-			runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.rv, rt.In(indexInReal))
+			runInfo.rv, runInfo.err = runInfo.convertReflectValueToType(runInfo.rv, rt.In(indexInReal))
 			if runInfo.err != nil {
 				runInfo.err = newStringError(callExpr.SubExprs[indexExpr],
 					"function wants argument type "+rt.In(indexInReal).String()+" but received type "+runInfo.rv.Type().String())
@@ -297,7 +385,7 @@ func (runInfo *runInfoStruct) makeCallArgs(rt reflect.Type, isRunVMFunction bool
 		if isRunVMFunction {
 			args = append(args, reflect.ValueOf(runInfo.rv))
 		} else {
-			runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.rv, rt.In(indexInReal))
+			runInfo.rv, runInfo.err = runInfo.convertReflectValueToType(runInfo.rv, rt.In(indexInReal))
 			if runInfo.err != nil {
 				runInfo.err = newStringError(callExpr.SubExprs[indexExpr],
 					"function wants argument type "+rt.In(indexInReal).String()+" but received type "+runInfo.rv.Type().String())
@@ -332,7 +420,7 @@ func (runInfo *runInfoStruct) makeCallArgs(rt reflect.Type, isRunVMFunction bool
 			if isRunVMFunction {
 				args = append(args, reflect.ValueOf(runInfo.rv.Index(indexSlice)))
 			} else {
-				runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.rv.Index(indexSlice), rt.In(indexInReal))
+				runInfo.rv, runInfo.err = runInfo.convertReflectValueToType(runInfo.rv.Index(indexSlice), rt.In(indexInReal))
 				if runInfo.err != nil {
 					runInfo.err = newStringError(callExpr.SubExprs[indexExpr],
 						"function wants argument type "+rt.In(indexInReal).String()+" but received type "+runInfo.rv.Type().String())
@@ -366,7 +454,7 @@ func (runInfo *runInfoStruct) makeCallArgs(rt reflect.Type, isRunVMFunction bool
 		if isRunVMFunction {
 			args = append(args, reflect.ValueOf(runInfo.rv))
 		} else {
-			runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.rv, rt.In(indexInReal))
+			runInfo.rv, runInfo.err = runInfo.convertReflectValueToType(runInfo.rv, rt.In(indexInReal))
 			if runInfo.err != nil {
 				runInfo.err = newStringError(callExpr.SubExprs[indexExpr],
 					"function wants argument type "+rt.In(indexInReal).String()+" but received type "+runInfo.rv.Type().String())
@@ -387,7 +475,7 @@ func (runInfo *runInfoStruct) makeCallArgs(rt reflect.Type, isRunVMFunction bool
 			if runInfo.err != nil {
 				return nil, false
 			}
-			runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.rv, sliceType)
+			runInfo.rv, runInfo.err = runInfo.convertReflectValueToType(runInfo.rv, sliceType)
 			if runInfo.err != nil {
 				runInfo.err = newStringError(callExpr.SubExprs[indexExpr],
 					"function wants argument type "+rt.In(indexInReal).String()+" but received type "+runInfo.rv.Type().String())
@@ -412,7 +500,7 @@ func (runInfo *runInfoStruct) makeCallArgs(rt reflect.Type, isRunVMFunction bool
 	if runInfo.err != nil {
 		return nil, false
 	}
-	runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.rv, sliceType)
+	runInfo.rv, runInfo.err = runInfo.convertReflectValueToType(runInfo.rv, sliceType)
 	if runInfo.err != nil {
 		runInfo.err = newStringError(callExpr.SubExprs[indexExpr],
 			"function wants argument type "+rt.In(indexInReal).String()+" but received type "+runInfo.rv.Type().String())
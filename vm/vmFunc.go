@@ -0,0 +1,257 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/mattn/anko/env"
+)
+
+// Func is a function from a script, or a Go function defined in an env, that can be called from Go.
+type Func struct {
+	fn              reflect.Value
+	isRunVMFunction bool
+}
+
+// NewFunc returns a Func for the function value, which can be a reflect.Value.
+func NewFunc(value interface{}) (*Func, error) {
+	rv, ok := value.(reflect.Value)
+	if !ok {
+		rv = reflect.ValueOf(value)
+	}
+	if rv.Kind() == reflect.Interface && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Func {
+		return nil, fmt.Errorf("cannot call type %v", rv.Kind())
+	}
+	if rv.IsNil() {
+		return nil, fmt.Errorf("cannot call nil function")
+	}
+	return &Func{fn: rv, isRunVMFunction: checkIfRunVMFunction(rv.Type())}, nil
+}
+
+// GetFunc returns a Func for the function symbol in the env.
+func GetFunc(e *env.Env, symbol string) (*Func, error) {
+	rv, err := e.GetValue(symbol)
+	if err != nil {
+		return nil, err
+	}
+	return NewFunc(rv)
+}
+
+// Call calls the function with ctx and args, returning the value returned by the function.
+// Returns ErrInterrupt if ctx is canceled while a script function, or a Go function that takes a context.Context, is running.
+// Go functions that take a context.Context first get ctx when args has one argument fewer than the function takes,
+// variadic ones always get ctx.
+// Script functions that return more than one value return an []interface{}.
+// For Go functions with an error as the last return value, a non-nil error is returned as the error.
+func (f *Func) Call(ctx context.Context, args ...interface{}) (value interface{}, err error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	defer func() {
+		if recoverInterface := recover(); recoverInterface != nil {
+			value = nil
+			switch recoverValue := recoverInterface.(type) {
+			case error:
+				err = recoverValue
+			default:
+				err = fmt.Errorf("%v", recoverInterface)
+			}
+		}
+	}()
+
+	var rv reflect.Value
+	if f.isRunVMFunction {
+		rv, err = f.callRunVMFunction(ctx, args)
+	} else {
+		rv, err = f.callGoFunction(ctx, args)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !rv.IsValid() {
+		return nil, nil
+	}
+	return rv.Interface(), nil
+}
+
+// callRunVMFunction calls a function created by a script.
+func (f *Func) callRunVMFunction(ctx context.Context, args []interface{}) (reflect.Value, error) {
+	fType := f.fn.Type()
+	// first argument is always context
+	numIn := fType.NumIn() - 1
+	if (!fType.IsVariadic() && len(args) != numIn) || (fType.IsVariadic() && len(args) < numIn-1) {
+		return nilValue, fmt.Errorf("function wants %v arguments but received %v", numIn, len(args))
+	}
+
+	in := make([]reflect.Value, 0, len(args)+1)
+	in = append(in, reflect.ValueOf(ctx))
+	for i, arg := range args {
+		argValue := nilValue
+		if arg != nil {
+			argValue = reflect.ValueOf(arg)
+		}
+		if fType.IsVariadic() && i >= numIn-1 {
+			// variadic arguments are interface values
+			in = append(in, argValue)
+			continue
+		}
+		in = append(in, reflect.ValueOf(argValue))
+	}
+
+	rv, err := processCallReturnValues(f.fn.Call(in), true, true)
+	if vmError, ok := err.(*Error); ok && vmError.Message == ErrInterrupt.Error() {
+		// the function adds its position to the error, return it the same as Run does
+		return nilValue, ErrInterrupt
+	}
+	return rv, err
+}
+
+// callGoFunction calls a Go function, converting the arguments to the parameter types.
+// If the function takes a context.Context first, ctx is passed when args has one argument fewer
+// than the function takes, or always when the function is variadic.
+func (f *Func) callGoFunction(ctx context.Context, args []interface{}) (reflect.Value, error) {
+	fType := f.fn.Type()
+	numIn := fType.NumIn()
+	takesContext := numIn > 0 && fType.In(0) == contextType
+	if takesContext {
+		if fType.IsVariadic() || len(args) == numIn-1 {
+			args = append([]interface{}{ctx}, args...)
+		}
+	}
+	if (!fType.IsVariadic() && len(args) != numIn) || (fType.IsVariadic() && len(args) < numIn-1) {
+		return nilValue, fmt.Errorf("function wants %v arguments but received %v", numIn, len(args))
+	}
+
+	in := make([]reflect.Value, len(args))
+	for i, arg := range args {
+		var inType reflect.Type
+		if fType.IsVariadic() && i >= numIn-1 {
+			inType = fType.In(numIn - 1).Elem()
+		} else {
+			inType = fType.In(i)
+		}
+		if arg == nil {
+			in[i] = reflect.Zero(inType)
+			continue
+		}
+		var err error
+		in[i], err = DefaultConverters.convert(ctx, reflect.ValueOf(arg), inType)
+		if err != nil || !in[i].Type().AssignableTo(inType) {
+			return nilValue, fmt.Errorf("function wants argument type %v but received type %T", inType, arg)
+		}
+	}
+
+	rvs := f.fn.Call(in)
+	if takesContext && ctx.Err() != nil {
+		return nilValue, ErrInterrupt
+	}
+	if len(rvs) > 0 && fType.Out(len(rvs)-1) == errorType {
+		if !rvs[len(rvs)-1].IsNil() {
+			return nilValue, rvs[len(rvs)-1].Interface().(error)
+		}
+		rvs = rvs[:len(rvs)-1]
+	}
+	return processCallReturnValues(rvs, false, true)
+}
+
+// BindFunc sets the Go function pointed to by fnPtr to call the function symbol in the env.
+// For example, with var onEvent func(string, int) (bool, error), BindFunc(e, "onEvent", &onEvent).
+// Arguments are passed to the function and the returned value is converted to the return types.
+// If the first parameter is a context.Context it is passed on as the context of the call.
+// If the last return type is error it returns the error of the call, otherwise the error panics.
+// A script function that returns more than one value is converted to the return types in order.
+func BindFunc(e *env.Env, symbol string, fnPtr interface{}) error {
+	ptr := reflect.ValueOf(fnPtr)
+	if ptr.Kind() != reflect.Ptr || ptr.IsNil() || ptr.Elem().Kind() != reflect.Func {
+		return fmt.Errorf("BindFunc wants a pointer to a function but received type %T", fnPtr)
+	}
+
+	f, err := GetFunc(e, symbol)
+	if err != nil {
+		return err
+	}
+
+	fnType := ptr.Elem().Type()
+	hasContext := fnType.NumIn() > 0 && fnType.In(0) == contextType
+	numOut := fnType.NumOut()
+	hasError := numOut > 0 && fnType.Out(numOut-1) == errorType
+	if hasError {
+		numOut--
+	}
+
+	ptr.Elem().Set(reflect.MakeFunc(fnType, func(in []reflect.Value) []reflect.Value {
+		ctx := context.Background()
+		if hasContext {
+			if !in[0].IsNil() {
+				ctx = in[0].Interface().(context.Context)
+			}
+			in = in[1:]
+		}
+
+		args := make([]interface{}, 0, len(in))
+		for i, arg := range in {
+			if fnType.IsVariadic() && i == len(in)-1 {
+				for j := 0; j < arg.Len(); j++ {
+					args = append(args, arg.Index(j).Interface())
+				}
+				break
+			}
+			args = append(args, arg.Interface())
+		}
+
+		value, err := f.Call(ctx, args...)
+		var out []reflect.Value
+		if err == nil {
+			out, err = bindFuncReturnValues(fnType, numOut, value)
+		}
+		if err != nil {
+			if !hasError {
+				panic(err)
+			}
+			out = make([]reflect.Value, numOut, numOut+1)
+			for i := 0; i < numOut; i++ {
+				out[i] = reflect.Zero(fnType.Out(i))
+			}
+			return append(out, reflect.ValueOf(&err).Elem())
+		}
+		if hasError {
+			out = append(out, reflect.Zero(errorType))
+		}
+		return out
+	}))
+
+	return nil
+}
+
+// bindFuncReturnValues converts the value returned by a function to the return types of a bound function.
+func bindFuncReturnValues(fnType reflect.Type, numOut int, value interface{}) ([]reflect.Value, error) {
+	values := []interface{}{value}
+	if numOut > 1 {
+		var ok bool
+		values, ok = value.([]interface{})
+		if !ok || len(values) != numOut {
+			return nil, fmt.Errorf("function wants %v return values but received %v", numOut, value)
+		}
+	}
+
+	out := make([]reflect.Value, numOut)
+	for i := 0; i < numOut; i++ {
+		outType := fnType.Out(i)
+		if values[i] == nil {
+			out[i] = reflect.Zero(outType)
+			continue
+		}
+		rv, err := convertReflectValueToType(reflect.ValueOf(values[i]), outType)
+		if err != nil || !rv.Type().AssignableTo(outType) {
+			return nil, fmt.Errorf("function wants return type %v but received type %T", outType, values[i])
+		}
+		out[i] = reflect.New(outType).Elem()
+		out[i].Set(rv)
+	}
+	return out, nil
+}
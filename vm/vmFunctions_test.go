@@ -2,12 +2,14 @@ package vm
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"reflect"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/mattn/anko/ast"
 	"github.com/mattn/anko/env"
 )
 
@@ -861,3 +863,549 @@ waitGroup.Wait()`,
 
 	runTests(t, tests, nil, &Options{Debug: true})
 }
+
+func TestFuncCall(t *testing.T) {
+	t.Parallel()
+
+	e := env.NewEnv()
+	err := e.Define("goAdd", func(a int, b int) int { return a + b })
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+	err = e.Define("goError", func(a string) (string, error) { return "", fmt.Errorf("go error: %v", a) })
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+	_, err = Execute(e, nil, `
+func add(a, b) { return a + b }
+func sum(a...) { s = 0; for v in a { s += v }; return s }
+func pair(a) { return a, a + 1 }
+func fail(a) { throw "script error " + a }
+func wait() { for { } }
+notFunc = 1
+`)
+	if err != nil {
+		t.Fatal("Execute error:", err)
+	}
+
+	tests := []struct {
+		symbol    string
+		args      []interface{}
+		runOutput interface{}
+		runError  error
+	}{
+		{symbol: "add", args: []interface{}{int64(1), int64(2)}, runOutput: int64(3)},
+		{symbol: "add", args: []interface{}{"a", "b"}, runOutput: "ab"},
+		{symbol: "add", args: []interface{}{int64(1)}, runError: fmt.Errorf("function wants 2 arguments but received 1")},
+		{symbol: "sum", runOutput: int64(0)},
+		{symbol: "sum", args: []interface{}{int64(1), int64(2), int64(3)}, runOutput: int64(6)},
+		{symbol: "pair", args: []interface{}{int64(1)}, runOutput: []interface{}{int64(1), int64(2)}},
+		{symbol: "fail", args: []interface{}{"a"}, runError: fmt.Errorf("script error a")},
+		{symbol: "goAdd", args: []interface{}{int64(1), int64(2)}, runOutput: 3},
+		{symbol: "goAdd", args: []interface{}{"a", int64(2)}, runError: fmt.Errorf("function wants argument type int but received type string")},
+		{symbol: "goError", args: []interface{}{"a"}, runError: fmt.Errorf("go error: a")},
+	}
+	for _, test := range tests {
+		f, err := GetFunc(e, test.symbol)
+		if err != nil {
+			t.Errorf("GetFunc error - symbol: %v - received: %v - expected: %v", test.symbol, err, nil)
+			continue
+		}
+		value, err := f.Call(context.Background(), test.args...)
+		if err != nil && test.runError != nil {
+			if err.Error() != test.runError.Error() {
+				t.Errorf("Call error - symbol: %v - received: %v - expected: %v", test.symbol, err, test.runError)
+			}
+			continue
+		} else if err != test.runError {
+			t.Errorf("Call error - symbol: %v - received: %v - expected: %v", test.symbol, err, test.runError)
+			continue
+		}
+		if !reflect.DeepEqual(value, test.runOutput) {
+			t.Errorf("Call value - symbol: %v - received: %#v - expected: %#v", test.symbol, value, test.runOutput)
+		}
+	}
+
+	_, err = GetFunc(e, "notFunc")
+	if err == nil || err.Error() != "cannot call type int64" {
+		t.Errorf("GetFunc error - received: %v - expected: %v", err, "cannot call type int64")
+	}
+
+	f, err := GetFunc(e, "wait")
+	if err != nil {
+		t.Fatal("GetFunc error:", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = f.Call(ctx)
+	if err != ErrInterrupt {
+		t.Errorf("Call error - received: %v - expected: %v", err, ErrInterrupt)
+	}
+}
+
+func TestBindFunc(t *testing.T) {
+	t.Parallel()
+
+	e := env.NewEnv()
+	_, err := Execute(e, nil, `
+func onEvent(name, count) { if count < 0 { throw "negative count" }; return len(name) == count }
+func split(a) { return a, toString(a) }
+func none() { }
+func toString(a) { return "" + a }
+`)
+	if err != nil {
+		t.Fatal("Execute error:", err)
+	}
+
+	var onEvent func(string, int) (bool, error)
+	err = BindFunc(e, "onEvent", &onEvent)
+	if err != nil {
+		t.Fatal("BindFunc error:", err)
+	}
+	value, err := onEvent("abc", 3)
+	if err != nil || value != true {
+		t.Errorf("onEvent - received: %v, %v - expected: %v, %v", value, err, true, nil)
+	}
+	value, err = onEvent("abc", -1)
+	if err == nil || err.Error() != "negative count" || value != false {
+		t.Errorf("onEvent - received: %v, %v - expected: %v, %v", value, err, false, "negative count")
+	}
+
+	var onEventContext func(context.Context, string, int) bool
+	err = BindFunc(e, "onEvent", &onEventContext)
+	if err != nil {
+		t.Fatal("BindFunc error:", err)
+	}
+	if !onEventContext(context.Background(), "ab", 2) {
+		t.Errorf("onEventContext - received: %v - expected: %v", false, true)
+	}
+
+	var split func(int64) (int, string, error)
+	err = BindFunc(e, "split", &split)
+	if err != nil {
+		t.Fatal("BindFunc error:", err)
+	}
+	number, text, err := split(5)
+	if err != nil || number != 5 || text != "5" {
+		t.Errorf("split - received: %v, %v, %v - expected: %v, %v, %v", number, text, err, 5, "5", nil)
+	}
+
+	var splitWrong func(int64) (bool, error)
+	err = BindFunc(e, "toString", &splitWrong)
+	if err != nil {
+		t.Fatal("BindFunc error:", err)
+	}
+	_, err = splitWrong(5)
+	if err == nil || err.Error() != "function wants return type bool but received type string" {
+		t.Errorf("splitWrong error - received: %v - expected: %v", err, "function wants return type bool but received type string")
+	}
+
+	var none func()
+	err = BindFunc(e, "none", &none)
+	if err != nil {
+		t.Fatal("BindFunc error:", err)
+	}
+	none()
+
+	err = BindFunc(e, "none", none)
+	if err == nil || err.Error() != "BindFunc wants a pointer to a function but received type func()" {
+		t.Errorf("BindFunc error - received: %v - expected: %v", err, "BindFunc wants a pointer to a function but received type func()")
+	}
+	err = BindFunc(e, "missing", &none)
+	if err == nil || err.Error() != "undefined symbol 'missing'" {
+		t.Errorf("BindFunc error - received: %v - expected: %v", err, "undefined symbol 'missing'")
+	}
+}
+
+func TestContextArgument(t *testing.T) {
+	t.Parallel()
+
+	ctxKey := struct{ name string }{name: "key"}
+	ctx := context.WithValue(context.Background(), ctxKey, "run")
+	value := func(ctx context.Context) interface{} { return ctx.Value(ctxKey) }
+	sum := func(ctx context.Context, a int64, b int64) int64 { return a + b }
+	count := func(ctx context.Context, a ...string) int { return len(a) }
+	format := func(ctx context.Context, format string, a ...interface{}) string { return fmt.Sprintf(format, a...) }
+	otherCtx := context.WithValue(context.Background(), ctxKey, "other")
+
+	tests := []Test{
+		{Script: `a()`, Input: map[string]interface{}{"a": value}, RunOutput: "run"},
+		{Script: `a(b)`, Input: map[string]interface{}{"a": value, "b": otherCtx}, RunOutput: "other"},
+		{Script: `a(1, 2)`, Input: map[string]interface{}{"a": sum}, RunOutput: int64(3)},
+		{Script: `a(b, 1, 2)`, Input: map[string]interface{}{"a": sum, "b": otherCtx}, RunOutput: int64(3)},
+		{Script: `a(1)`, Input: map[string]interface{}{"a": sum}, RunError: fmt.Errorf("function wants 3 arguments but received 1")},
+		{Script: `a()`, Input: map[string]interface{}{"a": count}, RunOutput: 0},
+		{Script: `a("a", "b")`, Input: map[string]interface{}{"a": count}, RunOutput: 2},
+		{Script: `a(b, "a")`, Input: map[string]interface{}{"a": count, "b": otherCtx}, RunError: fmt.Errorf("function wants argument type []string but received type *context.valueCtx")},
+		{Script: `a(b)`, Input: map[string]interface{}{"a": format, "b": otherCtx}, RunError: fmt.Errorf("function wants argument type string but received type *context.valueCtx")},
+		{Script: `a("%v", b) == c`, Input: map[string]interface{}{"a": format, "b": otherCtx, "c": fmt.Sprintf("%v", otherCtx)}, RunOutput: true},
+		{Script: `a(["a", "b"]...)`, Input: map[string]interface{}{"a": count}, RunOutput: 2},
+		{Script: `a("%v-%v", 1, 2)`, Input: map[string]interface{}{"a": format}, RunOutput: "1-2"},
+		{Script: `b = 0; a("%v", b++)`, Input: map[string]interface{}{"a": format}, RunOutput: "1", Output: map[string]interface{}{"b": int64(1)}},
+	}
+	for _, test := range tests {
+		e := env.NewEnv()
+		for symbol, value := range test.Input {
+			err := e.Define(symbol, value)
+			if err != nil {
+				t.Fatal("Define error:", err)
+			}
+		}
+		value, err := ExecuteContext(ctx, e, &Options{Debug: true}, test.Script)
+		if (err == nil) != (test.RunError == nil) || (err != nil && err.Error() != test.RunError.Error()) {
+			t.Errorf("Execute error - received: %v - expected: %v - script: %v", err, test.RunError, test.Script)
+			continue
+		}
+		if err == nil && value != test.RunOutput {
+			t.Errorf("Execute value - received: %#v - expected: %#v - script: %v", value, test.RunOutput, test.Script)
+		}
+		for symbol, expected := range test.Output {
+			value, err := e.Get(symbol)
+			if err != nil || value != expected {
+				t.Errorf("Get - received: %#v, %v - expected: %#v - script: %v", value, err, expected, test.Script)
+			}
+		}
+	}
+}
+
+func TestStreams(t *testing.T) {
+	t.Parallel()
+
+	e := env.NewEnv()
+	err := e.Define("write", func(ctx context.Context, s string) {
+		fmt.Fprint(Stdout(ctx), s)
+		fmt.Fprint(Stderr(ctx), len(s))
+	})
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+	err = e.Define("read", func(ctx context.Context) string {
+		b := make([]byte, 3)
+		n, _ := Stdin(ctx).Read(b)
+		return string(b[:n])
+	})
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+	_, err = Execute(e, nil, "func echo(s) { write(read() + s) }")
+	if err != nil {
+		t.Fatal("Execute error:", err)
+	}
+
+	var waitGroup sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		waitGroup.Add(1)
+		go func(i int) {
+			defer waitGroup.Done()
+			var stdout, stderr bytes.Buffer
+			options := &Options{Stdin: bytes.NewBufferString("in-"), Stdout: &stdout, Stderr: &stderr}
+			_, err := Execute(e.NewEnv(), options, fmt.Sprintf("echo(%q)", fmt.Sprint("run", i)))
+			if err != nil {
+				t.Errorf("Execute error - received: %v - expected: %v", err, nil)
+			}
+			expected := fmt.Sprint("in-run", i)
+			if stdout.String() != expected {
+				t.Errorf("Stdout - received: %v - expected: %v", stdout.String(), expected)
+			}
+			if stderr.String() != fmt.Sprint(len(expected)) {
+				t.Errorf("Stderr - received: %v - expected: %v", stderr.String(), len(expected))
+			}
+		}(i)
+	}
+	waitGroup.Wait()
+
+	var stdout, stderr bytes.Buffer
+	ctx := WithStreams(context.Background(), nil, &stdout, &stderr)
+	_, err = ExecuteContext(ctx, e, &Options{Stdin: bytes.NewBufferString("ctx")}, `write(read())`)
+	if err != nil {
+		t.Fatal("Execute error:", err)
+	}
+	if stdout.String() != "ctx" {
+		t.Errorf("Stdout - received: %v - expected: %v", stdout.String(), "ctx")
+	}
+}
+
+func TestContextValue(t *testing.T) {
+	t.Parallel()
+
+	type contextKey string
+	ctx := context.WithValue(context.Background(), contextKey("user"), "user")
+	ctx = context.WithValue(ctx, contextKey("secret"), "secret")
+
+	e := env.NewEnv()
+	err := e.Define("value", func(ctx context.Context, name string) interface{} {
+		return ContextValue(ctx, name)
+	})
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+
+	tests := []struct {
+		ctx      context.Context
+		keys     map[string]interface{}
+		script   string
+		expected interface{}
+	}{
+		{ctx: ctx, script: `value("user")`, expected: nil},
+		{ctx: ctx, keys: map[string]interface{}{"user": contextKey("user")}, script: `value("user")`, expected: "user"},
+		{ctx: ctx, keys: map[string]interface{}{"user": contextKey("user")}, script: `value("secret")`, expected: nil},
+		{ctx: ctx, keys: map[string]interface{}{"name": contextKey("user")}, script: `value("name")`, expected: "user"},
+		{ctx: WithContextKeys(ctx, map[string]interface{}{"secret": contextKey("secret")}), keys: map[string]interface{}{"user": contextKey("user")}, script: `value("secret") + value("user")`, expected: "secretuser"},
+		{ctx: ctx, keys: map[string]interface{}{"missing": contextKey("missing")}, script: `value("missing")`, expected: nil},
+	}
+	for _, test := range tests {
+		value, err := ExecuteContext(test.ctx, e, &Options{Debug: true, ContextKeys: test.keys}, test.script)
+		if err != nil {
+			t.Errorf("Execute error - received: %v - expected: %v - script: %v", err, nil, test.script)
+			continue
+		}
+		if value != test.expected {
+			t.Errorf("Execute value - received: %#v - expected: %#v - script: %v", value, test.expected, test.script)
+		}
+	}
+}
+
+func TestContextInterrupt(t *testing.T) {
+	t.Parallel()
+
+	wait := func(ctx context.Context, a ...interface{}) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+
+	e := env.NewEnv()
+	err := e.Define("wait", wait)
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+
+	scripts := []string{
+		`wait(); a = 1`,
+		`b = wait() == nil; a = 1`,
+		`nil |> wait; a = 1`,
+		`func f() { wait() }; f(); a = 1`,
+	}
+	for _, script := range scripts {
+		ctx, cancel := context.WithCancel(context.Background())
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			cancel()
+		}()
+		scriptEnv := e.NewEnv()
+		_, err = ExecuteContext(ctx, scriptEnv, nil, script)
+		if err == nil || err.Error() != ErrInterrupt.Error() {
+			t.Errorf("Execute error - received: %v - expected: %v - script: %v", err, ErrInterrupt, script)
+		}
+		_, err = scriptEnv.Get("a")
+		if err == nil {
+			t.Errorf("Get error - received: %v - expected: %v - script: %v", err, "undefined symbol 'a'", script)
+		}
+	}
+
+	f, err := NewFunc(wait)
+	if err != nil {
+		t.Fatal("NewFunc error:", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = f.Call(ctx)
+	if err != ErrInterrupt {
+		t.Errorf("Call error - received: %v - expected: %v", err, ErrInterrupt)
+	}
+
+	type contextKey string
+	f, err = NewFunc(func(ctx context.Context, a ...string) string {
+		return fmt.Sprintf("%v %v", ctx.Value(contextKey("key")), a)
+	})
+	if err != nil {
+		t.Fatal("NewFunc error:", err)
+	}
+	ctx = context.WithValue(context.Background(), contextKey("key"), "value")
+	tests := []struct {
+		args     []interface{}
+		expected string
+	}{
+		{args: nil, expected: "value []"},
+		{args: []interface{}{"a", "b"}, expected: "value [a b]"},
+	}
+	for _, test := range tests {
+		value, err := f.Call(ctx, test.args...)
+		if err != nil || value != test.expected {
+			t.Errorf("Call - received: %v, %v - expected: %v, %v - args: %v", value, err, test.expected, nil, test.args)
+		}
+	}
+
+	// arguments of variadic functions are never used as the context
+	otherCtx := context.WithValue(context.Background(), contextKey("key"), "other")
+	_, err = f.Call(ctx, otherCtx, "a")
+	if err == nil || err.Error() != "function wants argument type string but received type *context.valueCtx" {
+		t.Errorf("Call error - received: %v - expected: %v", err, "function wants argument type string but received type *context.valueCtx")
+	}
+}
+
+func TestCallbackContext(t *testing.T) {
+	t.Parallel()
+
+	e := env.NewEnv()
+	err := e.Define("each", func(items []string, f func(string)) {
+		for _, item := range items {
+			f(item)
+		}
+	})
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+	err = e.Define("write", func(ctx context.Context, s string) {
+		fmt.Fprint(Stdout(ctx), s)
+	})
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+
+	var stdout bytes.Buffer
+	_, err = Execute(e, &Options{Stdout: &stdout}, `each(["a", "b"], func(s) { write(s) })`)
+	if err != nil {
+		t.Fatal("Execute error:", err)
+	}
+	if stdout.String() != "ab" {
+		t.Errorf("Stdout - received: %v - expected: %v", stdout.String(), "ab")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+	_, err = ExecuteContext(ctx, e, nil, `each(["a"], func(s) { for { } }); a = 1`)
+	if err == nil || err.Error() != ErrInterrupt.Error() {
+		t.Errorf("Execute error - received: %v - expected: %v", err, ErrInterrupt)
+	}
+	_, err = e.Get("a")
+	if err == nil {
+		t.Errorf("Get error - received: %v - expected: %v", err, "undefined symbol 'a'")
+	}
+}
+
+func TestErrorPosition(t *testing.T) {
+	t.Parallel()
+
+	e := env.NewEnv()
+	err := e.Define("fail", func() { panic(fmt.Errorf("failed")) })
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+
+	tests := []struct {
+		script string
+		pos    ast.Position
+	}{
+		{script: "a = 1\n  fail()", pos: ast.Position{Line: 2, Column: 3}},
+		{script: "a = 1\nb = 1 + fail()", pos: ast.Position{Line: 2, Column: 9}},
+		{script: "func f() {\n\n  fail()\n}\nf()", pos: ast.Position{Line: 3, Column: 3}},
+		{script: "func f() {\n  throw \"thrown\"\n}\nf()", pos: ast.Position{Line: 2, Column: 3}},
+	}
+	for _, test := range tests {
+		_, err = Execute(e, nil, test.script)
+		vmError, ok := err.(*Error)
+		if !ok {
+			t.Errorf("Execute error - received: %#v - expected: %v - script: %v", err, "*Error", test.script)
+			continue
+		}
+		if vmError.Pos != test.pos {
+			t.Errorf("Execute error position - received: %v - expected: %v - script: %v", vmError.Pos, test.pos, test.script)
+		}
+	}
+}
+
+func TestExit(t *testing.T) {
+	t.Parallel()
+
+	exit := func(ctx context.Context, code int64) { Exit(ctx, int(code)) }
+	tests := []Test{
+		{Script: `exit(3); a = 1`, Input: map[string]interface{}{"exit": exit}, RunError: &ExitError{Code: 3}},
+		{Script: `exit(0)`, Input: map[string]interface{}{"exit": exit}, RunError: &ExitError{Code: 0}},
+		{Script: `exit(1); exit(2)`, Input: map[string]interface{}{"exit": exit}, RunError: &ExitError{Code: 1}},
+		{Script: `func f() { exit(4) }; f(); a = 1`, Input: map[string]interface{}{"exit": exit}, RunError: &ExitError{Code: 4}},
+		{Script: `try { exit(5) } catch { a = 1 }`, Input: map[string]interface{}{"exit": exit}, RunError: &ExitError{Code: 5}},
+		{Script: `for { exit(6) }`, Input: map[string]interface{}{"exit": exit}, RunError: &ExitError{Code: 6}},
+		{Script: "#!/usr/bin/env anko\n1", RunOutput: int64(1)},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+
+	// Exit outside of a run does nothing
+	Exit(context.Background(), 1)
+}
+
+type testConfig struct {
+	Name    string
+	Port    int
+	Timeout time.Duration `anko:"timeout"`
+	Start   time.Time
+	Tags    []string
+	Limits  map[string]int
+	Parent  *testConfig
+	secret  string
+}
+
+func TestConverters(t *testing.T) {
+	t.Parallel()
+
+	configName := func(config testConfig) string { return config.Name }
+	configPointer := func(config *testConfig) string { return config.Parent.Name }
+	configs := func(configs []testConfig) int { return len(configs) }
+	start, _ := time.Parse(time.RFC3339, "2020-01-02T03:04:05Z")
+
+	tests := []Test{
+		{Script: `a({"Name": "a"})`, Input: map[string]interface{}{"a": configName}, RunOutput: "a"},
+		{Script: `a({"name": "a"})`, Input: map[string]interface{}{"a": configName}, RunOutput: "a"},
+		{Script: `a({"Parent": {"name": "b"}})`, Input: map[string]interface{}{"a": configPointer}, RunOutput: "b"},
+		{Script: `a([{"name": "a"}, {"name": "b"}])`, Input: map[string]interface{}{"a": configs}, RunOutput: 2},
+		{Script: `a({"other": "a"})`, Input: map[string]interface{}{"a": configName}, RunError: fmt.Errorf("function wants argument type vm.testConfig but received type map[interface {}]interface {}")},
+		{Script: `a({"secret": "a"})`, Input: map[string]interface{}{"a": configName}, RunError: fmt.Errorf("function wants argument type vm.testConfig but received type map[interface {}]interface {}")},
+		{Script: `a({1: "a"})`, Input: map[string]interface{}{"a": configName}, RunError: fmt.Errorf("function wants argument type vm.testConfig but received type map[interface {}]interface {}")},
+		{Script: `a({"Port": "a"})`, Input: map[string]interface{}{"a": configName}, RunError: fmt.Errorf("function wants argument type vm.testConfig but received type map[interface {}]interface {}")},
+
+		{Script: `a = make(config); a.Name = "a"; a.Timeout = "1m30s"; a.Start = "2020-01-02T03:04:05Z"; a.Tags = ["a", "b"]; a.Limits = {"a": 1}; a.Parent = {"name": "b"}; a`,
+			Types:     map[string]interface{}{"config": testConfig{}},
+			RunOutput: testConfig{Name: "a", Timeout: 90 * time.Second, Start: start, Tags: []string{"a", "b"}, Limits: map[string]int{"a": 1}, Parent: &testConfig{Name: "b"}}},
+		{Script: `a = make(config); a.Timeout = 2; a.Timeout`, Types: map[string]interface{}{"config": testConfig{}}, RunOutput: time.Duration(2)},
+		{Script: `a = make(config); a.Timeout = "2"`, Types: map[string]interface{}{"config": testConfig{}}, RunError: fmt.Errorf("type string cannot be assigned to type time.Duration for struct")},
+		{Script: `a("2020-01-02")`, Input: map[string]interface{}{"a": func(t time.Time) int { return t.Day() }}, RunOutput: 2},
+		{Script: `a("2020-01-02T03:04:05+01:00")`, Input: map[string]interface{}{"a": func(t time.Time) int { return t.Hour() }}, RunOutput: 3},
+		{Script: `a([[1, 2], [3]])`, Input: map[string]interface{}{"a": func(a [][]int32) int32 { return a[0][1] + a[1][0] }}, RunOutput: int32(5)},
+		{Script: `a({"a": "b"})`, Input: map[string]interface{}{"a": func(a map[string]string) string { return a["a"] }}, RunOutput: "b"},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+
+	converters := NewConverters()
+	converters.Register(stringType, reflect.TypeOf(testConfig{}), func(rv reflect.Value, rt reflect.Type) (reflect.Value, error) {
+		return reflect.ValueOf(testConfig{Name: "string " + rv.String()}), nil
+	})
+	converters.Register(nil, reflect.TypeOf(testConfig{}), func(rv reflect.Value, rt reflect.Type) (reflect.Value, error) {
+		return reflect.ValueOf(testConfig{Name: fmt.Sprint("any ", rv.Interface())}), nil
+	})
+	converters.Register(stringType, durationType, func(rv reflect.Value, rt reflect.Type) (reflect.Value, error) {
+		return reflect.ValueOf(time.Minute), nil
+	})
+
+	tests = []Test{
+		{Script: `a("a")`, Input: map[string]interface{}{"a": configName}, RunOutput: "string a"},
+		{Script: `a(1)`, Input: map[string]interface{}{"a": configName}, RunOutput: "any 1"},
+		{Script: `a([1])`, Input: map[string]interface{}{"a": configs}, RunOutput: 1},
+		{Script: `a("1s")`, Input: map[string]interface{}{"a": func(d time.Duration) time.Duration { return d }}, RunOutput: time.Minute},
+		{Script: `a("2020-01-02")`, Input: map[string]interface{}{"a": func(t time.Time) int { return t.Day() }}, RunOutput: 2},
+	}
+	runTests(t, tests, nil, &Options{Debug: true, Converters: converters})
+
+	tests = []Test{
+		{Script: `a("a")`, Input: map[string]interface{}{"a": configName}, RunError: fmt.Errorf("function wants argument type vm.testConfig but received type string")},
+		{Script: `a("1s")`, Input: map[string]interface{}{"a": func(d time.Duration) time.Duration { return d }}, RunOutput: time.Second},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+
+	converters.Register(stringType, durationType, nil)
+	tests = []Test{
+		{Script: `a("1s")`, Input: map[string]interface{}{"a": func(d time.Duration) time.Duration { return d }}, RunOutput: time.Second},
+	}
+	runTests(t, tests, nil, &Options{Debug: true, Converters: converters})
+}
@@ -12,9 +12,19 @@ func (runInfo *runInfoStruct) invokeLetExpr() {
 
 	// IdentExpr
 	case *ast.IdentExpr:
-		if runInfo.env.SetValue(expr.Lit, runInfo.rv) != nil {
+		err := runInfo.env.SetValue(expr.Lit, runInfo.rv)
+		if err == env.ErrEnvFrozen {
+			runInfo.err = newError(expr, err)
+			runInfo.rv = nilValue
+			return
+		}
+		if err != nil {
 			runInfo.err = nil
-			runInfo.env.DefineValue(expr.Lit, runInfo.rv)
+			err = runInfo.env.DefineValue(expr.Lit, runInfo.rv)
+			if err != nil {
+				runInfo.err = newError(expr, err)
+				runInfo.rv = nilValue
+			}
 		}
 
 	// MemberExpr
@@ -40,6 +50,30 @@ func (runInfo *runInfoStruct) invokeLetExpr() {
 			return
 		}
 
+		if object, ok := runInfo.rv.Interface().(*env.Object); ok {
+			runInfo.rv, runInfo.err = object.Field(expr.Name)
+			if runInfo.err != nil {
+				if runInfo.err == env.ErrObjectReadOnly {
+					runInfo.err = newStringError(expr, "object member '"+expr.Name+"' is read-only")
+				} else {
+					runInfo.err = newError(expr, runInfo.err)
+				}
+				runInfo.rv = nilValue
+				return
+			}
+
+			value, runInfo.err = runInfo.convertReflectValueToType(value, runInfo.rv.Type())
+			if runInfo.err != nil {
+				runInfo.err = newStringError(expr, "type "+value.Type().String()+" cannot be assigned to type "+runInfo.rv.Type().String()+" for object")
+				runInfo.rv = nilValue
+				return
+			}
+
+			runInfo.rv.Set(value)
+			runInfo.notifyUpdate(expr)
+			return
+		}
+
 		if runInfo.rv.Kind() == reflect.Ptr {
 			runInfo.rv = runInfo.rv.Elem()
 		}
@@ -64,7 +98,7 @@ func (runInfo *runInfoStruct) invokeLetExpr() {
 				return
 			}
 
-			value, runInfo.err = convertReflectValueToType(value, runInfo.rv.Type())
+			value, runInfo.err = runInfo.convertReflectValueToType(value, runInfo.rv.Type())
 			if runInfo.err != nil {
 				runInfo.err = newStringError(expr, "type "+value.Type().String()+" cannot be assigned to type "+runInfo.rv.Type().String()+" for struct")
 				runInfo.rv = nilValue
@@ -72,11 +106,12 @@ func (runInfo *runInfoStruct) invokeLetExpr() {
 			}
 
 			runInfo.rv.Set(value)
+			runInfo.notifyUpdate(expr)
 			return
 
 		// Map
 		case reflect.Map:
-			value, runInfo.err = convertReflectValueToType(value, runInfo.rv.Type().Elem())
+			value, runInfo.err = runInfo.convertReflectValueToType(value, runInfo.rv.Type().Elem())
 			if runInfo.err != nil {
 				runInfo.err = newStringError(expr, "type "+value.Type().String()+" cannot be assigned to type "+runInfo.rv.Type().Elem().String()+" for map")
 				runInfo.rv = nilValue
@@ -94,6 +129,7 @@ func (runInfo *runInfoStruct) invokeLetExpr() {
 				return
 			}
 			runInfo.rv.SetMapIndex(reflect.ValueOf(expr.Name), value)
+			runInfo.notifyUpdate(expr)
 
 		default:
 			runInfo.err = newStringError(expr, "type "+runInfo.rv.Kind().String()+" does not support member operation")
@@ -135,7 +171,7 @@ func (runInfo *runInfoStruct) invokeLetExpr() {
 
 			if index == item.Len() {
 				// try to do automatic append
-				value, runInfo.err = convertReflectValueToType(value, item.Type().Elem())
+				value, runInfo.err = runInfo.convertReflectValueToType(value, item.Type().Elem())
 				if runInfo.err != nil {
 					runInfo.err = newStringError(expr, "type "+value.Type().String()+" cannot be assigned to type "+item.Type().Elem().String()+" for slice index")
 					runInfo.rv = nilValue
@@ -161,7 +197,7 @@ func (runInfo *runInfoStruct) invokeLetExpr() {
 				return
 			}
 
-			value, runInfo.err = convertReflectValueToType(value, item.Type())
+			value, runInfo.err = runInfo.convertReflectValueToType(value, item.Type())
 			if runInfo.err != nil {
 				runInfo.err = newStringError(expr, "type "+value.Type().String()+" cannot be assigned to type "+item.Type().String()+" for slice index")
 				runInfo.rv = nilValue
@@ -170,17 +206,18 @@ func (runInfo *runInfoStruct) invokeLetExpr() {
 
 			item.Set(value)
 			runInfo.rv = item
+			runInfo.notifyUpdate(expr)
 
 		// Map
 		case reflect.Map:
-			runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.rv, item.Type().Key())
+			runInfo.rv, runInfo.err = runInfo.convertReflectValueToType(runInfo.rv, item.Type().Key())
 			if runInfo.err != nil {
 				runInfo.err = newStringError(expr, "index type "+runInfo.rv.Type().String()+" cannot be used for map index type "+item.Type().Key().String())
 				runInfo.rv = nilValue
 				return
 			}
 
-			value, runInfo.err = convertReflectValueToType(value, item.Type().Elem())
+			value, runInfo.err = runInfo.convertReflectValueToType(value, item.Type().Elem())
 			if runInfo.err != nil {
 				runInfo.err = newStringError(expr, "type "+value.Type().String()+" cannot be assigned to type "+item.Type().Elem().String()+" for map")
 				runInfo.rv = nilValue
@@ -200,6 +237,7 @@ func (runInfo *runInfoStruct) invokeLetExpr() {
 				return
 			}
 			item.SetMapIndex(runInfo.rv, value)
+			runInfo.notifyUpdate(expr)
 
 		// String
 		case reflect.String:
@@ -211,7 +249,7 @@ func (runInfo *runInfoStruct) invokeLetExpr() {
 				return
 			}
 
-			value, runInfo.err = convertReflectValueToType(value, item.Type())
+			value, runInfo.err = runInfo.convertReflectValueToType(value, item.Type())
 			if runInfo.err != nil {
 				runInfo.err = newStringError(expr, "type "+value.Type().String()+" cannot be assigned to type "+item.Type().String())
 				runInfo.rv = nilValue
@@ -222,6 +260,7 @@ func (runInfo *runInfoStruct) invokeLetExpr() {
 				// automatic append
 				if item.CanSet() {
 					item.SetString(item.String() + value.String())
+					runInfo.notifyUpdate(expr)
 					return
 				}
 
@@ -240,6 +279,7 @@ func (runInfo *runInfoStruct) invokeLetExpr() {
 			if item.CanSet() {
 				item.SetString(item.Slice(0, index).String() + value.String() + item.Slice(index+1, item.Len()).String())
 				runInfo.rv = item
+				runInfo.notifyUpdate(expr)
 				return
 			}
 
@@ -350,6 +390,7 @@ func (runInfo *runInfoStruct) invokeLetExpr() {
 				return
 			}
 			item.Set(value)
+			runInfo.notifyUpdate(expr)
 
 		// String
 		case reflect.String:
@@ -380,3 +421,23 @@ func (runInfo *runInfoStruct) invokeLetExpr() {
 	}
 
 }
+
+// notifyUpdate calls the observers of the env with a change of the symbol that expr changed in place,
+// for example m in m.a[0] = 1.
+func (runInfo *runInfoStruct) notifyUpdate(expr ast.Expr) {
+	for {
+		switch value := expr.(type) {
+		case *ast.MemberExpr:
+			expr = value.Expr
+		case *ast.ItemExpr:
+			expr = value.Item
+		case *ast.SliceExpr:
+			expr = value.Item
+		case *ast.IdentExpr:
+			runInfo.env.NotifyUpdate(value.Lit)
+			return
+		default:
+			return
+		}
+	}
+}
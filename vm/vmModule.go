@@ -0,0 +1,317 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/mattn/anko/env"
+	"github.com/mattn/anko/parser"
+)
+
+// ModuleLoader loads script files imported as modules, for example import("./lib/util.ank").
+// A module runs once in a new scope and is cached by its absolute path.
+// All top-level symbols of a module are exported except the ones starting with an underscore.
+type ModuleLoader struct {
+	// FS is the file system modules are read from, nil uses the FS of the options or of the env
+	FS fs.FS
+	// Path is the list of directories searched for module names that do not start with ./ or ../
+	Path []string
+	// Dir is the directory that the main script resolves relative module names against, empty is the current directory
+	Dir string
+	// Env is the env that modules run in a new child scope of, nil uses a new env for each module
+	Env *env.Env
+
+	mutex   sync.Mutex
+	modules map[string]*env.Env
+}
+
+// NewModuleLoader creates a ModuleLoader that reads from fsys, nil being the operating system file system.
+// The search path is read from the ANKOPATH environment variable.
+func NewModuleLoader(fsys fs.FS) *ModuleLoader {
+	return &ModuleLoader{
+		FS:   fsys,
+		Path: filepath.SplitList(os.Getenv("ANKOPATH")),
+	}
+}
+
+// runModuleLoadersKey is the context key of the module loaders of a run.
+type runModuleLoadersKey struct{}
+
+// runModuleLoaders are the loaders a run without a ModuleLoader uses, one for each file system it imports modules from,
+// so a module runs once in the run.
+type runModuleLoaders struct {
+	mutex   sync.Mutex
+	loaders []*ModuleLoader
+}
+
+// withModuleLoaders returns a copy of ctx that carries the module loaders of a run, ctx if it already carries them.
+func withModuleLoaders(ctx context.Context) context.Context {
+	if _, ok := ctx.Value(runModuleLoadersKey{}).(*runModuleLoaders); ok {
+		return ctx
+	}
+	return context.WithValue(ctx, runModuleLoadersKey{}, &runModuleLoaders{})
+}
+
+// runModuleLoader returns the loader for the file system, nil being the operating system file system,
+// the same one for each import of the run.
+func runModuleLoader(ctx context.Context, fsys fs.FS) *ModuleLoader {
+	loaders, ok := ctx.Value(runModuleLoadersKey{}).(*runModuleLoaders)
+	if !ok {
+		return NewModuleLoader(fsys)
+	}
+	loaders.mutex.Lock()
+	defer loaders.mutex.Unlock()
+	for _, loader := range loaders.loaders {
+		if sameFS(loader.FS, fsys) {
+			return loader
+		}
+	}
+	loader := NewModuleLoader(fsys)
+	loaders.loaders = append(loaders.loaders, loader)
+	return loader
+}
+
+// sameFS returns true if a and b are the same file system.
+func sameFS(a fs.FS, b fs.FS) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	aType := reflect.TypeOf(a)
+	if aType != reflect.TypeOf(b) {
+		return false
+	}
+	if aType.Comparable() {
+		return a == b
+	}
+	// file systems like fstest.MapFS are maps, which can not be compared with ==
+	aValue, bValue := reflect.ValueOf(a), reflect.ValueOf(b)
+	switch aValue.Kind() {
+	case reflect.Map, reflect.Slice, reflect.Func:
+		return aValue.Pointer() == bValue.Pointer()
+	}
+	return false
+}
+
+// isModuleName returns true if the import name is a script file instead of a package.
+func isModuleName(name string) bool {
+	return strings.HasSuffix(name, ".ank") || strings.HasPrefix(name, "./") || strings.HasPrefix(name, "../") || strings.HasPrefix(name, "/")
+}
+
+// fileSystem returns the file system modules are read from, nil being the operating system file system.
+// If the loader does not have one, the file system of the run is used.
+func (loader *ModuleLoader) fileSystem(runInfo *runInfoStruct) fs.FS {
+	if loader.FS != nil {
+		return loader.FS
+	}
+	return runInfo.fileSystem()
+}
+
+// fileSystem returns the file system script files of the run are read from, the FS of the options or else of the env.
+func (runInfo *runInfoStruct) fileSystem() fs.FS {
+	if runInfo.options.FS != nil {
+		return runInfo.options.FS
+	}
+	return runInfo.env.FS()
+}
+
+// ReadFile reads the named file from the file system script files are read from by the run of ctx,
+// the FS of its options or else the FS of the env.
+func ReadFile(ctx context.Context, e *env.Env, name string) ([]byte, error) {
+	if options := ContextOptions(ctx); options != nil && options.FS != nil {
+		return env.ReadFile(options.FS, name)
+	}
+	return e.ReadFile(name)
+}
+
+// join joins file path elements using the path rules of the file system.
+func join(fsys fs.FS, elem ...string) string {
+	if fsys != nil {
+		return path.Join(elem...)
+	}
+	return filepath.Join(elem...)
+}
+
+// moduleDir returns the directory of a module file.
+func moduleDir(fsys fs.FS, file string) string {
+	if fsys != nil {
+		return path.Dir(file)
+	}
+	return filepath.Dir(file)
+}
+
+// moduleExists returns true if the file exists and is not a directory.
+func moduleExists(fsys fs.FS, file string) bool {
+	var info fs.FileInfo
+	var err error
+	if fsys != nil {
+		if !fs.ValidPath(file) {
+			return false
+		}
+		info, err = fs.Stat(fsys, file)
+	} else {
+		info, err = os.Stat(file)
+	}
+	return err == nil && !info.IsDir()
+}
+
+// resolve finds the absolute path of the module name imported from a module in dir.
+func (loader *ModuleLoader) resolve(fsys fs.FS, name string, dir string) (string, error) {
+	var candidates []string
+	switch {
+	case strings.HasPrefix(name, "./") || strings.HasPrefix(name, "../"):
+		candidates = []string{join(fsys, dir, name)}
+	case fsys == nil && filepath.IsAbs(name):
+		candidates = []string{filepath.Clean(name)}
+	case strings.HasPrefix(name, "/"):
+		candidates = []string{env.FSPath(name)}
+	default:
+		for _, searchDir := range loader.Path {
+			candidates = append(candidates, join(fsys, searchDir, name))
+		}
+		candidates = append(candidates, join(fsys, dir, name))
+	}
+
+	for _, file := range candidates {
+		if !moduleExists(fsys, file) {
+			continue
+		}
+		if fsys != nil {
+			return file, nil
+		}
+		return filepath.Abs(file)
+	}
+	return "", fmt.Errorf("module not found: %v", name)
+}
+
+// moduleFile returns the file of the module being run, empty if the run is not a module.
+func (options *Options) moduleFile() string {
+	if len(options.moduleStack) == 0 {
+		return ""
+	}
+	return options.moduleStack[len(options.moduleStack)-1]
+}
+
+// importModule returns the exports of the module name, running the module if it is not cached.
+func (runInfo *runInfoStruct) importModule(name string) (*env.Env, error) {
+	loader := runInfo.options.ModuleLoader
+	if loader == nil {
+		// modules are cached by path so each file system needs its own loader,
+		// the run keeps one for each file system and passes it on to the modules imported by the module
+		loader = runModuleLoader(runInfo.ctx, runInfo.fileSystem())
+	}
+	dir := runInfo.options.moduleDir
+	if dir == "" {
+		dir = loader.Dir
+		if dir == "" {
+			dir = "."
+		}
+	}
+
+	fsys := loader.fileSystem(runInfo)
+	file, err := loader.resolve(fsys, name, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, importing := range runInfo.options.moduleStack {
+		if importing == file {
+			return nil, fmt.Errorf("import cycle: %v", strings.Join(append(runInfo.options.moduleStack[i:], file), " -> "))
+		}
+	}
+
+	loader.mutex.Lock()
+	exports, ok := loader.modules[file]
+	loader.mutex.Unlock()
+	if ok {
+		return exports, nil
+	}
+
+	source, err := env.ReadFile(fsys, file)
+	if err != nil {
+		return nil, err
+	}
+	stmt, err := parser.ParseSrc(string(source))
+	if err != nil {
+		if parseError, ok := err.(*parser.Error); ok {
+			return nil, fmt.Errorf("%v:%v:%v: %v", file, parseError.Pos.Line, parseError.Pos.Column, parseError.Message)
+		}
+		return nil, fmt.Errorf("%v: %v", file, err)
+	}
+	if runInfo.options.Coverage != nil {
+		runInfo.options.Coverage.Add(file, source, stmt)
+	}
+
+	base := loader.Env
+	if base == nil {
+		base = env.NewEnv()
+	}
+	moduleEnv := base.NewEnv()
+	if fsys != nil {
+		// load in the module reads from the same file system
+		moduleEnv.SetFS(fsys)
+	}
+
+	options := *runInfo.options
+	options.ModuleLoader = loader
+	options.moduleDir = moduleDir(fsys, file)
+	options.moduleStack = append(runInfo.options.moduleStack[:len(runInfo.options.moduleStack):len(runInfo.options.moduleStack)], file)
+	_, err = RunContext(runInfo.ctx, moduleEnv, &options, stmt)
+	if err != nil {
+		if err == ErrInterrupt {
+			return nil, err
+		}
+		if vmError, ok := err.(*Error); ok {
+			return nil, fmt.Errorf("%v:%v:%v: %v", file, vmError.Pos.Line, vmError.Pos.Column, vmError.Message)
+		}
+		return nil, fmt.Errorf("%v: %v", file, err)
+	}
+
+	exports = base.NewEnv()
+	for _, symbol := range moduleEnv.GetValueSymbols() {
+		if strings.HasPrefix(symbol, "_") {
+			continue
+		}
+		value, err := moduleEnv.GetValue(symbol)
+		if err != nil {
+			return nil, err
+		}
+		err = exports.DefineValue(symbol, value)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, symbol := range moduleEnv.GetTypeSymbols() {
+		if strings.HasPrefix(symbol, "_") {
+			continue
+		}
+		aType, err := moduleEnv.Type(symbol)
+		if err != nil {
+			return nil, err
+		}
+		err = exports.DefineReflectType(symbol, aType)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	loader.mutex.Lock()
+	if cached, ok := loader.modules[file]; ok {
+		// another run imported the module at the same time
+		exports = cached
+	} else {
+		if loader.modules == nil {
+			loader.modules = make(map[string]*env.Env)
+		}
+		loader.modules[file] = exports
+	}
+	loader.mutex.Unlock()
+
+	return exports, nil
+}
@@ -0,0 +1,336 @@
+package vm
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"testing/fstest"
+
+	"github.com/mattn/anko/env"
+)
+
+func TestModuleImport(t *testing.T) {
+	t.Parallel()
+
+	fsys := fstest.MapFS{
+		"lib/util.ank":    {Data: []byte(`count = 0; func add(a, b) { return a + b }; func next() { count++; return count }; _hidden = 1`)},
+		"lib/uses.ank":    {Data: []byte(`util = import("./util.ank"); func add3(a, b, c) { return util.add(util.add(a, b), c) }`)},
+		"lib/parent.ank":  {Data: []byte(`main = import("../main.ank"); value = main.value`)},
+		"lib/types.ank":   {Data: []byte(`make(type pointType, make(struct { X int64, Y int64 }))`)},
+		"lib/cycle_a.ank": {Data: []byte(`import("./cycle_b.ank")`)},
+		"lib/cycle_b.ank": {Data: []byte(`import("./cycle_a.ank")`)},
+		"lib/broken.ank":  {Data: []byte(`a = 1 +`)},
+		"lib/throws.ank": {Data: []byte(`a = 1
+throw "module error"`)},
+		"lib/println.ank":  {Data: []byte(`println("module")`)},
+		"main.ank":         {Data: []byte(`value = "main"`)},
+		"search/path.ank":  {Data: []byte(`value = "search"`)},
+		"search/lib/a.ank": {Data: []byte(`value = "search lib"`)},
+		"lib/a.ank":        {Data: []byte(`value = "lib"`)},
+	}
+	loader := NewModuleLoader(fsys)
+	loader.Path = []string{"search"}
+
+	tests := []Test{
+		{Script: `import("./lib/none.ank")`, RunError: fmt.Errorf("module not found: ./lib/none.ank")},
+		{Script: `import("../main.ank")`, RunError: fmt.Errorf("module not found: ../main.ank")},
+		{Script: `import("./lib/cycle_a.ank")`, RunError: fmt.Errorf("lib/cycle_a.ank:1:1: lib/cycle_b.ank:1:1: import cycle: lib/cycle_a.ank -> lib/cycle_b.ank -> lib/cycle_a.ank")},
+		{Script: `import("./lib/broken.ank")`, RunError: fmt.Errorf("lib/broken.ank:1:8: syntax error")},
+		{Script: `import("./lib/throws.ank")`, RunError: fmt.Errorf("lib/throws.ank:2:1: module error")},
+		{Script: `import("./lib/println.ank")`, RunError: fmt.Errorf("lib/println.ank:1:1: undefined symbol 'println'")},
+
+		{Script: `util = import("./lib/util.ank"); util.add(1, 2)`, RunOutput: int64(3)},
+		{Script: `util = import("./lib/util.ank"); util._hidden`, RunError: fmt.Errorf("undefined symbol '_hidden'")},
+		{Script: `uses = import("./lib/uses.ank"); uses.add3(1, 2, 3)`, RunOutput: int64(6)},
+		{Script: `uses = import("./lib/uses.ank"); uses.util.add(1, 2)`, RunOutput: int64(3)},
+		{Script: `parent = import("./lib/parent.ank"); parent.value`, RunOutput: "main"},
+		{Script: `types = import("./lib/types.ank"); a = make(types.pointType); a.X = 1; a.X`, RunOutput: int64(1)},
+		{Script: `a = import("/lib/a.ank"); a.value`, RunOutput: "lib"},
+		{Script: `a = import("path.ank"); a.value`, RunOutput: "search"},
+		{Script: `a = import("lib/a.ank"); a.value`, RunOutput: "search lib"},
+		{Script: `a = import("./lib/a.ank"); a.value`, RunOutput: "lib"},
+	}
+	runTests(t, tests, nil, &Options{Debug: true, ModuleLoader: loader})
+
+	// module runs once
+	tests = []Test{
+		{Script: `util = import("./lib/util.ank"); util.next()`, RunOutput: int64(1)},
+		{Script: `util = import("./lib/util.ank"); util.next()`, RunOutput: int64(2)},
+		{Script: `util = import("./lib/util.ank"); uses = import("./lib/uses.ank"); uses.util == util`, RunOutput: true},
+	}
+	runTests(t, tests, nil, &Options{Debug: true, ModuleLoader: NewModuleLoader(fsys)})
+
+	// modules run in a child scope of the loader env
+	loaderEnv := env.NewEnv()
+	err := loaderEnv.Define("println", func(a ...interface{}) string { return fmt.Sprint(a...) })
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+	loader = NewModuleLoader(fsys)
+	loader.Env = loaderEnv
+	_, err = Execute(env.NewEnv(), &Options{Debug: true, ModuleLoader: loader}, `import("./lib/println.ank")`)
+	if err != nil {
+		t.Errorf("Execute error - received: %v - expected: %v", err, nil)
+	}
+}
+
+func TestModuleImportOS(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "anko")
+	if err != nil {
+		t.Fatal("TempDir error:", err)
+	}
+	defer os.RemoveAll(dir)
+
+	err = os.MkdirAll(filepath.Join(dir, "lib"), 0755)
+	if err != nil {
+		t.Fatal("MkdirAll error:", err)
+	}
+	err = ioutil.WriteFile(filepath.Join(dir, "lib", "util.ank"), []byte(`func add(a, b) { return a + b }`), 0644)
+	if err != nil {
+		t.Fatal("WriteFile error:", err)
+	}
+	err = ioutil.WriteFile(filepath.Join(dir, "main.ank"), []byte(`util = import("./lib/util.ank"); value = util.add(1, 2)`), 0644)
+	if err != nil {
+		t.Fatal("WriteFile error:", err)
+	}
+
+	loader := NewModuleLoader(nil)
+	loader.Dir = dir
+	loader.Path = []string{filepath.Join(dir, "lib")}
+
+	tests := []Test{
+		{Script: `main = import("./main.ank"); main.value`, RunOutput: int64(3)},
+		{Script: `util = import("util.ank"); util.add(2, 3)`, RunOutput: int64(5)},
+		{Script: `util = import(a); util.add(3, 4)`, Input: map[string]interface{}{"a": filepath.Join(dir, "lib", "util.ank")}, RunOutput: int64(7)},
+	}
+	runTests(t, tests, nil, &Options{Debug: true, ModuleLoader: loader})
+
+	// without a ModuleLoader modules are cached for the run
+	err = ioutil.WriteFile(filepath.Join(dir, "lib", "state.ank"), []byte(`state = {}`), 0644)
+	if err != nil {
+		t.Fatal("WriteFile error:", err)
+	}
+	input := map[string]interface{}{"file": filepath.Join(dir, "lib", "state.ank")}
+	tests = []Test{
+		{Script: `a = import(file); a.state.n = 1; b = import(file); b.state.n`, Input: input, RunOutput: int64(1)},
+		{Script: `a = import(file); a.state.n`, Input: input, RunOutput: nil},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
+func TestModuleImportFS(t *testing.T) {
+	t.Parallel()
+
+	fsys := fstest.MapFS{
+		"lib/util.ank":  {Data: []byte(`func add(a, b) { return a + b }`)},
+		"lib/uses.ank":  {Data: []byte(`util = import("./util.ank"); value = util.add(1, 2)`)},
+		"lib/state.ank": {Data: []byte(`state = {}`)},
+	}
+
+	// file system of the options
+	tests := []Test{
+		{Script: `uses = import("./lib/uses.ank"); uses.value`, RunOutput: int64(3)},
+		{Script: `util = import("/lib/util.ank"); util.add(2, 3)`, RunOutput: int64(5)},
+		{Script: `a = import("./lib/state.ank"); a.state.n = 1; b = import("/lib/state.ank"); b.state.n`, RunOutput: int64(1)},
+	}
+	runTests(t, tests, nil, &Options{Debug: true, FS: fsys})
+
+	// file system of the env
+	e := env.NewEnv()
+	e.SetFS(fsys)
+	value, err := Execute(e.NewEnv(), &Options{Debug: true}, `uses = import("./lib/uses.ank"); uses.value`)
+	if err != nil {
+		t.Errorf("Execute error - received: %v - expected: %v", err, nil)
+	}
+	if value != int64(3) {
+		t.Errorf("Execute value - received: %#v - expected: %#v", value, int64(3))
+	}
+
+	// loader FS is used before the options FS
+	loader := NewModuleLoader(fstest.MapFS{"lib/util.ank": {Data: []byte(`func add(a, b) { return a - b }`)}})
+	tests = []Test{
+		{Script: `util = import("./lib/util.ank"); util.add(2, 3)`, RunOutput: int64(-1)},
+		{Script: `uses = import("./lib/uses.ank")`, RunError: fmt.Errorf("module not found: ./lib/uses.ank")},
+	}
+	runTests(t, tests, nil, &Options{Debug: true, FS: fsys, ModuleLoader: loader})
+}
+
+func TestSnapshotScript(t *testing.T) {
+	t.Parallel()
+
+	e := env.NewEnv()
+	_, err := Execute(e, &Options{Debug: true}, `
+make(type point, make(struct { X int64, Y int64 }))
+p = make(point)
+p.X = 1
+p.Y = 2
+counts = {"a": 1, "b": [2, 3]}
+module config { name = "config"; port = 80 }
+func add(a, b) { return a + b }
+`)
+	if err != nil {
+		t.Fatal("Execute error:", err)
+	}
+
+	_, err = e.Snapshot(nil)
+	if err == nil || err.Error() != "cannot snapshot symbol 'add': type func(context.Context, reflect.Value, reflect.Value) (reflect.Value, reflect.Value) is not supported" {
+		t.Errorf("Snapshot error - received: %v - expected: %v", err, "cannot snapshot symbol 'add'")
+	}
+
+	snapshot, err := e.Snapshot(&env.SnapshotOptions{SkipUnsupported: true})
+	if err != nil {
+		t.Fatal("Snapshot error:", err)
+	}
+	jsonBytes, err := json.Marshal(snapshot)
+	if err != nil {
+		t.Fatal("Marshal error:", err)
+	}
+	snapshot = &env.Snapshot{}
+	err = json.Unmarshal(jsonBytes, snapshot)
+	if err != nil {
+		t.Fatal("Unmarshal error:", err)
+	}
+	e, err = env.NewEnvFromSnapshot(snapshot)
+	if err != nil {
+		t.Fatal("NewEnvFromSnapshot error:", err)
+	}
+
+	tests := []Test{
+		{Script: `p.X + p.Y`, RunOutput: int64(3)},
+		{Script: `q = make(point); q.X = 3; q.X`, RunOutput: int64(3)},
+		{Script: `counts.a + counts.b[1]`, RunOutput: int64(4)},
+		{Script: `config.name + toString(config.port)`, Input: map[string]interface{}{"toString": func(a int64) string { return fmt.Sprint(a) }}, RunOutput: "config80"},
+		{Script: `add(1, 2)`, RunError: fmt.Errorf("undefined symbol 'add'")},
+	}
+	for _, test := range tests {
+		testEnv := e.NewEnv()
+		for symbol, value := range test.Input {
+			err = testEnv.Define(symbol, value)
+			if err != nil {
+				t.Fatal("Define error:", err)
+			}
+		}
+		value, err := Execute(testEnv, &Options{Debug: true}, test.Script)
+		if err != nil && test.RunError != nil {
+			if err.Error() != test.RunError.Error() {
+				t.Errorf("Execute error - script: %v - received: %v - expected: %v", test.Script, err, test.RunError)
+			}
+			continue
+		} else if err != test.RunError {
+			t.Errorf("Execute error - script: %v - received: %v - expected: %v", test.Script, err, test.RunError)
+			continue
+		}
+		if value != test.RunOutput {
+			t.Errorf("Execute value - script: %v - received: %#v - expected: %#v", test.Script, value, test.RunOutput)
+		}
+	}
+}
+
+func TestOverlayScript(t *testing.T) {
+	t.Parallel()
+
+	e := env.NewEnv()
+	_, err := Execute(e, &Options{Debug: true}, `
+module config { name = "config"; port = 80; func setPort(port) { config.port = port } }
+count = 1
+func add(a, b) { return a + b }
+n = 0
+func inc() { n++; return n }
+`)
+	if err != nil {
+		t.Fatal("Execute error:", err)
+	}
+	e.Freeze()
+
+	for _, script := range []string{"count = 2", "config.port = 81"} {
+		_, err = Execute(e, nil, script)
+		if err == nil || err.Error() != "env is frozen" {
+			t.Errorf("Execute error - received: %v - expected: %v - script: %v", err, "env is frozen", script)
+		}
+	}
+
+	for i := 0; i < 3; i++ {
+		overlay := e.NewOverlay()
+		value, err := Execute(overlay, nil, "count = add(count, 1); b = count; b")
+		if err != nil {
+			t.Errorf("Execute error - received: %v - expected: %v", err, nil)
+		}
+		if value != int64(2) {
+			t.Errorf("Execute value - received: %v - expected: %v", value, int64(2))
+		}
+	}
+
+	// functions of the env set its symbols in the overlay, functions of its modules can not set them
+	for i := 0; i < 3; i++ {
+		overlay := e.NewOverlay()
+		value, err := Execute(overlay, nil, "inc(); inc()")
+		if err != nil || value != int64(2) {
+			t.Errorf("Execute - received: %v, %v - expected: %v, %v", value, err, int64(2), nil)
+		}
+		_, err = Execute(overlay, nil, "config.setPort(81)")
+		if err == nil || err.Error() != "env is frozen" {
+			t.Errorf("Execute error - received: %v - expected: %v", err, "env is frozen")
+		}
+	}
+
+	value, err := e.Get("count")
+	if err != nil || value != int64(1) {
+		t.Errorf("Get - received: %v, %v - expected: %v, %v", value, err, int64(1), nil)
+	}
+	value, err = e.Get("n")
+	if err != nil || value != int64(0) {
+		t.Errorf("Get - received: %v, %v - expected: %v, %v", value, err, int64(0), nil)
+	}
+	_, err = e.Get("b")
+	if err == nil {
+		t.Errorf("Get error - received: %v - expected: %v", err, "undefined symbol 'b'")
+	}
+}
+
+func TestObserverScript(t *testing.T) {
+	t.Parallel()
+
+	type point struct {
+		X int64
+	}
+	e := env.NewEnv()
+	err := e.Define("p", &point{})
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+	_, err = Execute(e, &Options{Debug: true}, `m = {"a": {}}; l = [1, 2]`)
+	if err != nil {
+		t.Fatal("Execute error:", err)
+	}
+
+	before := e.CopyValues()
+	var changes []string
+	remove := e.AddObserver(func(change env.Change) {
+		changes = append(changes, change.Kind.String()+" "+change.Symbol)
+	})
+	defer remove()
+	_, err = Execute(e, &Options{Debug: true}, `m["k"] = 1; m.a.b = 2; l[0] = 3; p.X = 4; l[2] = 5; x = 1`)
+	if err != nil {
+		t.Fatal("Execute error:", err)
+	}
+
+	expected := []string{"update m", "update m", "update l", "update p", "set l", "define x"}
+	if !reflect.DeepEqual(changes, expected) {
+		t.Errorf("changes - received: %v - expected: %v", changes, expected)
+	}
+
+	changes = nil
+	for _, change := range env.Diff(before, e) {
+		changes = append(changes, change.Kind.String()+" "+change.Symbol)
+	}
+	expected = []string{"set l", "set m", "define x"}
+	if !reflect.DeepEqual(changes, expected) {
+		t.Errorf("Diff - received: %v - expected: %v", changes, expected)
+	}
+}
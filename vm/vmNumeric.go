@@ -0,0 +1,342 @@
+package vm
+
+import (
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+
+	"github.com/mattn/anko/ast"
+)
+
+// NumericMode selects how the VM does arithmetic on numbers.
+type NumericMode int
+
+const (
+	// NumericDefault uses int64 and float64, integer overflow wraps around.
+	NumericDefault NumericMode = iota
+	// NumericChecked uses int64 and float64, integer overflow from + - * << and unary minus is a runtime error.
+	NumericChecked
+	// NumericBig uses *big.Int for integers and *big.Rat for all other numbers.
+	// Number literals are converted when evaluated and arithmetic is exact,
+	// integer literals still need to fit in an int64.
+	// Dividing two integers gives a *big.Int if the result is whole, otherwise a *big.Rat.
+	NumericBig
+)
+
+var (
+	bigIntType = reflect.TypeOf((*big.Int)(nil))
+	bigRatType = reflect.TypeOf((*big.Rat)(nil))
+)
+
+// literalToBig converts a number literal for NumericBig mode.
+// Float literals are converted from their shortest decimal form, so 0.1 is exactly 1/10.
+func literalToBig(rv reflect.Value) reflect.Value {
+	switch rv.Kind() {
+	case reflect.Int64:
+		return reflect.ValueOf(big.NewInt(rv.Int()))
+	case reflect.Float64:
+		r, ok := new(big.Rat).SetString(strconv.FormatFloat(rv.Float(), 'g', -1, 64))
+		if ok {
+			return reflect.ValueOf(r)
+		}
+	}
+	return rv
+}
+
+// toBigNumber returns the value as a *big.Int or *big.Rat.
+// Returns false if the value is not a number.
+func toBigNumber(rv reflect.Value) (interface{}, bool) {
+	if rv.Kind() == reflect.Interface && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return big.NewInt(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return new(big.Int).SetUint64(rv.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		r := new(big.Rat).SetFloat64(rv.Float())
+		if r == nil {
+			// NaN and Inf
+			return nil, false
+		}
+		return r, true
+	case reflect.Ptr:
+		if rv.IsNil() {
+			return nil, false
+		}
+		switch n := rv.Interface().(type) {
+		case *big.Int:
+			return n, true
+		case *big.Rat:
+			return n, true
+		}
+	}
+	return nil, false
+}
+
+// bigNumberToInt64 returns a *big.Int or a whole *big.Rat as an int64.
+// Returns false if the value is not one of those or does not fit in an int64.
+func bigNumberToInt64(rv reflect.Value) (int64, bool) {
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return 0, false
+	}
+	switch n := rv.Interface().(type) {
+	case *big.Int:
+		if n.IsInt64() {
+			return n.Int64(), true
+		}
+	case *big.Rat:
+		if n.IsInt() && n.Num().IsInt64() {
+			return n.Num().Int64(), true
+		}
+	}
+	return 0, false
+}
+
+// convertBigNumber converts between big numbers and Go numbers.
+// Returns false if neither the value nor the type is a big number.
+func convertBigNumber(rv reflect.Value, rt reflect.Type) (reflect.Value, bool, error) {
+	if rt == bigIntType || rt == bigRatType {
+		n, ok := toBigNumber(rv)
+		if !ok {
+			return rv, false, nil
+		}
+		switch n := n.(type) {
+		case *big.Int:
+			if rt == bigRatType {
+				return reflect.ValueOf(new(big.Rat).SetInt(n)), true, nil
+			}
+			return reflect.ValueOf(n), true, nil
+		case *big.Rat:
+			if rt == bigRatType {
+				return reflect.ValueOf(n), true, nil
+			}
+			if !n.IsInt() {
+				return rv, true, errInvalidTypeConversion
+			}
+			return reflect.ValueOf(new(big.Int).Set(n.Num())), true, nil
+		}
+	}
+
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || (rv.Type() != bigIntType && rv.Type() != bigRatType) {
+		return rv, false, nil
+	}
+
+	switch rt.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, ok := bigNumberToInt64(rv)
+		if !ok || reflect.Zero(rt).OverflowInt(i) {
+			return rv, true, errInvalidTypeConversion
+		}
+		return reflect.ValueOf(i).Convert(rt), true, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		var i *big.Int
+		switch n := rv.Interface().(type) {
+		case *big.Int:
+			i = n
+		case *big.Rat:
+			if n.IsInt() {
+				i = n.Num()
+			}
+		}
+		if i == nil || !i.IsUint64() || reflect.Zero(rt).OverflowUint(i.Uint64()) {
+			return rv, true, errInvalidTypeConversion
+		}
+		return reflect.ValueOf(i.Uint64()).Convert(rt), true, nil
+	case reflect.Float32, reflect.Float64:
+		var f float64
+		switch n := rv.Interface().(type) {
+		case *big.Int:
+			f, _ = new(big.Float).SetInt(n).Float64()
+		case *big.Rat:
+			f, _ = n.Float64()
+		}
+		return reflect.ValueOf(f).Convert(rt), true, nil
+	}
+	return rv, false, nil
+}
+
+// invokeNumericOperator runs the operator with the numeric mode of the options.
+// Returns false if the numeric mode does not handle the operator or the values.
+func (runInfo *runInfoStruct) invokeNumericOperator(operator ast.Operator, lhsV reflect.Value) bool {
+	switch runInfo.options.Numeric {
+	case NumericChecked:
+		return runInfo.invokeCheckedOperator(operator, lhsV)
+	case NumericBig:
+		return runInfo.invokeBigOperator(operator, lhsV)
+	}
+	return false
+}
+
+// invokeCheckedOperator runs + - * << on two integers, returning an error on overflow.
+func (runInfo *runInfoStruct) invokeCheckedOperator(operator ast.Operator, lhsV reflect.Value) bool {
+	if !isInteger(lhsV) || !isInteger(runInfo.rv) {
+		return false
+	}
+	lhs := lhsV.Int()
+	rhs := runInfo.rv.Int()
+
+	var result int64
+	var overflow bool
+	switch operator.String() {
+	case "+":
+		result = lhs + rhs
+		overflow = (rhs > 0 && result < lhs) || (rhs < 0 && result > lhs)
+	case "-":
+		result = lhs - rhs
+		overflow = (rhs < 0 && result < lhs) || (rhs > 0 && result > lhs)
+	case "*":
+		result = lhs * rhs
+		overflow = lhs != 0 && (result/lhs != rhs || (lhs == -1 && rhs == math.MinInt64))
+	case "<<":
+		if rhs < 0 {
+			runInfo.err = newStringError(operator, "invalid shift count")
+			runInfo.rv = nilValue
+			return true
+		}
+		if rhs < 64 {
+			result = lhs << uint64(rhs)
+		}
+		overflow = lhs != 0 && (rhs >= 64 || result>>uint64(rhs) != lhs)
+	default:
+		return false
+	}
+
+	if overflow {
+		runInfo.err = newStringError(operator, "integer overflow")
+		runInfo.rv = nilValue
+		return true
+	}
+	runInfo.rv = reflect.ValueOf(result)
+	return true
+}
+
+func isInteger(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return true
+	}
+	return false
+}
+
+// invokeBigOperator runs the operator on two numbers as *big.Int or *big.Rat.
+func (runInfo *runInfoStruct) invokeBigOperator(operator ast.Operator, lhsV reflect.Value) bool {
+	lhs, ok := toBigNumber(lhsV)
+	if !ok {
+		return false
+	}
+	rhs, ok := toBigNumber(runInfo.rv)
+	if !ok {
+		return false
+	}
+
+	name := operator.String()
+	var result interface{}
+	var cmp int
+
+	lhsInt, lhsIsInt := lhs.(*big.Int)
+	rhsInt, rhsIsInt := rhs.(*big.Int)
+	if lhsIsInt && rhsIsInt {
+		switch name {
+		case "+":
+			result = new(big.Int).Add(lhsInt, rhsInt)
+		case "-":
+			result = new(big.Int).Sub(lhsInt, rhsInt)
+		case "*":
+			result = new(big.Int).Mul(lhsInt, rhsInt)
+		case "/":
+			if rhsInt.Sign() == 0 {
+				runInfo.err = newStringError(operator, "division by zero")
+				runInfo.rv = nilValue
+				return true
+			}
+			r := new(big.Rat).SetFrac(lhsInt, rhsInt)
+			if r.IsInt() {
+				result = new(big.Int).Set(r.Num())
+			} else {
+				result = r
+			}
+		case "%":
+			if rhsInt.Sign() == 0 {
+				runInfo.err = newStringError(operator, "division by zero")
+				runInfo.rv = nilValue
+				return true
+			}
+			result = new(big.Int).Rem(lhsInt, rhsInt)
+		case "|":
+			result = new(big.Int).Or(lhsInt, rhsInt)
+		case "&":
+			result = new(big.Int).And(lhsInt, rhsInt)
+		case "<<", ">>":
+			if rhsInt.Sign() < 0 || !rhsInt.IsUint64() || rhsInt.Uint64() > math.MaxUint32 {
+				runInfo.err = newStringError(operator, "invalid shift count")
+				runInfo.rv = nilValue
+				return true
+			}
+			if name == "<<" {
+				result = new(big.Int).Lsh(lhsInt, uint(rhsInt.Uint64()))
+			} else {
+				result = new(big.Int).Rsh(lhsInt, uint(rhsInt.Uint64()))
+			}
+		default:
+			cmp = lhsInt.Cmp(rhsInt)
+		}
+	} else {
+		lhsRat := toBigRat(lhs)
+		rhsRat := toBigRat(rhs)
+		switch name {
+		case "+":
+			result = new(big.Rat).Add(lhsRat, rhsRat)
+		case "-":
+			result = new(big.Rat).Sub(lhsRat, rhsRat)
+		case "*":
+			result = new(big.Rat).Mul(lhsRat, rhsRat)
+		case "/":
+			if rhsRat.Sign() == 0 {
+				runInfo.err = newStringError(operator, "division by zero")
+				runInfo.rv = nilValue
+				return true
+			}
+			result = new(big.Rat).Quo(lhsRat, rhsRat)
+		case "%", "|", "&", "<<", ">>":
+			runInfo.err = newStringError(operator, "invalid operation")
+			runInfo.rv = nilValue
+			return true
+		default:
+			cmp = lhsRat.Cmp(rhsRat)
+		}
+	}
+
+	if result != nil {
+		runInfo.rv = reflect.ValueOf(result)
+		return true
+	}
+
+	switch name {
+	case "==":
+		runInfo.rv = reflect.ValueOf(cmp == 0)
+	case "!=":
+		runInfo.rv = reflect.ValueOf(cmp != 0)
+	case "<":
+		runInfo.rv = reflect.ValueOf(cmp < 0)
+	case "<=":
+		runInfo.rv = reflect.ValueOf(cmp <= 0)
+	case ">":
+		runInfo.rv = reflect.ValueOf(cmp > 0)
+	case ">=":
+		runInfo.rv = reflect.ValueOf(cmp >= 0)
+	default:
+		runInfo.err = newStringError(operator, "unknown operator")
+		runInfo.rv = nilValue
+	}
+	return true
+}
+
+func toBigRat(n interface{}) *big.Rat {
+	if i, ok := n.(*big.Int); ok {
+		return new(big.Rat).SetInt(i)
+	}
+	return n.(*big.Rat)
+}
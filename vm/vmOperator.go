@@ -75,6 +75,13 @@ func (runInfo *runInfoStruct) invokeOperator() {
 			runInfo.rv = runInfo.rv.Elem()
 		}
 
+		if runInfo.invokeNumericOperator(operator, lhsV) {
+			return
+		}
+		if runInfo.invokeOperatorOverload(operator, lhsV) {
+			return
+		}
+
 		switch operator.Operator {
 		case "==":
 			runInfo.rv = reflect.ValueOf(equal(lhsV, runInfo.rv))
@@ -114,6 +121,13 @@ func (runInfo *runInfoStruct) invokeOperator() {
 			runInfo.rv = runInfo.rv.Elem()
 		}
 
+		if runInfo.invokeNumericOperator(operator, lhsV) {
+			return
+		}
+		if runInfo.invokeOperatorOverload(operator, lhsV) {
+			return
+		}
+
 		switch operator.Operator {
 		case "+":
 			lhsKind := lhsV.Kind()
@@ -126,7 +140,7 @@ func (runInfo *runInfoStruct) invokeOperator() {
 					return
 				}
 				// try to append rhs non-slice to lhs slice
-				runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.rv, lhsV.Type().Elem())
+				runInfo.rv, runInfo.err = runInfo.convertReflectValueToType(runInfo.rv, lhsV.Type().Elem())
 				if runInfo.err != nil {
 					runInfo.err = newStringError(operator, "invalid type conversion")
 					runInfo.rv = nilValue
@@ -193,6 +207,13 @@ func (runInfo *runInfoStruct) invokeOperator() {
 			runInfo.rv = runInfo.rv.Elem()
 		}
 
+		if runInfo.invokeNumericOperator(operator, lhsV) {
+			return
+		}
+		if runInfo.invokeOperatorOverload(operator, lhsV) {
+			return
+		}
+
 		switch operator.Operator {
 		case "*":
 			if lhsV.Kind() == reflect.String && (runInfo.rv.Kind() == reflect.Int || runInfo.rv.Kind() == reflect.Int32 || runInfo.rv.Kind() == reflect.Int64) {
@@ -220,6 +241,34 @@ func (runInfo *runInfoStruct) invokeOperator() {
 			runInfo.rv = nilValue
 		}
 
+	// PipeOperator
+	case *ast.PipeOperator:
+		runInfo.expr = operator.LHS
+		runInfo.invokeExpr()
+		if runInfo.err != nil {
+			return
+		}
+		lhsExpr := &ast.LiteralExpr{Literal: runInfo.rv}
+		lhsExpr.SetPosition(operator.LHS.Position())
+
+		// the value on the left is passed as the first argument of the call on the right
+		switch rhs := operator.RHS.(type) {
+		case *ast.CallExpr:
+			callExpr := &ast.CallExpr{Func: rhs.Func, Name: rhs.Name, SubExprs: append([]ast.Expr{lhsExpr}, rhs.SubExprs...), VarArg: rhs.VarArg, Go: rhs.Go}
+			callExpr.SetPosition(rhs.Position())
+			runInfo.expr = callExpr
+		case *ast.AnonCallExpr:
+			anonCallExpr := &ast.AnonCallExpr{Expr: rhs.Expr, SubExprs: append([]ast.Expr{lhsExpr}, rhs.SubExprs...), VarArg: rhs.VarArg, Go: rhs.Go}
+			anonCallExpr.SetPosition(rhs.Position())
+			runInfo.expr = anonCallExpr
+		default:
+			// not a call, so call the function value with only the left value
+			anonCallExpr := &ast.AnonCallExpr{Expr: rhs, SubExprs: []ast.Expr{lhsExpr}}
+			anonCallExpr.SetPosition(rhs.Position())
+			runInfo.expr = anonCallExpr
+		}
+		runInfo.invokeExpr()
+
 	default:
 		runInfo.err = newStringError(operator, "unknown operator")
 		runInfo.rv = nilValue
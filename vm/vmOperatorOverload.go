@@ -0,0 +1,243 @@
+package vm
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"sync/atomic"
+
+	"github.com/mattn/anko/ast"
+)
+
+// operatorMethodNames are the method names looked up on the left value of an operator.
+// For comparisons the method must return an int like the Cmp method of math/big.
+var operatorMethodNames = map[string][]string{
+	"+":  {"Add"},
+	"-":  {"Sub"},
+	"*":  {"Mul"},
+	"/":  {"Quo", "Div"},
+	"%":  {"Rem", "Mod"},
+	"|":  {"Or"},
+	"&":  {"And"},
+	"==": {"Cmp", "Compare"},
+	"!=": {"Cmp", "Compare"},
+	"<":  {"Cmp", "Compare"},
+	"<=": {"Cmp", "Compare"},
+	">":  {"Cmp", "Compare"},
+	">=": {"Cmp", "Compare"},
+}
+
+type (
+	// Operators is a registry of functions used for the operators of values of Go types.
+	// A defined operator is used before any operator method of the left value.
+	Operators struct {
+		parent  *Operators
+		rwMutex sync.RWMutex
+		funcs   map[operatorKey]reflect.Value
+		// count is the number of funcs, so operators are only looked up when there are some
+		count int32
+	}
+
+	operatorKey struct {
+		operator string
+		lhs      reflect.Type
+		rhs      reflect.Type
+	}
+)
+
+// DefaultOperators are the Operators used when Options has no Operators.
+// Operators defined here are used by all runs.
+var DefaultOperators = &Operators{funcs: make(map[operatorKey]reflect.Value)}
+
+// NewOperators creates Operators that falls back to the DefaultOperators for types it has no operator for.
+func NewOperators() *Operators {
+	return &Operators{parent: DefaultOperators, funcs: make(map[operatorKey]reflect.Value)}
+}
+
+// Define defines fn as the operator for the types of its two parameters.
+// fn must return one value, optionally followed by an error.
+// Comparison operators must return a bool.
+func (o *Operators) Define(operator string, fn interface{}) error {
+	if _, ok := operatorMethodNames[operator]; !ok {
+		return fmt.Errorf("operator %v can not be defined", operator)
+	}
+	rv := reflect.ValueOf(fn)
+	if rv.Kind() != reflect.Func || rv.IsNil() {
+		return fmt.Errorf("operator %v must be a function", operator)
+	}
+	rt := rv.Type()
+	if rt.NumIn() != 2 || rt.IsVariadic() {
+		return fmt.Errorf("operator %v function must have two parameters", operator)
+	}
+	if rt.NumOut() < 1 || rt.NumOut() > 2 || (rt.NumOut() == 2 && rt.Out(1) != errorType) {
+		return fmt.Errorf("operator %v function must return a value and an optional error", operator)
+	}
+	if isComparisonOperator(operator) && rt.Out(0).Kind() != reflect.Bool {
+		return fmt.Errorf("operator %v function must return a bool", operator)
+	}
+
+	o.rwMutex.Lock()
+	o.funcs[operatorKey{operator: operator, lhs: rt.In(0), rhs: rt.In(1)}] = rv
+	atomic.StoreInt32(&o.count, int32(len(o.funcs)))
+	o.rwMutex.Unlock()
+	return nil
+}
+
+// Delete deletes the operator defined for the lhs and rhs types.
+// Operators of the DefaultOperators are not deleted by the Operators that fall back to them.
+func (o *Operators) Delete(operator string, lhs reflect.Type, rhs reflect.Type) {
+	o.rwMutex.Lock()
+	delete(o.funcs, operatorKey{operator: operator, lhs: lhs, rhs: rhs})
+	atomic.StoreInt32(&o.count, int32(len(o.funcs)))
+	o.rwMutex.Unlock()
+}
+
+// lookup returns the operator defined for the types, false if there is none.
+func (o *Operators) lookup(key operatorKey) (reflect.Value, bool) {
+	for operators := o; operators != nil; operators = operators.parent {
+		if atomic.LoadInt32(&operators.count) < 1 {
+			continue
+		}
+		operators.rwMutex.RLock()
+		fn, ok := operators.funcs[key]
+		operators.rwMutex.RUnlock()
+		if ok {
+			return fn, true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+// operators returns the Operators of the run.
+func (runInfo *runInfoStruct) operators() *Operators {
+	if runInfo.options.Operators != nil {
+		return runInfo.options.Operators
+	}
+	return DefaultOperators
+}
+
+func isComparisonOperator(operator string) bool {
+	switch operator {
+	case "==", "!=", "<", "<=", ">", ">=":
+		return true
+	}
+	return false
+}
+
+// invokeOperatorOverload runs a defined operator or an operator method of lhsV with runInfo.rv as the right value.
+// Returns false if there is neither, in which case the builtin operator should be used.
+func (runInfo *runInfoStruct) invokeOperatorOverload(operator ast.Operator, lhsV reflect.Value) bool {
+	rhsV := runInfo.rv
+	if !lhsV.IsValid() || !rhsV.IsValid() || isNil(rhsV) {
+		return false
+	}
+	name := operator.String()
+
+	if fn, ok := runInfo.operators().lookup(operatorKey{operator: name, lhs: lhsV.Type(), rhs: rhsV.Type()}); ok {
+		runInfo.rv, runInfo.err = runInfo.callOperatorFunc(fn, []reflect.Value{lhsV, rhsV})
+		if runInfo.err != nil {
+			runInfo.err = newError(operator, runInfo.err)
+			runInfo.rv = nilValue
+		}
+		return true
+	}
+
+	// only named Go types can have methods, this keeps the builtin operators fast
+	if lhsV.Kind() == reflect.Ptr {
+		if lhsV.IsNil() || lhsV.Type().Elem().PkgPath() == "" {
+			return false
+		}
+	} else if lhsV.Type().PkgPath() == "" {
+		return false
+	}
+
+	for _, methodName := range operatorMethodNames[name] {
+		method := lhsV.MethodByName(methodName)
+		if !method.IsValid() {
+			continue
+		}
+		methodType := method.Type()
+		if methodType.NumOut() < 1 || methodType.NumOut() > 2 || (methodType.NumOut() == 2 && methodType.Out(1) != errorType) {
+			continue
+		}
+
+		if (name == "==" || name == "!=") && methodType.NumIn() > 0 {
+			// values the method can not take, like a different type, are compared with the builtin equality
+			_, err := runInfo.convertReflectValueToType(rhsV, methodType.In(methodType.NumIn()-1))
+			if err != nil {
+				return false
+			}
+		}
+
+		var args []reflect.Value
+		switch methodType.NumIn() {
+		case 1:
+			// func (x T) Add(y T) T
+			args = []reflect.Value{rhsV}
+		case 2:
+			// func (z *T) Add(x, y *T) *T, the style used by math/big
+			if lhsV.Kind() != reflect.Ptr {
+				continue
+			}
+			method = reflect.New(lhsV.Type().Elem()).MethodByName(methodName)
+			args = []reflect.Value{lhsV, rhsV}
+		default:
+			continue
+		}
+
+		rv, err := runInfo.callOperatorFunc(method, args)
+		if err != nil {
+			runInfo.err = newError(operator, err)
+			runInfo.rv = nilValue
+			return true
+		}
+
+		if isComparisonOperator(name) {
+			if !isNum(rv) {
+				runInfo.err = newStringError(operator, "method "+methodName+" must return an int")
+				runInfo.rv = nilValue
+				return true
+			}
+			cmp := toInt64(rv)
+			var result bool
+			switch name {
+			case "==":
+				result = cmp == 0
+			case "!=":
+				result = cmp != 0
+			case "<":
+				result = cmp < 0
+			case "<=":
+				result = cmp <= 0
+			case ">":
+				result = cmp > 0
+			case ">=":
+				result = cmp >= 0
+			}
+			rv = reflect.ValueOf(result)
+		}
+
+		runInfo.rv = rv
+		return true
+	}
+
+	return false
+}
+
+// callOperatorFunc converts the arguments to the parameter types of fn with the Converters of the run then calls it.
+func (runInfo *runInfoStruct) callOperatorFunc(fn reflect.Value, args []reflect.Value) (reflect.Value, error) {
+	fnType := fn.Type()
+	for i := 0; i < len(args); i++ {
+		arg, err := runInfo.convertReflectValueToType(args[i], fnType.In(i))
+		if err != nil {
+			return nilValue, fmt.Errorf("operator can not use type %v as type %v", args[i].Type(), fnType.In(i))
+		}
+		args[i] = arg
+	}
+
+	rvs := fn.Call(args)
+	if len(rvs) == 2 && !rvs[1].IsNil() {
+		return nilValue, rvs[1].Interface().(error)
+	}
+	return rvs[0], nil
+}
@@ -2,6 +2,7 @@ package vm
 
 import (
 	"fmt"
+	"math/big"
 	"reflect"
 	"testing"
 )
@@ -32,6 +33,15 @@ func TestBasicOperators(t *testing.T) {
 		{Script: `2- -1`, RunOutput: int64(3)},
 		{Script: `2 - - 1`, RunOutput: int64(3)},
 		{Script: `2- - 1`, RunOutput: int64(3)},
+		{Script: `- -1`, RunOutput: int64(1)},
+		{Script: `-1.5`, RunOutput: float64(-1.5)},
+		{Script: `-0x10`, RunOutput: int64(-16)},
+		{Script: `-9223372036854775808`, RunOutput: int64(-9223372036854775808)},
+		{Script: `-0x8000000000000000`, RunOutput: int64(-9223372036854775808)},
+		{Script: `9223372036854775808`, ParseError: fmt.Errorf("invalid number: 9223372036854775808")},
+		{Script: `1 - 9223372036854775808`, ParseError: fmt.Errorf("invalid number: 9223372036854775808"), RunOutput: int64(1)},
+		{Script: `-9223372036854775808[0]`, ParseError: fmt.Errorf("invalid number: 9223372036854775808"), RunError: fmt.Errorf("type interface does not support index operation")},
+		{Script: `-9223372036854775809`, ParseError: fmt.Errorf("invalid number: -9223372036854775809")},
 
 		{Script: `a + b`, Input: map[string]interface{}{"a": int64(2), "b": int64(1)}, RunOutput: int64(3)},
 		{Script: `a - b`, Input: map[string]interface{}{"a": int64(2), "b": int64(1)}, RunOutput: int64(1)},
@@ -507,6 +517,270 @@ func TestNilCoalescingOperator(t *testing.T) {
 	runTests(t, tests, nil, &Options{Debug: true})
 }
 
+func TestPipeOperator(t *testing.T) {
+	t.Parallel()
+
+	tests := []Test{
+		{Script: `1 |>`, ParseError: fmt.Errorf("syntax error")},
+		{Script: `|> a()`, ParseError: fmt.Errorf("syntax error")},
+
+		{Script: `1 |> 2`, RunError: fmt.Errorf("cannot call type int64")},
+		{Script: `1 |> a()`, RunError: fmt.Errorf("undefined symbol 'a'")},
+		{Script: `1++ |> a()`, RunError: fmt.Errorf("invalid operation")},
+		{Script: `func a(b, c) { return b + c }; 1 |> a(1++)`, RunError: fmt.Errorf("invalid operation")},
+		{Script: `func a(b) { return b + 1 }; 1 |> a(2)`, RunError: fmt.Errorf("function wants 1 arguments but received 2")},
+
+		{Script: `func a(b) { return b + 1 }; 1 |> a()`, RunOutput: int64(2)},
+		{Script: `func a(b) { return b + 1 }; 1 |> a`, RunOutput: int64(2)},
+		{Script: `func a(b) { return b + 1 }; -1 |> a`, RunOutput: int64(0)},
+		{Script: `func a(b) { return b + 1 }; 1 |> a() |> a() |> a()`, RunOutput: int64(4)},
+		{Script: `func a(b, c) { return b - c }; 5 |> a(2)`, RunOutput: int64(3)},
+		{Script: `func a(b, c...) { return len(c) + b }; 1 |> a(2, 3)`, RunOutput: int64(3)},
+		{Script: `1 |> func(b) { return b * 2 }`, RunOutput: int64(2)},
+		{Script: `1 |> func(b, c) { return b * c }(3)`, RunOutput: int64(3)},
+		{Script: `b = {"c": func(d) { return d + 1 }}; 1 |> b.c()`, RunOutput: int64(2)},
+		{Script: `func a(b) { return b + 1 }; 1 + 2 |> a()`, RunOutput: int64(4)},
+		{Script: `func a(b) { return b }; false || true |> a()`, RunOutput: true},
+
+		{Script: `a |> b()`, Input: map[string]interface{}{"a": int64(1), "b": func(c int64) int64 { return c * 10 }}, RunOutput: int64(10)},
+		{Script: `a |> b(",")`, Input: map[string]interface{}{"a": []interface{}{"x", "y"}, "b": func(c []interface{}, d string) string { return fmt.Sprint(len(c)) + d }}, RunOutput: "2,"},
+		{Script: `strings = import("strings"); a |> strings.Join(",")`, Input: map[string]interface{}{"a": []string{"x", "y", "z"}}, RunOutput: "x,y,z"},
+		{Script: `sort = import("sort"); strings = import("strings"); a |> sort.Strings(); a |> strings.Join(",")`, Input: map[string]interface{}{"a": []string{"c", "b", "a"}}, RunOutput: "a,b,c"},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
+type testVector struct {
+	X int64
+	Y int64
+}
+
+func (v testVector) Add(w testVector) testVector {
+	return testVector{X: v.X + w.X, Y: v.Y + w.Y}
+}
+
+func (v testVector) Sub(w testVector) testVector {
+	return testVector{X: v.X - w.X, Y: v.Y - w.Y}
+}
+
+func (v testVector) Mul(n int64) testVector {
+	return testVector{X: v.X * n, Y: v.Y * n}
+}
+
+func (v testVector) Quo(n int64) (testVector, error) {
+	if n == 0 {
+		return testVector{}, fmt.Errorf("vector division by zero")
+	}
+	return testVector{X: v.X / n, Y: v.Y / n}, nil
+}
+
+func (v testVector) Cmp(w testVector) int {
+	a, b := v.X*v.X+v.Y*v.Y, w.X*w.X+w.Y*w.Y
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+type testAmount int64
+
+type testPrice int64
+
+func TestOperatorOverloading(t *testing.T) {
+	t.Parallel()
+
+	operators := NewOperators()
+	err := operators.Define("+", func(a testAmount, b testAmount) testAmount { return a + b })
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+	err = operators.Define("*", func(a int64, v testVector) testVector { return v.Mul(a) })
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+	err = operators.Define("<", func(a testAmount, b testAmount) (bool, error) {
+		if a < 0 || b < 0 {
+			return false, fmt.Errorf("negative amount")
+		}
+		return a < b, nil
+	})
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+
+	for _, test := range []struct {
+		operator string
+		fn       interface{}
+		err      string
+	}{
+		{operator: "&&", fn: func(a, b testAmount) bool { return true }, err: "operator && can not be defined"},
+		{operator: "+", fn: 1, err: "operator + must be a function"},
+		{operator: "+", fn: func(a testAmount) testAmount { return a }, err: "operator + function must have two parameters"},
+		{operator: "+", fn: func(a, b testAmount) {}, err: "operator + function must return a value and an optional error"},
+		{operator: "+", fn: func(a, b testAmount) (testAmount, testAmount) { return a, b }, err: "operator + function must return a value and an optional error"},
+		{operator: "==", fn: func(a, b testAmount) int { return 0 }, err: "operator == function must return a bool"},
+	} {
+		err = operators.Define(test.operator, test.fn)
+		if err == nil || err.Error() != test.err {
+			t.Errorf("Define error - received: %v - expected: %v", err, test.err)
+		}
+	}
+
+	tests := []Test{
+		{Script: `a + b`, Input: map[string]interface{}{"a": testVector{X: 1, Y: 2}, "b": testVector{X: 3, Y: 4}}, RunOutput: testVector{X: 4, Y: 6}},
+		{Script: `a - b`, Input: map[string]interface{}{"a": testVector{X: 1, Y: 2}, "b": testVector{X: 3, Y: 4}}, RunOutput: testVector{X: -2, Y: -2}},
+		{Script: `a * 3`, Input: map[string]interface{}{"a": testVector{X: 1, Y: 2}}, RunOutput: testVector{X: 3, Y: 6}},
+		{Script: `a / 2`, Input: map[string]interface{}{"a": testVector{X: 4, Y: 2}}, RunOutput: testVector{X: 2, Y: 1}},
+		{Script: `a / 0`, Input: map[string]interface{}{"a": testVector{X: 4, Y: 2}}, RunError: fmt.Errorf("vector division by zero")},
+		{Script: `a + 1`, Input: map[string]interface{}{"a": testVector{X: 1, Y: 2}}, RunError: fmt.Errorf("operator can not use type int64 as type vm.testVector")},
+		{Script: `a < b`, Input: map[string]interface{}{"a": testVector{X: 1, Y: 2}, "b": testVector{X: 3, Y: 4}}, RunOutput: true},
+		{Script: `a > b`, Input: map[string]interface{}{"a": testVector{X: 1, Y: 2}, "b": testVector{X: 3, Y: 4}}, RunOutput: false},
+		{Script: `a == b`, Input: map[string]interface{}{"a": testVector{X: 3, Y: 4}, "b": testVector{X: 4, Y: 3}}, RunOutput: true},
+		{Script: `a != b`, Input: map[string]interface{}{"a": testVector{X: 3, Y: 4}, "b": testVector{X: 4, Y: 3}}, RunOutput: false},
+		{Script: `a == 1`, Input: map[string]interface{}{"a": testVector{X: 1, Y: 2}}, RunOutput: false},
+		{Script: `a != "a"`, Input: map[string]interface{}{"a": testVector{X: 1, Y: 2}}, RunOutput: true},
+		{Script: `a < 1`, Input: map[string]interface{}{"a": testVector{X: 1, Y: 2}}, RunError: fmt.Errorf("operator can not use type int64 as type vm.testVector")},
+
+		{Script: `a + b`, Input: map[string]interface{}{"a": testAmount(1), "b": testAmount(2)}, RunOutput: testAmount(3)},
+		{Script: `a - b`, Input: map[string]interface{}{"a": testAmount(3), "b": testAmount(2)}, RunOutput: int64(1)},
+		{Script: `a < b`, Input: map[string]interface{}{"a": testAmount(1), "b": testAmount(2)}, RunOutput: true},
+		{Script: `a < b`, Input: map[string]interface{}{"a": testAmount(-1), "b": testAmount(2)}, RunError: fmt.Errorf("negative amount")},
+		{Script: `2 * a`, Input: map[string]interface{}{"a": testVector{X: 1, Y: 2}}, RunOutput: testVector{X: 2, Y: 4}},
+
+		{Script: `1 + 2`, RunOutput: int64(3)},
+		{Script: `"a" + "b"`, RunOutput: "ab"},
+	}
+	runTests(t, tests, nil, &Options{Debug: true, Operators: operators})
+
+	// operators of other runs are not used
+	tests = []Test{
+		{Script: `a + b`, Input: map[string]interface{}{"a": testAmount(1), "b": testAmount(2)}, RunOutput: int64(3)},
+		{Script: `a < b`, Input: map[string]interface{}{"a": testAmount(-1), "b": testAmount(2)}, RunOutput: true},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+
+	// operands are converted with the converters of the run
+	converters := NewConverters()
+	converters.Register(reflect.TypeOf(int64(0)), reflect.TypeOf(testVector{}), func(rv reflect.Value, rt reflect.Type) (reflect.Value, error) {
+		return reflect.ValueOf(testVector{X: rv.Int(), Y: rv.Int()}), nil
+	})
+	tests = []Test{
+		{Script: `a + 1`, Input: map[string]interface{}{"a": testVector{X: 1, Y: 2}}, RunOutput: testVector{X: 2, Y: 3}},
+		{Script: `a == 3`, Input: map[string]interface{}{"a": testVector{X: 3, Y: 3}}, RunOutput: true},
+	}
+	runTests(t, tests, nil, &Options{Debug: true, Operators: operators, Converters: converters})
+
+	// operators fall back to the DefaultOperators
+	priceType := reflect.TypeOf(testPrice(0))
+	err = DefaultOperators.Define("+", func(a testPrice, b testPrice) testPrice { return a + b + 1 })
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+	defer DefaultOperators.Delete("+", priceType, priceType)
+	tests = []Test{
+		{Script: `a + b`, Input: map[string]interface{}{"a": testPrice(1), "b": testPrice(2)}, RunOutput: testPrice(4)},
+	}
+	runTests(t, tests, nil, &Options{Debug: true, Operators: operators})
+
+	operators.Delete("*", reflect.TypeOf(int64(0)), reflect.TypeOf(testVector{}))
+	if _, ok := operators.lookup(operatorKey{operator: "*", lhs: reflect.TypeOf(int64(0)), rhs: reflect.TypeOf(testVector{})}); ok {
+		t.Errorf("Delete - operator * is still defined")
+	}
+}
+
+func TestNumericChecked(t *testing.T) {
+	t.Parallel()
+
+	tests := []Test{
+		{Script: `9223372036854775807 + 1`, RunError: fmt.Errorf("integer overflow")},
+		{Script: `-9223372036854775808 - 1`, RunError: fmt.Errorf("integer overflow")},
+		{Script: `-9223372036854775807 - 2`, RunError: fmt.Errorf("integer overflow")},
+		{Script: `9223372036854775807 * 2`, RunError: fmt.Errorf("integer overflow")},
+		{Script: `-1 * -9223372036854775808`, RunError: fmt.Errorf("integer overflow")},
+		{Script: `a = 9223372036854775807; a++`, RunError: fmt.Errorf("integer overflow")},
+		{Script: `a = 9223372036854775807; a += 1`, RunError: fmt.Errorf("integer overflow")},
+		{Script: `a = -9223372036854775808; -a`, RunError: fmt.Errorf("integer overflow")},
+		{Script: `- -9223372036854775808`, RunError: fmt.Errorf("integer overflow")},
+		{Script: `1 << 63`, RunError: fmt.Errorf("integer overflow")},
+		{Script: `3 << 62`, RunError: fmt.Errorf("integer overflow")},
+		{Script: `-1 << 64`, RunError: fmt.Errorf("integer overflow")},
+		{Script: `1 << -1`, RunError: fmt.Errorf("invalid shift count")},
+		{Script: `a + 1`, Input: map[string]interface{}{"a": int32(2147483647)}, RunOutput: int64(2147483648)},
+
+		{Script: `9223372036854775806 + 1`, RunOutput: int64(9223372036854775807)},
+		{Script: `-9223372036854775807 - 1`, RunOutput: int64(-9223372036854775808)},
+		{Script: `4611686018427387903 * 2`, RunOutput: int64(9223372036854775806)},
+		{Script: `-1 * 9223372036854775807`, RunOutput: int64(-9223372036854775807)},
+		{Script: `1 << 62`, RunOutput: int64(4611686018427387904)},
+		{Script: `-1 << 63`, RunOutput: int64(-9223372036854775808)},
+		{Script: `0 << 64`, RunOutput: int64(0)},
+		{Script: `-8 >> 1`, RunOutput: int64(-4)},
+		{Script: `9223372036854775807 + 1.0`, RunOutput: float64(9223372036854775807 + 1.0)},
+		{Script: `"a" + 1`, RunOutput: "a1"},
+		{Script: `3 / 2`, RunOutput: float64(1.5)},
+	}
+	runTests(t, tests, nil, &Options{Debug: true, Numeric: NumericChecked})
+}
+
+func TestNumericBig(t *testing.T) {
+	t.Parallel()
+
+	tests := []Test{
+		{Script: `1`, RunOutput: big.NewInt(1)},
+		{Script: `-1`, RunOutput: big.NewInt(-1)},
+		{Script: `0.1`, RunOutput: big.NewRat(1, 10)},
+		{Script: `9223372036854775807 + 1`, RunOutput: new(big.Int).Lsh(big.NewInt(1), 63)},
+		{Script: `9223372036854775807 * 9223372036854775807 * 4`, RunOutput: new(big.Int).Mul(new(big.Int).Mul(big.NewInt(9223372036854775807), big.NewInt(9223372036854775807)), big.NewInt(4))},
+		{Script: `0.1 + 0.2 == 0.3`, RunOutput: true},
+		{Script: `0.1 + 0.2`, RunOutput: big.NewRat(3, 10)},
+		{Script: `1 - 0.25`, RunOutput: big.NewRat(3, 4)},
+		{Script: `3 * 0.5`, RunOutput: big.NewRat(3, 2)},
+		{Script: `4 / 2`, RunOutput: big.NewInt(2)},
+		{Script: `10 / 4`, RunOutput: big.NewRat(5, 2)},
+		{Script: `1 / 3 + 1 / 6`, RunOutput: big.NewRat(1, 2)},
+		{Script: `7 % 3`, RunOutput: big.NewInt(1)},
+		{Script: `1 << 70`, RunOutput: new(big.Int).Lsh(big.NewInt(1), 70)},
+		{Script: `1 | 2`, RunOutput: big.NewInt(3)},
+		{Script: `1 / 0`, RunError: fmt.Errorf("division by zero")},
+		{Script: `1 % 0`, RunError: fmt.Errorf("division by zero")},
+		{Script: `0.5 / 0`, RunError: fmt.Errorf("division by zero")},
+		{Script: `0.5 % 2`, RunError: fmt.Errorf("invalid operation")},
+		{Script: `a = 1; a++; a`, RunOutput: big.NewInt(2)},
+		{Script: `a = 1; -a`, RunOutput: big.NewInt(-1)},
+		{Script: `a = 1; a += 0.5; a`, RunOutput: big.NewRat(3, 2)},
+		{Script: `1 < 2`, RunOutput: true},
+		{Script: `0.5 >= 1`, RunOutput: false},
+		{Script: `1 == 1.0`, RunOutput: true},
+		{Script: `"a" + 1`, RunOutput: "a1"},
+		{Script: `"a" + 0.5`, RunOutput: "a1/2"},
+		{Script: `a = [1, 2, 3]; a[1]`, RunOutput: big.NewInt(2)},
+		{Script: `a = [1, 2, 3]; a[1:2]`, RunOutput: []interface{}{big.NewInt(2)}},
+
+		{Script: `a + 1`, Input: map[string]interface{}{"a": int64(1)}, RunOutput: big.NewInt(2)},
+		{Script: `a + 1`, Input: map[string]interface{}{"a": float64(0.5)}, RunOutput: big.NewRat(3, 2)},
+		{Script: `a(1)`, Input: map[string]interface{}{"a": func(b int64) int64 { return b }}, RunOutput: int64(1)},
+		{Script: `a(1)`, Input: map[string]interface{}{"a": func(b int8) int8 { return b }}, RunOutput: int8(1)},
+		{Script: `a(1)`, Input: map[string]interface{}{"a": func(b uint) uint { return b }}, RunOutput: uint(1)},
+		{Script: `a(1 / 4)`, Input: map[string]interface{}{"a": func(b float64) float64 { return b }}, RunOutput: float64(0.25)},
+		{Script: `a(1)`, Input: map[string]interface{}{"a": func(b *big.Rat) string { return b.String() }}, RunOutput: "1/1"},
+		{Script: `a(1000)`, Input: map[string]interface{}{"a": func(b int8) int8 { return b }}, RunError: fmt.Errorf("function wants argument type int8 but received type *big.Int")},
+		{Script: `a(-1)`, Input: map[string]interface{}{"a": func(b uint) uint { return b }}, RunError: fmt.Errorf("function wants argument type uint but received type *big.Int")},
+		{Script: `a(0.5)`, Input: map[string]interface{}{"a": func(b int64) int64 { return b }}, RunError: fmt.Errorf("function wants argument type int64 but received type *big.Rat")},
+	}
+	runTests(t, tests, nil, &Options{Debug: true, Numeric: NumericBig})
+
+	tests = []Test{
+		{Script: `a + 1`, Input: map[string]interface{}{"a": big.NewInt(1)}, RunOutput: big.NewInt(2)},
+		{Script: `-a`, Input: map[string]interface{}{"a": big.NewInt(1)}, RunOutput: big.NewInt(-1)},
+		{Script: `b(a)`, Input: map[string]interface{}{"a": big.NewInt(1), "b": func(c int64) int64 { return c }}, RunOutput: int64(1)},
+		{Script: `b(1)`, Input: map[string]interface{}{"b": func(c *big.Int) string { return c.String() }}, RunOutput: "1"},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
 func TestIf(t *testing.T) {
 	t.Parallel()
 
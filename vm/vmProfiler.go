@@ -0,0 +1,383 @@
+package vm
+
+import (
+	"context"
+	"runtime"
+	"runtime/metrics"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/mattn/anko/ast"
+)
+
+// Profiler records the wall time and the allocations of scripts by script function and source line.
+// Set it in the Options of the runs to profile, it can be used by many runs at the same time.
+//
+// Time is measured between the statements that run, so the time of a statement includes the time of the
+// Go functions it calls. Allocations are read from the counters of the Go runtime, which count the allocations
+// of all goroutines, so they are only exact when nothing else runs at the same time as the script.
+// Go 1.16 does not have the allocation metrics of runtime/metrics, the counters are then read with
+// runtime.ReadMemStats, which stops the world at each statement, so scripts run a lot slower while profiled.
+type Profiler struct {
+	// File is the file name of the scripts that are not imported modules
+	File string
+
+	mutex sync.Mutex
+	start time.Time
+	// roots are the functions the runs and goroutines start with
+	roots map[profileKey]*profileNode
+}
+
+// profileKey identifies a function called from a line of its caller.
+type profileKey struct {
+	function string
+	file     string
+	callLine int
+}
+
+// profileNode is a function in the call tree, with the values of its lines.
+type profileNode struct {
+	parent    *profileNode
+	function  string
+	file      string
+	startLine int
+	callLine  int
+	calls     int64
+	children  map[profileKey]*profileNode
+	lines     map[int]*ProfileValues
+}
+
+// ProfileValues are the values recorded for a line or a function.
+type ProfileValues struct {
+	// Count is how many times statements ran
+	Count int64
+	// Wall is the time spent running
+	Wall time.Duration
+	// AllocObjects is the number of objects allocated
+	AllocObjects int64
+	// AllocBytes is the number of bytes allocated
+	AllocBytes int64
+}
+
+// add adds the values of other to values.
+func (values *ProfileValues) add(other *ProfileValues) {
+	values.Count += other.Count
+	values.Wall += other.Wall
+	values.AllocObjects += other.AllocObjects
+	values.AllocBytes += other.AllocBytes
+}
+
+// FunctionProfile are the values recorded for a script function. The top-level statements of a run are
+// in the function main, the ones of an imported module in the function init, and function literals
+// are named func@ followed by the line they start on.
+type FunctionProfile struct {
+	Function string
+	File     string
+	// Line is the line the function starts on
+	Line int
+	// Calls is how many times the function was called
+	Calls int64
+	// Flat are the values of the statements of the function
+	Flat ProfileValues
+	// Cum are the values of the statements of the function and of the functions it called
+	Cum ProfileValues
+}
+
+// LineProfile are the values recorded for a line of a script function.
+type LineProfile struct {
+	Function string
+	File     string
+	Line     int
+	Values   ProfileValues
+}
+
+// NewProfiler creates a Profiler without values.
+func NewProfiler() *Profiler {
+	return &Profiler{
+		start: time.Now(),
+		roots: make(map[profileKey]*profileNode),
+	}
+}
+
+// profileThreadKey is the context key of the profile thread of a run.
+type profileThreadKey struct{}
+
+// profileThread is where a run or a goroutine of a run is in the call tree.
+// Its fields are guarded by the mutex of the profiler.
+type profileThread struct {
+	profiler *Profiler
+	// node is the function that is running, nil before the first function starts
+	node *profileNode
+	// line is the line that is running
+	line int
+	// last is when the values were last recorded
+	last        time.Time
+	lastObjects uint64
+	lastBytes   uint64
+	samples     []metrics.Sample
+	// memStats is true when the Go runtime does not have the allocation metrics
+	memStats bool
+}
+
+// newThread returns a context with a new profile thread, which starts with no function running.
+func (p *Profiler) newThread(ctx context.Context) (context.Context, *profileThread) {
+	thread := &profileThread{
+		profiler: p,
+		samples:  []metrics.Sample{{Name: "/gc/heap/allocs:objects"}, {Name: "/gc/heap/allocs:bytes"}},
+	}
+	metrics.Read(thread.samples)
+	thread.memStats = thread.samples[0].Value.Kind() != metrics.KindUint64 || thread.samples[1].Value.Kind() != metrics.KindUint64
+	thread.last, thread.lastObjects, thread.lastBytes = thread.read()
+	return context.WithValue(ctx, profileThreadKey{}, thread), thread
+}
+
+// read returns the time and the allocation counters of the Go runtime.
+// The mutex of the profiler must be held, script functions called by Go functions can run on other goroutines.
+func (thread *profileThread) read() (time.Time, uint64, uint64) {
+	if thread.memStats {
+		var memStats runtime.MemStats
+		runtime.ReadMemStats(&memStats)
+		return time.Now(), memStats.Mallocs, memStats.TotalAlloc
+	}
+	metrics.Read(thread.samples)
+	return time.Now(), thread.samples[0].Value.Uint64(), thread.samples[1].Value.Uint64()
+}
+
+// record adds the time and allocations since the last record to the line that is running.
+// The mutex of the profiler must be held.
+func (thread *profileThread) record(now time.Time, objects uint64, bytes uint64) *ProfileValues {
+	var values *ProfileValues
+	if thread.node != nil {
+		values = thread.node.lines[thread.line]
+		if values == nil {
+			values = &ProfileValues{}
+			thread.node.lines[thread.line] = values
+		}
+		values.Wall += now.Sub(thread.last)
+		values.AllocObjects += int64(objects - thread.lastObjects)
+		values.AllocBytes += int64(bytes - thread.lastBytes)
+	}
+	thread.last, thread.lastObjects, thread.lastBytes = now, objects, bytes
+	return values
+}
+
+// stmt starts the line of the statement. Returns the line that was running, to restore when the statement is done.
+func (thread *profileThread) stmt(stmt ast.Stmt) int {
+	p := thread.profiler
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	now, objects, bytes := thread.read()
+
+	previous := thread.line
+	thread.record(now, objects, bytes)
+	if _, isBlock := stmt.(*ast.StmtsStmt); isBlock || stmt == nil {
+		return previous
+	}
+	thread.line = stmt.Position().Line
+	if values := thread.record(now, objects, bytes); values != nil {
+		values.Count++
+	}
+	return previous
+}
+
+// restore goes back to the line that was running before the statement.
+func (thread *profileThread) restore(line int) {
+	p := thread.profiler
+	p.mutex.Lock()
+	now, objects, bytes := thread.read()
+	thread.record(now, objects, bytes)
+	thread.line = line
+	p.mutex.Unlock()
+}
+
+// enter starts a call of the function, from the line that is running.
+func (thread *profileThread) enter(function string, file string, startLine int) {
+	p := thread.profiler
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	now, objects, bytes := thread.read()
+
+	thread.record(now, objects, bytes)
+	key := profileKey{function: function, file: file, callLine: thread.line}
+	children := p.roots
+	if thread.node != nil {
+		children = thread.node.children
+	}
+	node, ok := children[key]
+	if !ok {
+		node = &profileNode{
+			parent:    thread.node,
+			function:  function,
+			file:      file,
+			startLine: startLine,
+			callLine:  key.callLine,
+			children:  make(map[profileKey]*profileNode),
+			lines:     make(map[int]*ProfileValues),
+		}
+		children[key] = node
+	}
+	node.calls++
+	thread.node = node
+	thread.line = startLine
+}
+
+// exit returns from the function that is running to the line of its caller.
+func (thread *profileThread) exit() {
+	p := thread.profiler
+	p.mutex.Lock()
+	now, objects, bytes := thread.read()
+	thread.record(now, objects, bytes)
+	if thread.node != nil {
+		thread.line = thread.node.callLine
+		thread.node = thread.node.parent
+	}
+	p.mutex.Unlock()
+}
+
+// profileThread returns the profile thread of the run, nil if the run is not profiled.
+func (runInfo *runInfoStruct) profileThread() *profileThread {
+	if runInfo.options.Profiler == nil {
+		return nil
+	}
+	thread, _ := runInfo.ctx.Value(profileThreadKey{}).(*profileThread)
+	if thread == nil || thread.profiler != runInfo.options.Profiler {
+		return nil
+	}
+	return thread
+}
+
+// startProfile starts the top-level statements of the run in the profiler, as main or as init for modules.
+// Runs started with the context of another run, like module imports, continue the thread of that run.
+// Returns the function to call when the run is done.
+func (runInfo *runInfoStruct) startProfile() func() {
+	thread := runInfo.profileThread()
+	if thread == nil {
+		runInfo.ctx, thread = runInfo.options.Profiler.newThread(runInfo.ctx)
+	}
+	function := "main"
+	if len(runInfo.options.moduleStack) > 0 {
+		function = "init"
+	}
+	thread.enter(function, runInfo.options.profileFile(), 0)
+	return thread.exit
+}
+
+// profileGoroutine returns the context for a function called in a new goroutine, with a new profile thread.
+func (runInfo *runInfoStruct) profileGoroutine() context.Context {
+	ctx, _ := runInfo.options.Profiler.newThread(runInfo.ctx)
+	return ctx
+}
+
+// profileFile returns the file of the script being run, for the profiler.
+func (options *Options) profileFile() string {
+	if file := options.moduleFile(); file != "" {
+		return file
+	}
+	return options.Profiler.File
+}
+
+// walk calls visit with each node of the call tree.
+func (p *Profiler) walk(visit func(node *profileNode)) {
+	var walkNodes func(nodes map[profileKey]*profileNode)
+	walkNodes = func(nodes map[profileKey]*profileNode) {
+		for _, node := range nodes {
+			visit(node)
+			walkNodes(node.children)
+		}
+	}
+	walkNodes(p.roots)
+}
+
+// Functions returns the values of the functions, sorted by cumulative wall time.
+func (p *Profiler) Functions() []FunctionProfile {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	type functionKey struct {
+		function  string
+		file      string
+		startLine int
+	}
+	functions := make(map[functionKey]*FunctionProfile)
+	get := func(node *profileNode) *FunctionProfile {
+		key := functionKey{function: node.function, file: node.file, startLine: node.startLine}
+		function, ok := functions[key]
+		if !ok {
+			function = &FunctionProfile{Function: node.function, File: node.file, Line: node.startLine}
+			functions[key] = function
+		}
+		return function
+	}
+
+	p.walk(func(node *profileNode) {
+		get(node).Calls += node.calls
+		for _, values := range node.lines {
+			get(node).Flat.add(values)
+			// recursive functions only count the values once in their cumulative values
+			seen := make(map[*FunctionProfile]struct{})
+			for caller := node; caller != nil; caller = caller.parent {
+				function := get(caller)
+				if _, ok := seen[function]; ok {
+					continue
+				}
+				seen[function] = struct{}{}
+				function.Cum.add(values)
+			}
+		}
+	})
+
+	list := make([]FunctionProfile, 0, len(functions))
+	for _, function := range functions {
+		list = append(list, *function)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Cum.Wall != list[j].Cum.Wall {
+			return list[i].Cum.Wall > list[j].Cum.Wall
+		}
+		if list[i].File != list[j].File {
+			return list[i].File < list[j].File
+		}
+		return list[i].Line < list[j].Line
+	})
+	return list
+}
+
+// Lines returns the values of the lines of each function, sorted by wall time.
+func (p *Profiler) Lines() []LineProfile {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	type lineKey struct {
+		function string
+		file     string
+		line     int
+	}
+	lines := make(map[lineKey]*LineProfile)
+	p.walk(func(node *profileNode) {
+		for line, values := range node.lines {
+			key := lineKey{function: node.function, file: node.file, line: line}
+			lineProfile, ok := lines[key]
+			if !ok {
+				lineProfile = &LineProfile{Function: node.function, File: node.file, Line: line}
+				lines[key] = lineProfile
+			}
+			lineProfile.Values.add(values)
+		}
+	})
+
+	list := make([]LineProfile, 0, len(lines))
+	for _, line := range lines {
+		list = append(list, *line)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Values.Wall != list[j].Values.Wall {
+			return list[i].Values.Wall > list[j].Values.Wall
+		}
+		if list[i].File != list[j].File {
+			return list[i].File < list[j].File
+		}
+		return list[i].Line < list[j].Line
+	})
+	return list
+}
@@ -0,0 +1,230 @@
+package vm
+
+import (
+	"compress/gzip"
+	"io"
+	"sort"
+	"time"
+)
+
+// WritePprof writes the values in the gzipped protocol buffer format of pprof, with a frame for each script function
+// and line, so the profile can be read with go tool pprof. The sample types are stmts, wall, alloc_objects and alloc_space.
+func (p *Profiler) WritePprof(w io.Writer) error {
+	p.mutex.Lock()
+	profile := p.buildPprof()
+	p.mutex.Unlock()
+
+	gzipWriter := gzip.NewWriter(w)
+	_, err := gzipWriter.Write(profile)
+	if err != nil {
+		return err
+	}
+	return gzipWriter.Close()
+}
+
+// pprofFunction identifies a function of the profile.
+type pprofFunction struct {
+	name      string
+	file      string
+	startLine int
+}
+
+// pprofLocation identifies a location of the profile, a line of a function.
+type pprofLocation struct {
+	function uint64
+	line     int
+}
+
+// pprofBuilder builds the tables of a pprof profile.
+type pprofBuilder struct {
+	strings     []string
+	stringIDs   map[string]int64
+	functions   []pprofFunction
+	functionIDs map[pprofFunction]uint64
+	locations   []pprofLocation
+	locationIDs map[pprofLocation]uint64
+}
+
+// stringID returns the index of the string in the string table.
+func (builder *pprofBuilder) stringID(s string) int64 {
+	id, ok := builder.stringIDs[s]
+	if !ok {
+		id = int64(len(builder.strings))
+		builder.strings = append(builder.strings, s)
+		builder.stringIDs[s] = id
+	}
+	return id
+}
+
+// locationID returns the id of the location of the line of the node.
+func (builder *pprofBuilder) locationID(node *profileNode, line int) uint64 {
+	function := pprofFunction{name: node.function, file: node.file, startLine: node.startLine}
+	functionID, ok := builder.functionIDs[function]
+	if !ok {
+		builder.functions = append(builder.functions, function)
+		functionID = uint64(len(builder.functions))
+		builder.functionIDs[function] = functionID
+	}
+	location := pprofLocation{function: functionID, line: line}
+	locationID, ok := builder.locationIDs[location]
+	if !ok {
+		builder.locations = append(builder.locations, location)
+		locationID = uint64(len(builder.locations))
+		builder.locationIDs[location] = locationID
+	}
+	return locationID
+}
+
+// buildPprof returns the profile encoded as a pprof protocol buffer. The mutex of the profiler must be held.
+func (p *Profiler) buildPprof() []byte {
+	builder := &pprofBuilder{
+		strings:     []string{""},
+		stringIDs:   map[string]int64{"": 0},
+		functionIDs: make(map[pprofFunction]uint64),
+		locationIDs: make(map[pprofLocation]uint64),
+	}
+	var profile protoBuffer
+
+	// sample_type
+	sampleTypes := [][2]string{{"stmts", "count"}, {"wall", "nanoseconds"}, {"alloc_objects", "count"}, {"alloc_space", "bytes"}}
+	for _, sampleType := range sampleTypes {
+		var valueType protoBuffer
+		valueType.int64Field(1, builder.stringID(sampleType[0]))
+		valueType.int64Field(2, builder.stringID(sampleType[1]))
+		profile.bytesField(1, valueType.bytes)
+	}
+
+	// sample, in a stable order so the same values give the same profile
+	var nodes []*profileNode
+	p.walk(func(node *profileNode) { nodes = append(nodes, node) })
+	sort.Slice(nodes, func(i, j int) bool { return profileNodeLess(nodes[i], nodes[j]) })
+	for _, node := range nodes {
+		lines := make([]int, 0, len(node.lines))
+		for line := range node.lines {
+			lines = append(lines, line)
+		}
+		sort.Ints(lines)
+		for _, line := range lines {
+			values := node.lines[line]
+			var locationIDs []uint64
+			locationIDs = append(locationIDs, builder.locationID(node, line))
+			for caller := node; caller.parent != nil; caller = caller.parent {
+				locationIDs = append(locationIDs, builder.locationID(caller.parent, caller.callLine))
+			}
+			var sample protoBuffer
+			sample.packedField(1, locationIDs)
+			sample.packedField(2, []uint64{uint64(values.Count), uint64(values.Wall), uint64(values.AllocObjects), uint64(values.AllocBytes)})
+			profile.bytesField(2, sample.bytes)
+		}
+	}
+
+	// location
+	for i, location := range builder.locations {
+		var line protoBuffer
+		line.uint64Field(1, location.function)
+		line.int64Field(2, int64(location.line))
+		var locationBuffer protoBuffer
+		locationBuffer.uint64Field(1, uint64(i+1))
+		locationBuffer.bytesField(4, line.bytes)
+		profile.bytesField(4, locationBuffer.bytes)
+	}
+
+	// function
+	for i, function := range builder.functions {
+		var functionBuffer protoBuffer
+		functionBuffer.uint64Field(1, uint64(i+1))
+		functionBuffer.int64Field(2, builder.stringID(function.name))
+		functionBuffer.int64Field(3, builder.stringID(function.name))
+		functionBuffer.int64Field(4, builder.stringID(function.file))
+		functionBuffer.int64Field(5, int64(function.startLine))
+		profile.bytesField(5, functionBuffer.bytes)
+	}
+
+	// time_nanos, duration_nanos, period_type, period and default_sample_type
+	var periodType protoBuffer
+	periodType.int64Field(1, builder.stringID("wall"))
+	periodType.int64Field(2, builder.stringID("nanoseconds"))
+	defaultSampleType := builder.stringID("wall")
+
+	// string_table, after the other tables added their strings
+	for _, s := range builder.strings {
+		profile.bytesField(6, []byte(s))
+	}
+	profile.int64Field(9, p.start.UnixNano())
+	profile.int64Field(10, int64(time.Since(p.start)))
+	profile.bytesField(11, periodType.bytes)
+	profile.int64Field(12, 1)
+	profile.int64Field(14, defaultSampleType)
+	return profile.bytes
+}
+
+// profileNodeLess orders the nodes of the call tree by file, function and call line of each caller.
+func profileNodeLess(a *profileNode, b *profileNode) bool {
+	for a != nil && b != nil {
+		switch {
+		case a.file != b.file:
+			return a.file < b.file
+		case a.startLine != b.startLine:
+			return a.startLine < b.startLine
+		case a.function != b.function:
+			return a.function < b.function
+		case a.callLine != b.callLine:
+			return a.callLine < b.callLine
+		}
+		a, b = a.parent, b.parent
+	}
+	return a == nil && b != nil
+}
+
+// protoBuffer encodes protocol buffer fields.
+type protoBuffer struct {
+	bytes []byte
+}
+
+// varint appends x as a varint.
+func (buffer *protoBuffer) varint(x uint64) {
+	for x >= 0x80 {
+		buffer.bytes = append(buffer.bytes, byte(x)|0x80)
+		x >>= 7
+	}
+	buffer.bytes = append(buffer.bytes, byte(x))
+}
+
+// key appends the key of a field.
+func (buffer *protoBuffer) key(field int, wireType int) {
+	buffer.varint(uint64(field)<<3 | uint64(wireType))
+}
+
+// int64Field appends an int64 field, zero values are not written.
+func (buffer *protoBuffer) int64Field(field int, x int64) {
+	if x == 0 {
+		return
+	}
+	buffer.key(field, 0)
+	buffer.varint(uint64(x))
+}
+
+// uint64Field appends a uint64 field, zero values are not written.
+func (buffer *protoBuffer) uint64Field(field int, x uint64) {
+	if x == 0 {
+		return
+	}
+	buffer.key(field, 0)
+	buffer.varint(x)
+}
+
+// packedField appends a packed repeated varint field.
+func (buffer *protoBuffer) packedField(field int, xs []uint64) {
+	var packed protoBuffer
+	for _, x := range xs {
+		packed.varint(x)
+	}
+	buffer.bytesField(field, packed.bytes)
+}
+
+// bytesField appends a length delimited field, for strings and embedded messages.
+func (buffer *protoBuffer) bytesField(field int, data []byte) {
+	buffer.key(field, 2)
+	buffer.varint(uint64(len(data)))
+	buffer.bytes = append(buffer.bytes, data...)
+}
@@ -0,0 +1,174 @@
+package vm
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/mattn/anko/env"
+)
+
+func TestProfiler(t *testing.T) {
+	t.Parallel()
+
+	script := `func slow() {
+  sleep()
+}
+func fast() {
+  a = 1
+}
+for i = 0; i < 3; i++ {
+  fast()
+}
+slow()
+f = func() {
+  return 1
+}
+f()
+`
+	e := env.NewEnv()
+	err := e.Define("sleep", func() { time.Sleep(20 * time.Millisecond) })
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+	profiler := NewProfiler()
+	profiler.File = "script.ank"
+	_, err = ExecuteContext(context.Background(), e, &Options{Debug: true, Profiler: profiler}, script)
+	if err != nil {
+		t.Fatal("ExecuteContext error:", err)
+	}
+
+	functions := make(map[string]FunctionProfile)
+	for _, function := range profiler.Functions() {
+		if function.File != "script.ank" {
+			t.Errorf("File - received: %v - expected: %v - function: %v", function.File, "script.ank", function.Function)
+		}
+		functions[function.Function] = function
+	}
+	tests := []struct {
+		function string
+		line     int
+		calls    int64
+		count    int64
+		minWall  time.Duration
+	}{
+		{function: "main", line: 0, calls: 1, count: 10, minWall: 20 * time.Millisecond},
+		{function: "slow", line: 1, calls: 1, count: 1, minWall: 20 * time.Millisecond},
+		{function: "fast", line: 4, calls: 3, count: 3},
+		{function: "func@11", line: 11, calls: 1, count: 1},
+	}
+	for _, test := range tests {
+		function, ok := functions[test.function]
+		if !ok {
+			t.Errorf("Functions - received: %v - expected: %v", functions, test.function)
+			continue
+		}
+		if function.Line != test.line || function.Calls != test.calls || function.Flat.Count != test.count {
+			t.Errorf("function %v - received: line %v, calls %v, count %v - expected: line %v, calls %v, count %v",
+				test.function, function.Line, function.Calls, function.Flat.Count, test.line, test.calls, test.count)
+		}
+		if function.Cum.Wall < test.minWall {
+			t.Errorf("function %v cumulative wall - received: %v - expected at least: %v", test.function, function.Cum.Wall, test.minWall)
+		}
+	}
+	if functions["main"].Flat.Wall >= 20*time.Millisecond {
+		t.Errorf("main flat wall - received: %v - expected less than: %v", functions["main"].Flat.Wall, 20*time.Millisecond)
+	}
+
+	lines := profiler.Lines()
+	if len(lines) == 0 || lines[0].Function != "slow" || lines[0].Line != 2 || lines[0].Values.Wall < 20*time.Millisecond {
+		t.Errorf("Lines - received: %+v - expected first: slow line 2", lines)
+	}
+
+	var buffer bytes.Buffer
+	err = profiler.WritePprof(&buffer)
+	if err != nil {
+		t.Fatal("WritePprof error:", err)
+	}
+	reader, err := gzip.NewReader(&buffer)
+	if err != nil {
+		t.Fatal("gzip NewReader error:", err)
+	}
+	profile, err := ioutil.ReadAll(reader)
+	if err != nil {
+		t.Fatal("ReadAll error:", err)
+	}
+	for _, expected := range []string{"slow", "func@11", "script.ank", "wall", "alloc_space"} {
+		if !bytes.Contains(profile, []byte(expected)) {
+			t.Errorf("WritePprof - expected to contain: %q", expected)
+		}
+	}
+}
+
+func TestProfilerCallback(t *testing.T) {
+	t.Parallel()
+
+	script := `func outer() {
+  apply(func() {
+    sleep()
+  })
+}
+outer()
+`
+	e := env.NewEnv()
+	err := e.Define("sleep", func() { time.Sleep(20 * time.Millisecond) })
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+	err = e.Define("apply", func(f func()) { f() })
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+	profiler := NewProfiler()
+	_, err = Execute(e, &Options{Debug: true, Profiler: profiler}, script)
+	if err != nil {
+		t.Fatal("Execute error:", err)
+	}
+	// the function called by apply is in the call tree of outer
+	functions := make(map[string]FunctionProfile)
+	for _, function := range profiler.Functions() {
+		functions[function.Function] = function
+	}
+	callback := functions["func@2"]
+	if callback.Calls != 1 || callback.Flat.Count != 1 || callback.Flat.Wall < 20*time.Millisecond {
+		t.Errorf("func@2 - received: %+v - expected: 1 call and 1 statement of at least %v", callback, 20*time.Millisecond)
+	}
+	if functions["outer"].Flat.Wall >= 20*time.Millisecond {
+		t.Errorf("outer flat wall - received: %v - expected less than: %v", functions["outer"].Flat.Wall, 20*time.Millisecond)
+	}
+}
+
+func TestProfilerGoroutine(t *testing.T) {
+	t.Parallel()
+
+	script := `func work(c) {
+  c <- 1
+}
+c = make(chan int64)
+go work(c)
+<-c
+`
+	profiler := NewProfiler()
+	_, err := Execute(env.NewEnv(), &Options{Debug: true, Profiler: profiler}, script)
+	if err != nil {
+		t.Fatal("Execute error:", err)
+	}
+	// the goroutine starts with work instead of being called by main
+	var work FunctionProfile
+	for _, function := range profiler.Functions() {
+		if function.Function == "work" {
+			work = function
+		}
+	}
+	if work.Calls != 1 || work.Flat.Count != 1 {
+		t.Errorf("work - received: %+v - expected: 1 call and 1 statement", work)
+	}
+	for _, line := range profiler.Lines() {
+		if line.Function == "main" && line.Line == 5 && line.Values.Count != 1 {
+			t.Errorf("main line 5 count - received: %v - expected: %v", line.Values.Count, 1)
+		}
+	}
+}
@@ -3,6 +3,8 @@ package vm
 import (
 	"context"
 	"fmt"
+	"io"
+	"io/fs"
 	"reflect"
 
 	"github.com/mattn/anko/ast"
@@ -12,6 +14,35 @@ import (
 
 type Options struct {
 	Debug bool
+	// Numeric selects how arithmetic is done, the default is int64 and float64 without overflow checks
+	Numeric NumericMode
+	// FS is the file system script files are read from, nil uses the FS of the env or the operating system file system
+	FS fs.FS
+	// ModuleLoader loads the script files imported as modules and caches them,
+	// nil caches the modules for the run, reading the search path from ANKOPATH when the run starts
+	ModuleLoader *ModuleLoader
+	// Stdin, Stdout and Stderr are the streams of the run used by the core I/O builtins,
+	// nil uses the streams of the context or the os streams
+	Stdin  io.Reader
+	Stdout io.Writer
+	Stderr io.Writer
+	// Converters converts script values to the Go types wanted by functions and typed values, nil uses DefaultConverters
+	Converters *Converters
+	// Operators are the functions used for the operators of Go types, nil uses DefaultOperators
+	Operators *Operators
+	// ContextKeys maps names to keys of the values of the run context that scripts can read with ctx.value(name)
+	ContextKeys map[string]interface{}
+	// Coverage records how many times the statements and branches of the scripts added to it run, nil does not record
+	Coverage *Coverage
+	// Profiler records the wall time and allocations of the script functions and lines that run, nil does not record
+	Profiler *Profiler
+	// Tracer is told about the calls, throws, catches and errors of the scripts, nil does not trace
+	Tracer Tracer
+
+	// moduleDir is the directory of the module being run
+	moduleDir string
+	// moduleStack is the chain of module files being imported, used to detect import cycles
+	moduleStack []string
 	// ...other fields...
 }
 
@@ -48,10 +79,27 @@ func RunContext(ctx context.Context, env *env.Env, options *Options, stmt ast.St
 	if runInfo.options == nil {
 		runInfo.options = &Options{}
 	}
+	ctx, cancel := withExit(withModuleLoaders(ctx))
+	defer cancel()
+	runInfo.ctx = WithStreams(ctx, runInfo.options.Stdin, runInfo.options.Stdout, runInfo.options.Stderr)
+	runInfo.ctx = WithContextKeys(runInfo.ctx, runInfo.options.ContextKeys)
+	runInfo.ctx = context.WithValue(runInfo.ctx, optionsKey{}, runInfo.options)
+	if overlay := env.Overlay(); overlay != nil {
+		runInfo.ctx = context.WithValue(runInfo.ctx, overlayKey{}, overlay)
+	}
+	if runInfo.options.Profiler != nil {
+		defer runInfo.startProfile()()
+	}
 	runInfo.runSingleStmt()
 	if runInfo.err == ErrReturn {
 		runInfo.err = nil
 	}
+	if err := exitError(ctx); err != nil {
+		return nil, err
+	}
+	if runInfo.err != nil && runInfo.options.Tracer != nil && len(runInfo.options.moduleStack) == 0 {
+		runInfo.options.Tracer.Error(runInfo.ctx, runInfo.err)
+	}
 	return runInfo.rv.Interface(), runInfo.err
 }
 
@@ -65,6 +113,11 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 	default:
 	}
 
+	runInfo.coverStmt(runInfo.stmt)
+	if thread := runInfo.profileThread(); thread != nil {
+		defer thread.restore(thread.stmt(runInfo.stmt))
+	}
+
 	switch stmt := runInfo.stmt.(type) {
 
 	// nil
@@ -75,9 +128,11 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 		for _, stmt := range stmt.Stmts {
 			switch stmt.(type) {
 			case *ast.BreakStmt:
+				runInfo.coverStmt(stmt)
 				runInfo.err = ErrBreak
 				return
 			case *ast.ContinueStmt:
+				runInfo.coverStmt(stmt)
 				runInfo.err = ErrContinue
 				return
 			case *ast.ReturnStmt:
@@ -239,6 +294,7 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 
 		if toBool(runInfo.rv) {
 			// then
+			runInfo.coverBranch(stmt, 0)
 			runInfo.rv = nilValue
 			runInfo.stmt = stmt.Then
 			runInfo.env = env.NewEnv()
@@ -247,7 +303,7 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 			return
 		}
 
-		for _, statement := range stmt.ElseIf {
+		for i, statement := range stmt.ElseIf {
 			elseIf := statement.(*ast.IfStmt)
 
 			// else if - if
@@ -264,6 +320,7 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 			}
 
 			// else if - then
+			runInfo.coverBranch(stmt, i+1)
 			runInfo.rv = nilValue
 			runInfo.stmt = elseIf.Then
 			runInfo.env = env.NewEnv()
@@ -272,6 +329,8 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 			return
 		}
 
+		// else, counted even when the if statement does not have one
+		runInfo.coverBranch(stmt, len(stmt.ElseIf)+1)
 		if stmt.Else != nil {
 			// else
 			runInfo.rv = nilValue
@@ -300,6 +359,9 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 			}
 
 			// Catch
+			if runInfo.options.Tracer != nil {
+				runInfo.options.Tracer.Catch(runInfo.ctx, stmt.Position(), runInfo.err)
+			}
 			runInfo.stmt = stmt.Catch
 			if stmt.Var != "" {
 				runInfo.env.DefineValue(stmt.Var, reflect.ValueOf(runInfo.err))
@@ -608,6 +670,9 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 		if runInfo.err != nil {
 			return
 		}
+		if runInfo.options.Tracer != nil {
+			runInfo.options.Tracer.Throw(runInfo.ctx, stmt.Position(), runInfo.rv.Interface())
+		}
 		runInfo.err = newStringError(stmt, fmt.Sprint(runInfo.rv.Interface()))
 
 	// ModuleStmt
@@ -638,7 +703,7 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 		}
 		value := runInfo.rv
 
-		for _, switchCaseStmt := range stmt.Cases {
+		for i, switchCaseStmt := range stmt.Cases {
 			caseStmt := switchCaseStmt.(*ast.SwitchCaseStmt)
 			for _, runInfo.expr = range caseStmt.Exprs {
 				runInfo.invokeExpr()
@@ -647,6 +712,7 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 					return
 				}
 				if equal(runInfo.rv, value) {
+					runInfo.coverBranch(stmt, i)
 					runInfo.stmt = caseStmt.Stmt
 					runInfo.runSingleStmt()
 					runInfo.env = env
@@ -655,6 +721,8 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 			}
 		}
 
+		// default, counted even when the switch statement does not have one
+		runInfo.coverBranch(stmt, len(stmt.Cases))
 		if stmt.Default == nil {
 			runInfo.rv = nilValue
 		} else {
@@ -710,7 +778,7 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 				runInfo.rv = nilValue
 				return
 			}
-			runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.rv, item.Type().Key())
+			runInfo.rv, runInfo.err = runInfo.convertReflectValueToType(runInfo.rv, item.Type().Key())
 			if runInfo.err != nil {
 				runInfo.err = newStringError(stmt, "cannot use type "+item.Type().Key().String()+" as type "+runInfo.rv.Type().String()+" in delete")
 				runInfo.rv = nilValue
@@ -0,0 +1,63 @@
+package vm
+
+import (
+	"context"
+	"io"
+	"os"
+)
+
+// streamsKey is the context key of the streams of a run.
+type streamsKey struct{}
+
+// streams are the standard streams of a run.
+type streams struct {
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+}
+
+// WithStreams returns a copy of ctx that carries the standard streams used by the core I/O builtins,
+// for Go code that calls script functions with a Func. A nil stream keeps the stream of ctx.
+// RunContext does the same with the streams of the Options.
+func WithStreams(ctx context.Context, stdin io.Reader, stdout io.Writer, stderr io.Writer) context.Context {
+	if stdin == nil && stdout == nil && stderr == nil {
+		return ctx
+	}
+	s := streams{stdin: stdin, stdout: stdout, stderr: stderr}
+	if parent, ok := ctx.Value(streamsKey{}).(*streams); ok {
+		if s.stdin == nil {
+			s.stdin = parent.stdin
+		}
+		if s.stdout == nil {
+			s.stdout = parent.stdout
+		}
+		if s.stderr == nil {
+			s.stderr = parent.stderr
+		}
+	}
+	return context.WithValue(ctx, streamsKey{}, &s)
+}
+
+// Stdin returns the standard input of the run of ctx, os.Stdin if none was set.
+func Stdin(ctx context.Context) io.Reader {
+	if s, ok := ctx.Value(streamsKey{}).(*streams); ok && s.stdin != nil {
+		return s.stdin
+	}
+	return os.Stdin
+}
+
+// Stdout returns the standard output of the run of ctx, os.Stdout if none was set.
+func Stdout(ctx context.Context) io.Writer {
+	if s, ok := ctx.Value(streamsKey{}).(*streams); ok && s.stdout != nil {
+		return s.stdout
+	}
+	return os.Stdout
+}
+
+// Stderr returns the standard error of the run of ctx, os.Stderr if none was set.
+func Stderr(ctx context.Context) io.Writer {
+	if s, ok := ctx.Value(streamsKey{}).(*streams); ok && s.stderr != nil {
+		return s.stderr
+	}
+	return os.Stderr
+}
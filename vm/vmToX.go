@@ -3,6 +3,7 @@ package vm
 import (
 	"errors"
 	"fmt"
+	"math/big"
 	"reflect"
 	"strconv"
 	"strings"
@@ -13,6 +14,14 @@ func toString(v reflect.Value) string {
 	if v.Kind() == reflect.Interface && !v.IsNil() {
 		v = v.Elem()
 	}
+	if v.Kind() == reflect.Ptr && !v.IsNil() {
+		switch n := v.Interface().(type) {
+		case *big.Int:
+			return n.String()
+		case *big.Rat:
+			return n.RatString()
+		}
+	}
 	if v.Kind() == reflect.Ptr {
 		v = v.Elem()
 	}
@@ -50,6 +59,9 @@ func toInt(v reflect.Value) int {
 // If it cannot (in the case of a non-numeric string, a struct, etc.)
 // it returns 0 and an error.
 func tryToInt(v reflect.Value) (int, error) {
+	if i, ok := bigNumberToInt64(v); ok {
+		return int(i), nil
+	}
 	if v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
 		v = v.Elem()
 	}
@@ -0,0 +1,102 @@
+package vm
+
+import (
+	"context"
+	"reflect"
+	"runtime"
+	"time"
+
+	"github.com/mattn/anko/ast"
+)
+
+// Tracer is told what scripts do, to log it or to make spans of it. Set it in the Options of the runs to trace.
+// The methods are called by the goroutine running the script, they must be safe to call from many goroutines
+// when scripts start goroutines or a Tracer is used by many runs.
+type Tracer interface {
+	// FuncStart is called when a script function is called, the function runs with the context it returns
+	FuncStart(ctx context.Context, call *TraceCall) context.Context
+	// FuncEnd is called when the script function returns, with the context returned by FuncStart
+	FuncEnd(ctx context.Context, call *TraceCall)
+	// HostCall is called when a Go function called by a script returns
+	HostCall(ctx context.Context, call *TraceCall)
+	// Throw is called when a script throws a value
+	Throw(ctx context.Context, pos ast.Position, value interface{})
+	// Catch is called when a try statement catches an error
+	Catch(ctx context.Context, pos ast.Position, err error)
+	// Error is called when a run ends with an error, runs of imported modules do not call it
+	Error(ctx context.Context, err error)
+}
+
+// TraceCall is a call of a script function or of a Go function.
+type TraceCall struct {
+	// Name is the name of the function. Script function literals are named func@ followed by the line they start on,
+	// Go functions are named like the script calls them, or by their Go name when the script calls a value.
+	Name string
+	// Host is true for Go functions
+	Host bool
+	// File is the file of the module the script function is in or the Go function is called from, empty outside of modules
+	File string
+	// Pos is the position of script functions and of the calls of Go functions
+	Pos ast.Position
+	// Start is when the call started
+	Start time.Time
+	// Duration is how long the call took, set when the call returns
+	Duration time.Duration
+	// Err is the error of the call, set when the call returns.
+	// For Go functions it is the error the function panicked with or the error it returned last.
+	Err error
+}
+
+// startFunc tells the tracer the script function started and sets the context of the run to the one the tracer returns.
+func (runInfo *runInfoStruct) startFunc(name string, funcExpr *ast.FuncExpr) *TraceCall {
+	call := &TraceCall{Name: name, File: runInfo.options.moduleFile(), Pos: funcExpr.Position(), Start: time.Now()}
+	runInfo.ctx = runInfo.options.Tracer.FuncStart(runInfo.ctx, call)
+	return call
+}
+
+// endFunc tells the tracer the script function returned, with the error of the run.
+func (runInfo *runInfoStruct) endFunc(call *TraceCall) {
+	call.Duration = time.Since(call.Start)
+	if runInfo.err != ErrReturn {
+		call.Err = runInfo.err
+	}
+	runInfo.options.Tracer.FuncEnd(runInfo.ctx, call)
+}
+
+// startHostCall returns the call of the Go function f.
+func (runInfo *runInfoStruct) startHostCall(callExpr *ast.CallExpr, f reflect.Value) *TraceCall {
+	name := callExpr.Name
+	if name == "" {
+		if function := runtime.FuncForPC(f.Pointer()); function != nil {
+			name = function.Name()
+		}
+	}
+	return &TraceCall{Name: name, Host: true, File: runInfo.options.moduleFile(), Pos: callExpr.Position(), Start: time.Now()}
+}
+
+// endHostCall tells the tracer the Go function returned, with the error of the run or the error it returned last.
+func (runInfo *runInfoStruct) endHostCall(call *TraceCall, rvs []reflect.Value) {
+	call.Duration = time.Since(call.Start)
+	call.Err = runInfo.err
+	if call.Err == nil && len(rvs) > 0 {
+		if err, ok := rvs[len(rvs)-1].Interface().(error); ok && rvs[len(rvs)-1].Type() == errorType {
+			call.Err = err
+		}
+	}
+	runInfo.options.Tracer.HostCall(runInfo.ctx, call)
+}
+
+// callName returns the name of the function expr calls, empty if it is not a name or a member.
+func callName(expr ast.Expr) string {
+	switch expr := expr.(type) {
+	case *ast.IdentExpr:
+		return expr.Lit
+	case *ast.MemberExpr:
+		name := callName(expr.Expr)
+		if name == "" {
+			return ""
+		}
+		return name + "." + expr.Name
+	}
+	return ""
+}
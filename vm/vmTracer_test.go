@@ -0,0 +1,146 @@
+package vm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/mattn/anko/ast"
+	"github.com/mattn/anko/env"
+)
+
+// testTracer records the events of a run as strings.
+type testTracer struct {
+	mutex  sync.Mutex
+	events []string
+}
+
+type testTracerKey struct{}
+
+func (tracer *testTracer) add(format string, a ...interface{}) {
+	tracer.mutex.Lock()
+	tracer.events = append(tracer.events, fmt.Sprintf(format, a...))
+	tracer.mutex.Unlock()
+}
+
+func (tracer *testTracer) FuncStart(ctx context.Context, call *TraceCall) context.Context {
+	tracer.add("start %v %v", call.Name, call.Pos.Line)
+	depth, _ := ctx.Value(testTracerKey{}).(int)
+	return context.WithValue(ctx, testTracerKey{}, depth+1)
+}
+
+func (tracer *testTracer) FuncEnd(ctx context.Context, call *TraceCall) {
+	depth, _ := ctx.Value(testTracerKey{}).(int)
+	tracer.add("end %v depth %v error %v", call.Name, depth, call.Err != nil)
+}
+
+func (tracer *testTracer) HostCall(ctx context.Context, call *TraceCall) {
+	depth, _ := ctx.Value(testTracerKey{}).(int)
+	tracer.add("host %v %v depth %v error %v", call.Name, call.Pos.Line, depth, call.Err)
+}
+
+func (tracer *testTracer) Throw(ctx context.Context, pos ast.Position, value interface{}) {
+	tracer.add("throw %v %v", pos.Line, value)
+}
+
+func (tracer *testTracer) Catch(ctx context.Context, pos ast.Position, err error) {
+	tracer.add("catch %v %v", pos.Line, err)
+}
+
+func (tracer *testTracer) Error(ctx context.Context, err error) {
+	tracer.add("error %v", err)
+}
+
+func TestTracer(t *testing.T) {
+	t.Parallel()
+
+	script := `func add(a, b) {
+  return a + b
+}
+add(1, 2)
+try {
+  throw "boom"
+} catch {
+}
+func() {
+  strings.ToUpper(toString(fail()))
+}()
+`
+	e := env.NewEnv()
+	err := e.Define("toString", func(v interface{}) string { return fmt.Sprint(v) })
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+	err = e.Define("fail", func() error { return errors.New("failed") })
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+	stringsModule, err := e.NewModule("strings")
+	if err != nil {
+		t.Fatal("NewModule error:", err)
+	}
+	err = stringsModule.Define("ToUpper", strings.ToUpper)
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+
+	tracer := &testTracer{}
+	_, err = Execute(e, &Options{Tracer: tracer}, script)
+	if err != nil {
+		t.Fatal("Execute error:", err)
+	}
+	expected := []string{
+		"start add 1",
+		"end add depth 1 error false",
+		"throw 6 boom",
+		"catch 5 boom",
+		"start func@9 9",
+		"host fail 10 depth 1 error failed",
+		"host toString 10 depth 1 error <nil>",
+		"host strings.ToUpper 10 depth 1 error <nil>",
+		"end func@9 depth 1 error false",
+	}
+	if !reflect.DeepEqual(tracer.events, expected) {
+		t.Errorf("events - received: %#v - expected: %#v", tracer.events, expected)
+	}
+
+	// functions called by Go functions run in the span of the caller
+	err = e.Define("apply", func(f func(int64) int64, v int64) int64 { return f(v) })
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+	tracer = &testTracer{}
+	_, err = Execute(e, &Options{Tracer: tracer}, "func outer() {\n  return apply(func(v) { return v + 1 }, 1)\n}\nouter()")
+	if err != nil {
+		t.Fatal("Execute error:", err)
+	}
+	expected = []string{
+		"start outer 1",
+		"start func@2 2",
+		"end func@2 depth 2 error false",
+		"host apply 2 depth 1 error <nil>",
+		"end outer depth 1 error false",
+	}
+	if !reflect.DeepEqual(tracer.events, expected) {
+		t.Errorf("events - received: %#v - expected: %#v", tracer.events, expected)
+	}
+
+	tracer = &testTracer{}
+	_, err = Execute(e, &Options{Tracer: tracer}, "func f() { throw \"boom\" }\nf()")
+	if err == nil {
+		t.Fatal("Execute error - received: nil - expected: boom")
+	}
+	expected = []string{
+		"start f 1",
+		"throw 1 boom",
+		"end f depth 1 error true",
+		"error boom",
+	}
+	if !reflect.DeepEqual(tracer.events, expected) {
+		t.Errorf("events - received: %#v - expected: %#v", tracer.events, expected)
+	}
+}